@@ -0,0 +1,449 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// maskString は値を完全にマスクする際の文字列です
+const maskString = "********"
+
+// MaskPath は JSON パスで指定した値をマスクするルールです
+type MaskPath struct {
+	// Path は $.users[*].email のような JSON パスです
+	// 対応する構文は $ / .key / ['key'] / [n] / [*] / .* です
+	Path string
+
+	// KeepLast は末尾を残す文字数です（0 は完全にマスク）
+	KeepLast int
+}
+
+// MaskPattern は値の中身が正規表現に一致した部分をマスクするルールです
+type MaskPattern struct {
+	// Name はルールの名前です（ログには出力しません）
+	Name string
+
+	// Pattern はマスク対象に一致する正規表現です
+	Pattern *regexp.Regexp
+
+	// KeepLast は末尾を残す文字数です（0 は完全にマスク）
+	KeepLast int
+
+	// Validate は一致した文字列を本当にマスクするか判定する関数です（nil の場合は常にマスク）
+	Validate func(match string) bool
+}
+
+// DefaultMaskPatterns は個人情報・認証情報とみなす値のパターンです
+var DefaultMaskPatterns = []MaskPattern{
+	{
+		Name:    "jwt",
+		Pattern: regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`),
+	},
+	{
+		Name:    "email",
+		Pattern: regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`),
+	},
+	{
+		Name:     "credit_card",
+		Pattern:  regexp.MustCompile(`\b(?:\d[ -]?){12,18}\d\b`),
+		KeepLast: 4,
+		Validate: luhnValid,
+	},
+	{
+		Name:     "phone",
+		Pattern:  regexp.MustCompile(`(?:\+81[- ]?|\b0)\d{1,4}[- ]?\d{1,4}[- ]?\d{3,4}\b`),
+		KeepLast: 4,
+	},
+}
+
+// DefaultSecretPathPrefixes はパスに秘密の値を含むルートです
+// 共有リンクのトークン（/share/:token）を知っていれば業務経歴書を閲覧できるため、ログに残しません
+var DefaultSecretPathPrefixes = []string{"/share/"}
+
+// maskSecretPaths はリクエストURIのパスが prefixes のいずれかで始まる場合、直後の要素をマスクします
+// 例: /share/abc/resume.pdf → /share/********/resume.pdf
+func maskSecretPaths(uri string, prefixes []string) string {
+	for _, prefix := range prefixes {
+		rest, ok := strings.CutPrefix(uri, prefix)
+		if !ok {
+			continue
+		}
+		end := strings.IndexAny(rest, "/?#")
+		if end == -1 {
+			end = len(rest)
+		}
+		if end == 0 {
+			continue
+		}
+		return prefix + maskString + rest[end:]
+	}
+	return uri
+}
+
+// masker はボディ・クエリ文字列の機密情報をマスクします
+type masker struct {
+	fields   map[string]struct{}
+	paths    []compiledMaskPath
+	patterns []MaskPattern
+	// textFields は JSON として解釈できないボディ（切り詰められた JSON など）から
+	// "field": "value" の形をした値を探すための正規表現です
+	textFields *regexp.Regexp
+}
+
+type compiledMaskPath struct {
+	segments []pathSegment
+	keepLast int
+}
+
+// pathSegment は JSON パスの1要素です
+type pathSegment struct {
+	key      string
+	index    int
+	wildcard bool
+	isIndex  bool
+}
+
+// newMasker はマスク設定からマスク処理を作成します
+func newMasker(fields []string, paths []MaskPath, patterns []MaskPattern) (*masker, error) {
+	m := &masker{
+		fields:   make(map[string]struct{}, len(fields)),
+		patterns: patterns,
+	}
+
+	quoted := make([]string, 0, len(fields))
+	for _, field := range fields {
+		m.fields[strings.ToLower(field)] = struct{}{}
+		quoted = append(quoted, regexp.QuoteMeta(field))
+	}
+	if len(quoted) > 0 {
+		m.textFields = regexp.MustCompile(`(?i)("(?:` + strings.Join(quoted, "|") + `)"\s*:\s*)("(?:[^"\\]|\\.)*"?|[^,}\]\s]+)`)
+	}
+
+	for _, p := range paths {
+		segments, err := parseJSONPath(p.Path)
+		if err != nil {
+			return nil, err
+		}
+		m.paths = append(m.paths, compiledMaskPath{segments: segments, keepLast: p.KeepLast})
+	}
+
+	return m, nil
+}
+
+// maskBody は Content-Type に応じてボディをマスクします
+func (m *masker) maskBody(data []byte, contentType string) []byte {
+	if len(data) == 0 {
+		return data
+	}
+
+	// PDF・xlsx などのバイナリはマスクできず、ログに出しても読めないため種類とサイズだけを出力する
+	if !isTextContentType(contentType) {
+		return []byte(fmt.Sprintf("[binary body omitted: %s, %d bytes]", contentType, len(data)))
+	}
+
+	if strings.Contains(contentType, "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(data)); err == nil {
+			return []byte(m.maskValues(values).Encode())
+		}
+	}
+
+	// JSONデータかどうかをチェック（ルートが配列の場合も含む）
+	// 大きな数値の精度が落ちたり、整数が指数表記になったりしないよう json.Number として扱う
+	if jsonData, ok := decodeJSON(data); ok {
+		maskedData, err := json.Marshal(m.maskJSON(jsonData))
+		if err == nil {
+			return maskedData
+		}
+	}
+
+	// JSON として解釈できない場合もテキストとして可能な限りマスクする
+	return []byte(m.maskText(string(data)))
+}
+
+// decodeJSON は数値を json.Number のまま JSON を解析します
+// json.Unmarshal と同様に、値の後ろに余分なデータがある場合は解析できないものとします
+func decodeJSON(data []byte) (interface{}, bool) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, false
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, false
+	}
+	return v, true
+}
+
+// isTextContentType はボディをテキストとしてマスクして出力できる Content-Type かどうかを判定します
+// Content-Type がない場合はテキストとして扱います
+func isTextContentType(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType, _, _ = strings.Cut(strings.ToLower(contentType), ";")
+		mediaType = strings.TrimSpace(mediaType)
+	}
+	switch {
+	case strings.HasPrefix(mediaType, "text/"),
+		strings.HasSuffix(mediaType, "+json"),
+		strings.HasSuffix(mediaType, "+xml"):
+		return true
+	}
+	switch mediaType {
+	case "application/json", "application/x-www-form-urlencoded", "application/xml", "application/javascript":
+		return true
+	}
+	return false
+}
+
+// maskURI はリクエストURIのクエリ文字列とパスをマスクします
+func (m *masker) maskURI(uri string) string {
+	path, rawQuery, hasQuery := strings.Cut(uri, "?")
+	path = m.maskPatterns(path)
+	if !hasQuery {
+		return path
+	}
+
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return path + "?" + m.maskPatterns(rawQuery)
+	}
+	return path + "?" + m.maskValues(values).Encode()
+}
+
+// maskValues はフォーム・クエリ文字列の値をマスクします
+func (m *masker) maskValues(values url.Values) url.Values {
+	masked := make(url.Values, len(values))
+	for key, vs := range values {
+		_, isField := m.fields[strings.ToLower(key)]
+		for _, v := range vs {
+			if isField {
+				masked.Add(key, maskString)
+			} else {
+				masked.Add(key, m.maskPatterns(v))
+			}
+		}
+	}
+	return masked
+}
+
+// maskJSON はフィールド名・JSON パス・値のパターンの順にマスクします
+func (m *masker) maskJSON(data interface{}) interface{} {
+	data = m.maskFields(data)
+	for _, p := range m.paths {
+		data = applyJSONPath(data, p.segments, func(v interface{}) interface{} {
+			return maskValue(stringify(v), p.keepLast)
+		})
+	}
+	return m.maskStrings(data)
+}
+
+// maskFields は名前が MaskFields に一致するキーの値を再帰的にマスクします
+func (m *masker) maskFields(data interface{}) interface{} {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if _, ok := m.fields[strings.ToLower(key)]; ok {
+				v[key] = maskString
+				continue
+			}
+			v[key] = m.maskFields(value)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = m.maskFields(item)
+		}
+	}
+	return data
+}
+
+// maskStrings は全ての文字列値に値のパターンを適用します
+func (m *masker) maskStrings(data interface{}) interface{} {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = m.maskStrings(value)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = m.maskStrings(item)
+		}
+	case string:
+		return m.maskPatterns(v)
+	}
+	return data
+}
+
+// maskText は JSON でないボディに対して、フィールド名と値のパターンでマスクします
+func (m *masker) maskText(text string) string {
+	if m.textFields != nil {
+		text = m.textFields.ReplaceAllString(text, `${1}"`+maskString+`"`)
+	}
+	return m.maskPatterns(text)
+}
+
+// maskPatterns は文字列中の値のパターンに一致した部分をマスクします
+func (m *masker) maskPatterns(s string) string {
+	for _, p := range m.patterns {
+		s = p.Pattern.ReplaceAllStringFunc(s, func(match string) string {
+			if p.Validate != nil && !p.Validate(match) {
+				return match
+			}
+			return maskValue(match, p.KeepLast)
+		})
+	}
+	return s
+}
+
+// maskValue は値をマスクします。keepLast が正の場合は末尾の文字を残します
+// 残すと値の大半が見えてしまう短い値は完全にマスクします
+func maskValue(s string, keepLast int) string {
+	runes := []rune(s)
+	if keepLast <= 0 || len(runes) <= keepLast*2 {
+		return maskString
+	}
+	return strings.Repeat("*", 4) + string(runes[len(runes)-keepLast:])
+}
+
+// stringify は JSON の値を文字列に変換します
+func stringify(v interface{}) string {
+	switch val := v.(type) {
+	case string:
+		return val
+	case json.Number:
+		return val.String()
+	case nil:
+		return ""
+	default:
+		b, err := json.Marshal(val)
+		if err != nil {
+			return fmt.Sprint(val)
+		}
+		return string(b)
+	}
+}
+
+// applyJSONPath はパスに一致した値を fn の戻り値で置き換えます
+func applyJSONPath(node interface{}, segments []pathSegment, fn func(interface{}) interface{}) interface{} {
+	if len(segments) == 0 {
+		return fn(node)
+	}
+
+	seg, rest := segments[0], segments[1:]
+	switch v := node.(type) {
+	case map[string]interface{}:
+		if seg.isIndex {
+			return node
+		}
+		if seg.wildcard {
+			for key, value := range v {
+				v[key] = applyJSONPath(value, rest, fn)
+			}
+			return node
+		}
+		if value, ok := v[seg.key]; ok {
+			v[seg.key] = applyJSONPath(value, rest, fn)
+		}
+	case []interface{}:
+		if seg.wildcard {
+			for i, item := range v {
+				v[i] = applyJSONPath(item, rest, fn)
+			}
+			return node
+		}
+		if seg.isIndex && seg.index >= 0 && seg.index < len(v) {
+			v[seg.index] = applyJSONPath(v[seg.index], rest, fn)
+		}
+	}
+	return node
+}
+
+// parseJSONPath は $.users[*].email のような JSON パスを解析します
+func parseJSONPath(path string) ([]pathSegment, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("invalid json path %q: must start with $", path)
+	}
+
+	var segments []pathSegment
+	rest := path[1:]
+	for rest != "" {
+		switch {
+		case strings.HasPrefix(rest, "."):
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+			key := rest[:end]
+			if key == "" {
+				return nil, fmt.Errorf("invalid json path %q: empty key", path)
+			}
+			if key == "*" {
+				segments = append(segments, pathSegment{wildcard: true})
+			} else {
+				segments = append(segments, pathSegment{key: key})
+			}
+			rest = rest[end:]
+		case strings.HasPrefix(rest, "["):
+			end := strings.Index(rest, "]")
+			if end == -1 {
+				return nil, fmt.Errorf("invalid json path %q: missing ]", path)
+			}
+			inner := rest[1:end]
+			rest = rest[end+1:]
+			switch {
+			case inner == "*":
+				segments = append(segments, pathSegment{wildcard: true})
+			case len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0]:
+				segments = append(segments, pathSegment{key: inner[1 : len(inner)-1]})
+			default:
+				index, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, fmt.Errorf("invalid json path %q: bad index %q", path, inner)
+				}
+				segments = append(segments, pathSegment{index: index, isIndex: true})
+			}
+		default:
+			return nil, fmt.Errorf("invalid json path %q: unexpected %q", path, rest[:1])
+		}
+	}
+	return segments, nil
+}
+
+// luhnValid はクレジットカード番号のチェックディジットを検証します
+// 年月日やIDなど、桁数が同じだけの数字を誤ってマスクしないために使います
+func luhnValid(s string) bool {
+	var digits []int
+	for _, r := range s {
+		if unicode.IsDigit(r) {
+			digits = append(digits, int(r-'0'))
+		}
+	}
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := digits[i]
+		if (len(digits)-1-i)%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
@@ -0,0 +1,228 @@
+package middleware
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMasker_MaskBody(t *testing.T) {
+	tests := []struct {
+		name        string
+		fields      []string
+		paths       []MaskPath
+		patterns    []MaskPattern
+		contentType string
+		body        string
+		expected    string
+	}{
+		{
+			name:        "正常系: ネストしたオブジェクトのフィールドをマスクする",
+			fields:      []string{"password"},
+			patterns:    []MaskPattern{},
+			contentType: "application/json",
+			body:        `{"user":{"name":"taro","Password":"p@ss"}}`,
+			expected:    `{"user":{"Password":"********","name":"taro"}}`,
+		},
+		{
+			name:        "正常系: ルートが配列のJSONもマスクする",
+			fields:      []string{"token"},
+			patterns:    []MaskPattern{},
+			contentType: "application/json",
+			body:        `[{"token":"a"},[{"token":"b"}]]`,
+			expected:    `[{"token":"********"},[{"token":"********"}]]`,
+		},
+		{
+			name:        "正常系: JSONパスで指定した値をマスクする",
+			paths:       []MaskPath{{Path: "$.users[*].email"}, {Path: "$.users[0]['name']"}},
+			patterns:    []MaskPattern{},
+			contentType: "application/json",
+			body:        `{"users":[{"name":"taro","email":"a"},{"name":"jiro","email":"b"}]}`,
+			expected:    `{"users":[{"email":"********","name":"********"},{"email":"********","name":"jiro"}]}`,
+		},
+		{
+			name:        "正常系: JSONパスの部分マスクで末尾を残す",
+			paths:       []MaskPath{{Path: "$.card_number", KeepLast: 4}, {Path: "$.*.code", KeepLast: 4}},
+			patterns:    []MaskPattern{},
+			contentType: "application/json",
+			body:        `{"card_number":"1234567890123456","a":{"code":12345678901}}`,
+			expected:    `{"a":{"code":"****8901"},"card_number":"****3456"}`,
+		},
+		{
+			name:        "正常系: 値のパターンに一致した部分をマスクする",
+			patterns:    DefaultMaskPatterns,
+			contentType: "application/json",
+			body:        `{"note":"連絡先 taro@example.com / 090-1234-5678","card":"4111 1111 1111 1111","jwt":"eyJhbGciOiJIUzI1NiJ9.eyJzdWIiOiIxIn0.sig","period":"2024-04-01"}`,
+			expected:    `{"card":"****1111","jwt":"********","note":"連絡先 ******** / ****5678","period":"2024-04-01"}`,
+		},
+		{
+			name:        "正常系: Luhn チェックを通らない数字列はマスクしない",
+			patterns:    DefaultMaskPatterns,
+			contentType: "application/json",
+			body:        `{"id":"1234567890123456"}`,
+			expected:    `{"id":"1234567890123456"}`,
+		},
+		{
+			name:        "正常系: フォームの値をマスクする",
+			fields:      []string{"password"},
+			patterns:    DefaultMaskPatterns,
+			contentType: "application/x-www-form-urlencoded",
+			body:        `email=taro%40example.com&password=p%40ss&title=Go`,
+			expected:    `email=%2A%2A%2A%2A%2A%2A%2A%2A&password=%2A%2A%2A%2A%2A%2A%2A%2A&title=Go`,
+		},
+		{
+			name:        "正常系: 切り詰められたJSONもフィールド名でマスクする",
+			fields:      []string{"password"},
+			patterns:    DefaultMaskPatterns,
+			contentType: "application/json",
+			body:        `{"title":"Go","password":"p@ss","note":"taro@exam`,
+			expected:    `{"title":"Go","password":"********","note":"taro@exam`,
+		},
+		{
+			name:        "正常系: 大きな数値の精度と整数の表記を保つ",
+			fields:      []string{"password"},
+			patterns:    []MaskPattern{},
+			contentType: "application/json",
+			body:        `{"id":9007199254740993,"count":10000000,"rate":0.25,"password":"p@ss"}`,
+			expected:    `{"count":10000000,"id":9007199254740993,"password":"********","rate":0.25}`,
+		},
+		{
+			name:        "正常系: JSONパスで指定した大きな数値も末尾を残してマスクする",
+			paths:       []MaskPath{{Path: "$.account", KeepLast: 4}},
+			patterns:    []MaskPattern{},
+			contentType: "application/json; charset=UTF-8",
+			body:        `{"account":12345678901234567890}`,
+			expected:    `{"account":"****7890"}`,
+		},
+		{
+			name:        "正常系: PDFのボディは種類とサイズだけを出力する",
+			fields:      []string{"password"},
+			patterns:    DefaultMaskPatterns,
+			contentType: "application/pdf",
+			body:        "%PDF-1.7\n\x00\xff",
+			expected:    `[binary body omitted: application/pdf, 11 bytes]`,
+		},
+		{
+			name:        "正常系: xlsxのボディは種類とサイズだけを出力する",
+			patterns:    DefaultMaskPatterns,
+			contentType: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+			body:        "PK\x03\x04",
+			expected:    `[binary body omitted: application/vnd.openxmlformats-officedocument.spreadsheetml.sheet, 4 bytes]`,
+		},
+		{
+			name:        "正常系: Content-Typeがないボディはテキストとしてマスクする",
+			fields:      []string{"password"},
+			patterns:    []MaskPattern{},
+			contentType: "",
+			body:        `{"password":"p@ss"}`,
+			expected:    `{"password":"********"}`,
+		},
+		{
+			name:        "正常系: 後ろに余分なデータがあるJSONはテキストとしてマスクする",
+			fields:      []string{"password"},
+			patterns:    []MaskPattern{},
+			contentType: "application/json",
+			body:        `{"password":"p@ss"} trailing`,
+			expected:    `{"password":"********"} trailing`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := newMasker(tt.fields, tt.paths, tt.patterns)
+			require.NoError(t, err)
+
+			got := m.maskBody([]byte(tt.body), tt.contentType)
+
+			assert.Equal(t, tt.expected, string(got))
+		})
+	}
+}
+
+func TestMasker_MaskURI(t *testing.T) {
+	m, err := newMasker([]string{"token"}, nil, DefaultMaskPatterns)
+	require.NoError(t, err)
+
+	got := m.maskURI("/users/taro@example.com?token=abc&page=2")
+
+	assert.Equal(t, "/users/********?page=2&token=%2A%2A%2A%2A%2A%2A%2A%2A", got)
+}
+
+func TestMaskSecretPaths(t *testing.T) {
+	tests := []struct {
+		name     string
+		uri      string
+		expected string
+	}{
+		{
+			name:     "正常系: 共有リンクのトークンをマスクする",
+			uri:      "/share/abc123",
+			expected: "/share/********",
+		},
+		{
+			name:     "正常系: トークンより後ろのパスとクエリ文字列は残す",
+			uri:      "/share/abc123/resume.pdf?download=1",
+			expected: "/share/********/resume.pdf?download=1",
+		},
+		{
+			name:     "正常系: 対象でないパスはそのまま返す",
+			uri:      "/me/share-links/1",
+			expected: "/me/share-links/1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, maskSecretPaths(tt.uri, DefaultSecretPathPrefixes))
+		})
+	}
+}
+
+func TestParseJSONPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		expected []pathSegment
+		wantErr  bool
+	}{
+		{
+			name: "正常系: キー・ワイルドカード・インデックス",
+			path: "$.users[*].emails[0]",
+			expected: []pathSegment{
+				{key: "users"},
+				{wildcard: true},
+				{key: "emails"},
+				{index: 0, isIndex: true},
+			},
+		},
+		{
+			name:     "正常系: ブラケット記法のキー",
+			path:     "$['a.b']",
+			expected: []pathSegment{{key: "a.b"}},
+		},
+		{
+			name:    "異常系: $ で始まらない",
+			path:    "users.email",
+			wantErr: true,
+		},
+		{
+			name:    "異常系: 閉じ括弧がない",
+			path:    "$.users[0",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseJSONPath(tt.path)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
@@ -1,16 +1,17 @@
 package middleware
 
 import (
+	"bufio"
 	"bytes"
-	"encoding/json"
 	"io"
+	"log/slog"
+	"net"
 	"net/http"
 	"strings"
 	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
-	"github.com/labstack/gommon/log"
 )
 
 // BodyDumpConfig は BodyDumpMiddleware の設定です
@@ -19,7 +20,7 @@ type BodyDumpConfig struct {
 	Skipper middleware.Skipper
 
 	// MaxBodySize はログに出力するボディの最大サイズです（バイト単位）
-	// 0は制限なしを意味します
+	// 0 の場合は DefaultBodyDumpConfig.MaxBodySize（10KB）を使います。ボディ全体を出力する設定はありません
 	MaxBodySize int64
 
 	// ExcludeURLs はボディをログに出力しないURLパターンのリストです
@@ -28,17 +29,44 @@ type BodyDumpConfig struct {
 	// MaskFields は機密情報をマスクするフィールド名のリストです
 	MaskFields []string
 
-	// Logger はカスタムロガーです
-	Logger echo.Logger
+	// MaskPaths は JSON パスで指定したマスクルールのリストです
+	MaskPaths []MaskPath
+
+	// MaskPatterns は値の中身で判定するマスクルールのリストです
+	// nil の場合は DefaultMaskPatterns を使い、空のスライスを渡すと無効になります
+	MaskPatterns []MaskPattern
+
+	// MaskHeaders は値をマスクするヘッダー名のリストです（大文字・小文字は区別しません）
+	// DefaultMaskHeaders は指定の有無にかかわらず常にマスクします
+	MaskHeaders []string
+
+	// SecretPathPrefixes は直後のパスの要素を秘密の値としてマスクするパスの前方一致のリストです
+	// nil の場合は DefaultSecretPathPrefixes を使います
+	SecretPathPrefixes []string
+
+	// Logger は出力先のロガーです（nil の場合は slog.Default()）
+	Logger *slog.Logger
 }
 
 // DefaultBodyDumpConfig はデフォルトの設定です
 var DefaultBodyDumpConfig = BodyDumpConfig{
-	Skipper:     middleware.DefaultSkipper,
-	MaxBodySize: 1024 * 10, // 10KB
-	ExcludeURLs: []string{},
-	MaskFields:  []string{"password", "token", "secret", "authorization", "api_key"},
-	Logger:      nil,
+	Skipper:      middleware.DefaultSkipper,
+	MaxBodySize:  1024 * 10, // 10KB
+	ExcludeURLs:  []string{},
+	MaskFields:   []string{"password", "token", "secret", "authorization", "api_key"},
+	MaskPaths:    []MaskPath{},
+	MaskPatterns: DefaultMaskPatterns,
+	MaskHeaders:  []string{},
+	Logger:       nil,
+}
+
+// DefaultMaskHeaders は常にマスクする認証情報のヘッダーです
+var DefaultMaskHeaders = []string{
+	echo.HeaderAuthorization,
+	echo.HeaderCookie,
+	echo.HeaderSetCookie,
+	// パスワード付きの共有リンクの閲覧に使うパスワード
+	"X-Share-Password",
 }
 
 // BodyDump はリクエストとレスポンスのボディをログに出力するミドルウェアです
@@ -53,11 +81,29 @@ func BodyDumpWithConfig(config BodyDumpConfig) echo.MiddlewareFunc {
 		config.Skipper = DefaultBodyDumpConfig.Skipper
 	}
 	if config.Logger == nil {
-		config.Logger = log.New("body-dump")
+		config.Logger = slog.Default()
 	}
 	if config.MaxBodySize == 0 {
 		config.MaxBodySize = DefaultBodyDumpConfig.MaxBodySize
 	}
+	if config.MaskFields == nil {
+		config.MaskFields = DefaultBodyDumpConfig.MaskFields
+	}
+	if config.MaskPatterns == nil {
+		config.MaskPatterns = DefaultBodyDumpConfig.MaskPatterns
+	}
+	if config.SecretPathPrefixes == nil {
+		config.SecretPathPrefixes = DefaultSecretPathPrefixes
+	}
+	maskHeaders := make(map[string]struct{}, len(DefaultMaskHeaders)+len(config.MaskHeaders))
+	for _, header := range append(append([]string{}, DefaultMaskHeaders...), config.MaskHeaders...) {
+		maskHeaders[http.CanonicalHeaderKey(header)] = struct{}{}
+	}
+
+	masker, err := newMasker(config.MaskFields, config.MaskPaths, config.MaskPatterns)
+	if err != nil {
+		panic("body dump: " + err.Error())
+	}
 
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
@@ -72,168 +118,184 @@ func BodyDumpWithConfig(config BodyDumpConfig) echo.MiddlewareFunc {
 				}
 			}
 
-			// リクエストボディの読み取りと復元
-			var reqBody []byte
-			if c.Request().Body != nil {
-				// リクエストボディの読み取り
-				limitedReader := io.LimitReader(c.Request().Body, config.MaxBodySize)
-				reqBody, _ = io.ReadAll(limitedReader)
-
-				// リクエストボディの復元
-				c.Request().Body = io.NopCloser(bytes.NewBuffer(reqBody))
+			// リクエストボディは読み取られた分だけ上限まで複製し、ハンドラーには全体をそのまま渡す
+			reqBody := newLimitedBuffer(config.MaxBodySize)
+			var reqTee *teeReadCloser
+			if c.Request().Body != nil && c.Request().Body != http.NoBody {
+				reqTee = &teeReadCloser{
+					Reader: io.TeeReader(c.Request().Body, reqBody),
+					Closer: c.Request().Body,
+				}
+				c.Request().Body = reqTee
 			}
 
-			// レスポンスボディをキャプチャするためのレスポンスライター
-			resBody := new(bytes.Buffer)
-			mw := io.MultiWriter(c.Response().Writer, resBody)
+			// レスポンスボディも上限までのみキャプチャする（全体をメモリに保持しない）
+			resBody := newLimitedBuffer(config.MaxBodySize)
 			writer := &bodyDumpResponseWriter{
 				ResponseWriter: c.Response().Writer,
-				Writer:         mw,
+				body:           resBody,
 			}
 			c.Response().Writer = writer
+			defer func() { c.Response().Writer = writer.ResponseWriter }()
 
 			// リクエスト開始時間
 			start := time.Now()
 
 			// 次のハンドラを実行
-			err := next(c)
+			// エラーはここでレスポンスに変換し、確定したステータスとボディをログに出力する
+			if err := next(c); err != nil {
+				c.Error(err)
+			}
 
 			// レスポンス時間
 			duration := time.Since(start)
 
+			// ハンドラーが読まなかったボディもログの上限までは読み取る
+			if reqTee != nil {
+				_, _ = io.Copy(io.Discard, io.LimitReader(reqTee, reqBody.Remaining()))
+			}
+
 			// リクエスト情報
 			req := c.Request()
 			res := c.Response()
 
 			// リクエストとレスポンスのボディをマスク処理
-			maskedReqBody := maskSensitiveData(reqBody, config.MaskFields)
-			maskedResBody := maskSensitiveData(resBody.Bytes(), config.MaskFields)
+			maskedReqBody := masker.maskBody(reqBody.Bytes(), req.Header.Get(echo.HeaderContentType))
+			maskedResBody := masker.maskBody(resBody.Bytes(), res.Header().Get(echo.HeaderContentType))
 
 			// ログ出力
-			logData := map[string]interface{}{
-				"time":             time.Now().Format(time.RFC3339),
-				"remote_ip":        c.RealIP(),
-				"host":             req.Host,
-				"method":           req.Method,
-				"uri":              req.RequestURI,
-				"user_agent":       req.UserAgent(),
-				"status":           res.Status,
-				"duration":         duration.String(),
-				"duration_ms":      float64(duration.Nanoseconds()) / 1e6,
-				"request_headers":  formatHeaders(req.Header),
-				"response_headers": formatHeaders(res.Header()),
+			attrs := []any{
+				slog.String("remote_ip", c.RealIP()),
+				slog.String("host", req.Host),
+				slog.String("method", req.Method),
+				slog.String("uri", masker.maskURI(maskSecretPaths(req.RequestURI, config.SecretPathPrefixes))),
+				slog.String("user_agent", req.UserAgent()),
+				slog.Int("status", res.Status),
+				slog.Float64("duration_ms", float64(duration.Nanoseconds())/1e6),
+				slog.Any("request_headers", formatHeaders(req.Header, maskHeaders)),
+				slog.Any("response_headers", formatHeaders(res.Header(), maskHeaders)),
 			}
-
-			// Content-Typeがapplication/jsonの場合は整形して出力
-			reqContentType := req.Header.Get("Content-Type")
-			resContentType := res.Header().Get("Content-Type")
-
-			if strings.Contains(reqContentType, "application/json") && len(maskedReqBody) > 0 {
-				var prettyJSON bytes.Buffer
-				if err := json.Indent(&prettyJSON, maskedReqBody, "", "  "); err == nil {
-					logData["request_body"] = prettyJSON.String()
-				} else {
-					logData["request_body"] = string(maskedReqBody)
-				}
-			} else if len(maskedReqBody) > 0 {
-				logData["request_body"] = string(maskedReqBody)
+			if len(maskedReqBody) > 0 {
+				attrs = append(attrs, slog.String("request_body", string(maskedReqBody)))
 			}
-
-			if strings.Contains(resContentType, "application/json") && len(maskedResBody) > 0 {
-				var prettyJSON bytes.Buffer
-				if err := json.Indent(&prettyJSON, maskedResBody, "", "  "); err == nil {
-					logData["response_body"] = prettyJSON.String()
-				} else {
-					logData["response_body"] = string(maskedResBody)
-				}
-			} else if len(maskedResBody) > 0 {
-				logData["response_body"] = string(maskedResBody)
+			if reqBody.Truncated() {
+				attrs = append(attrs, slog.Bool("request_body_truncated", true))
+			}
+			if len(maskedResBody) > 0 {
+				attrs = append(attrs, slog.String("response_body", string(maskedResBody)))
+			}
+			if resBody.Truncated() {
+				attrs = append(attrs, slog.Bool("response_body_truncated", true))
 			}
 
 			// ステータスコードに応じてログレベルを変更
+			level := slog.LevelInfo
 			if res.Status >= 500 {
-				config.Logger.Errorf("%+v", logData)
+				level = slog.LevelError
 			} else if res.Status >= 400 {
-				config.Logger.Warnf("%+v", logData)
-			} else {
-				config.Logger.Infof("%+v", logData)
+				level = slog.LevelWarn
 			}
+			config.Logger.Log(req.Context(), level, "body dump", attrs...)
 
-			return err
+			return nil
 		}
 	}
 }
 
 // bodyDumpResponseWriter はレスポンスボディをキャプチャするためのラッパーです
+// Flush / Hijack は元の ResponseWriter に委譲するため、ストリーミングや SSE もそのまま動作します
 type bodyDumpResponseWriter struct {
 	http.ResponseWriter
-	Writer io.Writer
+	body *limitedBuffer
 }
 
 func (w *bodyDumpResponseWriter) Write(b []byte) (int, error) {
-	return w.Writer.Write(b)
+	n, err := w.ResponseWriter.Write(b)
+	// クライアントに書き込めた分だけキャプチャする
+	_, _ = w.body.Write(b[:n])
+	return n, err
 }
 
 func (w *bodyDumpResponseWriter) Header() http.Header {
 	return w.ResponseWriter.Header()
 }
 
-// maskSensitiveData は機密データをマスクする関数です
-func maskSensitiveData(data []byte, maskFields []string) []byte {
-	if len(data) == 0 {
-		return data
-	}
+// Flush implements http.Flusher.
+func (w *bodyDumpResponseWriter) Flush() {
+	_ = http.NewResponseController(w.ResponseWriter).Flush()
+}
 
-	// JSONデータかどうかをチェック
-	var jsonData map[string]interface{}
-	if err := json.Unmarshal(data, &jsonData); err != nil {
-		// JSONでない場合はそのまま返す
-		return data
-	}
+// Hijack implements http.Hijacker.
+func (w *bodyDumpResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return http.NewResponseController(w.ResponseWriter).Hijack()
+}
 
-	// 機密フィールドをマスク
-	for _, field := range maskFields {
-		maskJSONField(jsonData, field, "********")
-	}
+// Unwrap は http.ResponseController が元の ResponseWriter を辿れるようにします
+func (w *bodyDumpResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
 
-	// マスク済みのJSONを返す
-	maskedData, err := json.Marshal(jsonData)
-	if err != nil {
-		return data // エラーが発生した場合は元のデータを返す
-	}
+// teeReadCloser は読み取った内容を複製しつつ、Close は元のボディに委譲します
+type teeReadCloser struct {
+	io.Reader
+	io.Closer
+}
 
-	return maskedData
+// limitedBuffer は上限までのみ保持し、超えた分は捨てる io.Writer です
+// 書き込み側には常に全量を書き込んだと返すため、TeeReader や MultiWriter を止めません
+type limitedBuffer struct {
+	buf       bytes.Buffer
+	limit     int64
+	truncated bool
 }
 
-// maskJSONField はJSONオブジェクト内の特定のフィールドをマスクする関数です
-func maskJSONField(data map[string]interface{}, field, mask string) {
-	for key, value := range data {
-		if strings.ToLower(key) == strings.ToLower(field) {
-			data[key] = mask
-			continue
-		}
+func newLimitedBuffer(limit int64) *limitedBuffer {
+	return &limitedBuffer{limit: limit}
+}
 
-		// ネストされたオブジェクトを再帰的に処理
-		switch v := value.(type) {
-		case map[string]interface{}:
-			maskJSONField(v, field, mask)
-		case []interface{}:
-			for _, item := range v {
-				if mapItem, ok := item.(map[string]interface{}); ok {
-					maskJSONField(mapItem, field, mask)
-				}
-			}
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	remaining := b.limit - int64(b.buf.Len())
+	if remaining <= 0 {
+		if len(p) > 0 {
+			b.truncated = true
 		}
+		return len(p), nil
 	}
+	if int64(len(p)) > remaining {
+		b.buf.Write(p[:remaining])
+		b.truncated = true
+		return len(p), nil
+	}
+	b.buf.Write(p)
+	return len(p), nil
+}
+
+// Remaining は上限までに保持できる残りのバイト数を返します
+// 上限を超えたかどうかを判定できるよう、1バイト余分に返します
+func (b *limitedBuffer) Remaining() int64 {
+	if b.truncated {
+		return 0
+	}
+	return b.limit - int64(b.buf.Len()) + 1
+}
+
+// Bytes は保持している内容を返します
+func (b *limitedBuffer) Bytes() []byte {
+	return b.buf.Bytes()
+}
+
+// Truncated は上限を超えて切り捨てた内容があるかを返します
+func (b *limitedBuffer) Truncated() bool {
+	return b.truncated
 }
 
 // formatHeaders はヘッダーを文字列に整形する関数です
-func formatHeaders(headers http.Header) map[string]string {
+// maskHeaders に含まれるヘッダー（正規化したヘッダー名）の値はマスクします
+func formatHeaders(headers http.Header, maskHeaders map[string]struct{}) map[string]string {
 	result := make(map[string]string)
 	for key, values := range headers {
-		// 認証ヘッダーはマスク
-		if strings.ToLower(key) == "authorization" || strings.ToLower(key) == "cookie" {
-			result[key] = "********"
+		if _, ok := maskHeaders[http.CanonicalHeaderKey(key)]; ok {
+			result[key] = maskString
 		} else {
 			result[key] = strings.Join(values, ", ")
 		}
@@ -0,0 +1,360 @@
+package middleware_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	appmiddleware "stackies/backend/application"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// parseLogs は JSON 形式のログを1行ずつパースします
+func parseLogs(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+	var logs []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		logs = append(logs, entry)
+	}
+	return logs
+}
+
+func TestBodyDumpWithConfig(t *testing.T) {
+	tests := []struct {
+		name            string
+		config          appmiddleware.BodyDumpConfig
+		path            string
+		requestBody     string
+		handler         echo.HandlerFunc
+		expectedStatus  int
+		expectedLogs    int
+		expectedLevel   string
+		expectedReqBody string
+		expectedResBody string
+	}{
+		{
+			name:        "正常系: 機密フィールドをマスクして出力する",
+			config:      appmiddleware.BodyDumpConfig{},
+			path:        "/experiences",
+			requestBody: `{"title":"Go","password":"p@ss"}`,
+			handler: func(c echo.Context) error {
+				return c.JSON(http.StatusCreated, map[string]string{"title": "Go", "token": "abc"})
+			},
+			expectedStatus:  http.StatusCreated,
+			expectedLogs:    1,
+			expectedLevel:   "INFO",
+			expectedReqBody: `{"password":"********","title":"Go"}`,
+			expectedResBody: `{"title":"Go","token":"********"}`,
+		},
+		{
+			name:        "正常系: MaskFields を指定した場合はそのフィールドのみマスクする",
+			config:      appmiddleware.BodyDumpConfig{MaskFields: []string{"title"}},
+			path:        "/experiences",
+			requestBody: `{"title":"Go","password":"p@ss"}`,
+			handler: func(c echo.Context) error {
+				return c.NoContent(http.StatusNoContent)
+			},
+			expectedStatus:  http.StatusNoContent,
+			expectedLogs:    1,
+			expectedLevel:   "INFO",
+			expectedReqBody: `{"password":"p@ss","title":"********"}`,
+		},
+		{
+			name:        "正常系: ExcludeURLs に一致するパスは出力しない",
+			config:      appmiddleware.BodyDumpConfig{ExcludeURLs: []string{"/callback"}},
+			path:        "/callback",
+			requestBody: `{"code":"xxx"}`,
+			handler: func(c echo.Context) error {
+				return c.JSON(http.StatusOK, map[string]string{"access_token": "xxx"})
+			},
+			expectedStatus: http.StatusOK,
+			expectedLogs:   0,
+		},
+		{
+			name:        "正常系: Skipper が true の場合は出力しない",
+			config:      appmiddleware.BodyDumpConfig{Skipper: func(echo.Context) bool { return true }},
+			path:        "/experiences",
+			requestBody: `{"title":"Go"}`,
+			handler: func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			},
+			expectedStatus: http.StatusOK,
+			expectedLogs:   0,
+		},
+		{
+			name:        "正常系: ハンドラーが読まなかったボディも MaxBodySize まで出力する",
+			config:      appmiddleware.BodyDumpConfig{MaxBodySize: 5},
+			path:        "/experiences",
+			requestBody: `abcdefghij`,
+			handler: func(c echo.Context) error {
+				return c.String(http.StatusOK, "ok")
+			},
+			expectedStatus:  http.StatusOK,
+			expectedLogs:    1,
+			expectedLevel:   "INFO",
+			expectedReqBody: `abcde`,
+			expectedResBody: `ok`,
+		},
+		{
+			name:        "異常系: ハンドラーのエラーはレスポンスに変換して WARN で出力する",
+			config:      appmiddleware.BodyDumpConfig{},
+			path:        "/experiences",
+			requestBody: `{"title":"Go"}`,
+			handler: func(c echo.Context) error {
+				return echo.NewHTTPError(http.StatusBadRequest, "invalid")
+			},
+			expectedStatus:  http.StatusBadRequest,
+			expectedLogs:    1,
+			expectedLevel:   "WARN",
+			expectedReqBody: `{"title":"Go"}`,
+			expectedResBody: `{"message":"invalid"}`,
+		},
+		{
+			name:        "異常系: 500系は ERROR で出力する",
+			config:      appmiddleware.BodyDumpConfig{},
+			path:        "/experiences",
+			requestBody: ``,
+			handler: func(c echo.Context) error {
+				return c.JSON(http.StatusInternalServerError, map[string]string{"message": "db error"})
+			},
+			expectedStatus:  http.StatusInternalServerError,
+			expectedLogs:    1,
+			expectedLevel:   "ERROR",
+			expectedResBody: `{"message":"db error"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			tt.config.Logger = slog.New(slog.NewJSONHandler(buf, nil))
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			err := appmiddleware.BodyDumpWithConfig(tt.config)(tt.handler)(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			logs := parseLogs(t, buf)
+			require.Len(t, logs, tt.expectedLogs)
+			if tt.expectedLogs == 0 {
+				return
+			}
+
+			entry := logs[0]
+			assert.Equal(t, tt.expectedLevel, entry["level"])
+			assert.Equal(t, float64(tt.expectedStatus), entry["status"])
+			if tt.expectedReqBody != "" {
+				assert.Equal(t, tt.expectedReqBody, strings.TrimSpace(entry["request_body"].(string)))
+			} else {
+				assert.NotContains(t, entry, "request_body")
+			}
+			if tt.expectedResBody != "" {
+				assert.Equal(t, tt.expectedResBody, strings.TrimSpace(entry["response_body"].(string)))
+			}
+		})
+	}
+}
+
+func TestBodyDumpWithConfig_PassesBodyToHandler(t *testing.T) {
+	buf := new(bytes.Buffer)
+	middleware := appmiddleware.BodyDumpWithConfig(appmiddleware.BodyDumpConfig{
+		Logger: slog.New(slog.NewJSONHandler(buf, nil)),
+	})
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/experiences", strings.NewReader(`{"title":"Go"}`))
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	var received []byte
+	err := middleware(func(c echo.Context) error {
+		var err error
+		received, err = io.ReadAll(c.Request().Body)
+		if err != nil {
+			return err
+		}
+		return c.NoContent(http.StatusNoContent)
+	})(c)
+
+	// ログ出力のために読み取ったボディがハンドラーにも渡ること
+	assert.NoError(t, err)
+	assert.Equal(t, `{"title":"Go"}`, string(received))
+}
+
+func TestBodyDumpWithConfig_MasksHeaders(t *testing.T) {
+	buf := new(bytes.Buffer)
+	middleware := appmiddleware.BodyDumpWithConfig(appmiddleware.BodyDumpConfig{
+		Logger: slog.New(slog.NewJSONHandler(buf, nil)),
+	})
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/experiences", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer secret-token")
+	req.Header.Set("Cookie", "session=secret")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := middleware(func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})(c)
+	assert.NoError(t, err)
+
+	logs := parseLogs(t, buf)
+	require.Len(t, logs, 1)
+	headers := logs[0]["request_headers"].(map[string]interface{})
+	assert.Equal(t, "********", headers[echo.HeaderAuthorization])
+	assert.Equal(t, "********", headers["Cookie"])
+	assert.NotContains(t, buf.String(), "secret")
+}
+
+func TestBodyDumpWithConfig_MasksSharePasswordAndToken(t *testing.T) {
+	buf := new(bytes.Buffer)
+	middleware := appmiddleware.BodyDumpWithConfig(appmiddleware.BodyDumpConfig{
+		MaskHeaders: []string{"x-api-key"},
+		Logger:      slog.New(slog.NewJSONHandler(buf, nil)),
+	})
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/share/share-token-123/resume.pdf?download=1", nil)
+	req.Header.Set("X-Share-Password", "share-password-456")
+	req.Header.Set("X-Api-Key", "api-key-789")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := middleware(func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})(c)
+	assert.NoError(t, err)
+
+	logs := parseLogs(t, buf)
+	require.Len(t, logs, 1)
+	assert.Equal(t, "/share/********/resume.pdf?download=1", logs[0]["uri"])
+	headers := logs[0]["request_headers"].(map[string]interface{})
+	assert.Equal(t, "********", headers["X-Share-Password"])
+	// 設定したヘッダーは大文字・小文字を区別せずにマスクすること
+	assert.Equal(t, "********", headers["X-Api-Key"])
+	assert.NotContains(t, buf.String(), "share-token-123")
+	assert.NotContains(t, buf.String(), "share-password-456")
+	assert.NotContains(t, buf.String(), "api-key-789")
+}
+
+func TestBodyDumpWithConfig_LargeBody(t *testing.T) {
+	buf := new(bytes.Buffer)
+	middleware := appmiddleware.BodyDumpWithConfig(appmiddleware.BodyDumpConfig{
+		MaxBodySize: 10,
+		Logger:      slog.New(slog.NewJSONHandler(buf, nil)),
+	})
+
+	requestBody := strings.Repeat("a", 100)
+	responseBody := strings.Repeat("b", 100)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/experiences", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	var received []byte
+	err := middleware(func(c echo.Context) error {
+		var err error
+		received, err = io.ReadAll(c.Request().Body)
+		if err != nil {
+			return err
+		}
+		return c.String(http.StatusOK, responseBody)
+	})(c)
+	assert.NoError(t, err)
+
+	// 上限を超えてもハンドラーとクライアントには全体が渡ること
+	assert.Equal(t, requestBody, string(received))
+	assert.Equal(t, responseBody, rec.Body.String())
+
+	// ログには上限までのみ出力されること
+	logs := parseLogs(t, buf)
+	require.Len(t, logs, 1)
+	assert.Equal(t, strings.Repeat("a", 10), logs[0]["request_body"])
+	assert.Equal(t, true, logs[0]["request_body_truncated"])
+	assert.Equal(t, strings.Repeat("b", 10), logs[0]["response_body"])
+	assert.Equal(t, true, logs[0]["response_body_truncated"])
+}
+
+func TestBodyDumpWithConfig_Flush(t *testing.T) {
+	buf := new(bytes.Buffer)
+	middleware := appmiddleware.BodyDumpWithConfig(appmiddleware.BodyDumpConfig{
+		Logger: slog.New(slog.NewJSONHandler(buf, nil)),
+	})
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/events", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := middleware(func(c echo.Context) error {
+		c.Response().Header().Set(echo.HeaderContentType, "text/event-stream")
+		c.Response().WriteHeader(http.StatusOK)
+		for _, event := range []string{"data: 1\n\n", "data: 2\n\n"} {
+			if _, err := c.Response().Write([]byte(event)); err != nil {
+				return err
+			}
+			c.Response().Flush()
+		}
+		return nil
+	})(c)
+
+	// SSE のようにイベントごとに Flush できること
+	assert.NoError(t, err)
+	assert.True(t, rec.Flushed)
+	assert.Equal(t, "data: 1\n\ndata: 2\n\n", rec.Body.String())
+}
+
+// hijackableRecorder は Hijack に対応した ResponseRecorder です
+type hijackableRecorder struct {
+	*httptest.ResponseRecorder
+	hijacked bool
+}
+
+func (r *hijackableRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	r.hijacked = true
+	return nil, nil, nil
+}
+
+func TestBodyDumpWithConfig_Hijack(t *testing.T) {
+	buf := new(bytes.Buffer)
+	middleware := appmiddleware.BodyDumpWithConfig(appmiddleware.BodyDumpConfig{
+		Logger: slog.New(slog.NewJSONHandler(buf, nil)),
+	})
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/ws", nil)
+	rec := &hijackableRecorder{ResponseRecorder: httptest.NewRecorder()}
+	c := e.NewContext(req, rec)
+
+	err := middleware(func(c echo.Context) error {
+		_, _, err := c.Response().Hijack()
+		return err
+	})(c)
+
+	// WebSocket などで元のコネクションを取得できること
+	assert.NoError(t, err)
+	assert.True(t, rec.hijacked)
+}
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"log/slog"
+	"time"
+
+	"stackies/backend/infra/logging"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ContextKeyUserID は認証済みユーザーのID（JWT の sub）を echo.Context に保存するキーです
+const ContextKeyUserID = "user_id"
+
+// RequestLogger はリクエストごとにアクセスログを構造化ログとして出力するミドルウェアです
+// ルートをコンテキストに積むため、下位の層のログにも同じフィールドが出力されます
+// リクエストIDを出力するには RequestID ミドルウェアより内側で使います
+func RequestLogger(logger *slog.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+
+			req := c.Request()
+			ctx := logging.ContextWithAttrs(req.Context(), slog.String("route", c.Path()))
+			c.SetRequest(req.WithContext(ctx))
+
+			err := next(c)
+			if err != nil {
+				// ステータスコードを確定させてからログを出力する
+				c.Error(err)
+			}
+
+			res := c.Response()
+			attrs := []any{
+				slog.String("method", req.Method),
+				// 共有リンクのトークンなど、パスに含まれる秘密の値はマスクする
+				slog.String("uri", maskSecretPaths(req.RequestURI, DefaultSecretPathPrefixes)),
+				slog.Int("status", res.Status),
+				slog.Float64("latency_ms", float64(time.Since(start).Nanoseconds())/1e6),
+				slog.String("remote_ip", c.RealIP()),
+				slog.String("user_agent", req.UserAgent()),
+				slog.Int64("bytes_out", res.Size),
+			}
+			if userID, ok := c.Get(ContextKeyUserID).(string); ok {
+				attrs = append(attrs, slog.String("user_id", userID))
+			}
+			if tenantID, ok := c.Get(ContextKeyTenantID).(string); ok {
+				attrs = append(attrs, slog.String("tenant_id", tenantID))
+			}
+			if err != nil {
+				attrs = append(attrs, slog.Any("error", err))
+			}
+
+			level := slog.LevelInfo
+			if res.Status >= 500 {
+				level = slog.LevelError
+			} else if res.Status >= 400 {
+				level = slog.LevelWarn
+			}
+			logger.Log(ctx, level, "request", attrs...)
+
+			return nil
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package middleware_test
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	appmiddleware "stackies/backend/application"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestLogger(t *testing.T) {
+	tests := []struct {
+		name        string
+		uri         string
+		expectedURI string
+		secret      string
+	}{
+		{
+			name:        "正常系: 共有リンクのトークンをマスクして出力する",
+			uri:         "/share/share-token-123/resume.pdf",
+			expectedURI: "/share/********/resume.pdf",
+			secret:      "share-token-123",
+		},
+		{
+			name:        "正常系: 対象でないパスはそのまま出力する",
+			uri:         "/experiences/1?page=2",
+			expectedURI: "/experiences/1?page=2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			middleware := appmiddleware.RequestLogger(slog.New(slog.NewJSONHandler(buf, nil)))
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, tt.uri, nil)
+			req.Header.Set("X-Share-Password", "share-password-456")
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			err := middleware(func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			})(c)
+			assert.NoError(t, err)
+
+			logs := parseLogs(t, buf)
+			require.Len(t, logs, 1)
+			assert.Equal(t, tt.expectedURI, logs[0]["uri"])
+			assert.NotContains(t, buf.String(), "share-password-456")
+			if tt.secret != "" {
+				assert.NotContains(t, buf.String(), tt.secret)
+			}
+		})
+	}
+}
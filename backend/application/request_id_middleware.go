@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"log/slog"
+	"regexp"
+
+	"stackies/backend/infra/logging"
+	"stackies/backend/usecase"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// validRequestID はクライアントから受け付ける X-Request-ID の形式です
+// ログやDBにそのまま保存するため、長さと文字種を制限します
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._-]{1,128}$`)
+
+// RequestID はリクエストIDを払い出すミドルウェアです
+// X-Request-ID ヘッダーが妥当な形式であれば引き継ぎ、なければ UUID を生成します
+// リクエストIDはレスポンスヘッダー・ログ・監査ログに出力されます
+func RequestID() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			requestID := c.Request().Header.Get(echo.HeaderXRequestID)
+			if !validRequestID.MatchString(requestID) {
+				requestID = uuid.NewString()
+			}
+			c.Response().Header().Set(echo.HeaderXRequestID, requestID)
+
+			ctx := usecase.ContextWithRequestID(c.Request().Context(), requestID)
+			ctx = logging.ContextWithAttrs(ctx, slog.String("request_id", requestID))
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	appmiddleware "stackies/backend/application"
+	"stackies/backend/usecase"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name      string
+		header    string
+		expectNew bool
+	}{
+		{
+			name:   "正常系: X-Request-ID を引き継ぐ",
+			header: "abc-123",
+		},
+		{
+			name:      "正常系: X-Request-ID がない場合は生成する",
+			header:    "",
+			expectNew: true,
+		},
+		{
+			name:      "異常系: 不正な形式の X-Request-ID は使わない",
+			header:    "abc 123\n",
+			expectNew: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set(echo.HeaderXRequestID, tt.header)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			var actor usecase.Actor
+			err := appmiddleware.RequestID()(func(c echo.Context) error {
+				actor = usecase.ActorFromContext(c.Request().Context())
+				return c.NoContent(http.StatusOK)
+			})(c)
+
+			assert.NoError(t, err)
+			requestID := rec.Header().Get(echo.HeaderXRequestID)
+			if tt.expectNew {
+				assert.Len(t, requestID, 36)
+				assert.NotEqual(t, tt.header, requestID)
+			} else {
+				assert.Equal(t, tt.header, requestID)
+			}
+			// 下位の層からもリクエストIDを参照できること
+			assert.Equal(t, requestID, actor.RequestID)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+
+	"stackies/backend/domain/model"
+	"stackies/backend/infra/logging"
+	"stackies/backend/usecase"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// ContextKeyTenantID はリクエストのテナントの ID を echo.Context に保存するキーです
+	ContextKeyTenantID = "tenant_id"
+	// ContextKeyClaims は検証済みの JWT のクレーム（jwt.MapClaims）を echo.Context に保存するキーです
+	ContextKeyClaims = "claims"
+)
+
+// TenantConfig はリクエストのテナントの決め方です
+type TenantConfig struct {
+	// BaseDomain はテナントのサブドメインの親ドメインです
+	// "stackies.example.com" の場合、"acme.stackies.example.com" へのリクエストはテナント acme になります（空の場合はサブドメインを使いません）
+	BaseDomain string
+	// DefaultTenantID はサブドメインと JWT のクレームのいずれでもテナントが決まらない場合のテナントです
+	DefaultTenantID string
+	// Claim はテナントの ID を持つ JWT のクレームです
+	Claim string
+}
+
+// Tenant はホスト名のサブドメインからリクエストのテナントを決めるミドルウェアです
+// サブドメインがない場合は DefaultTenantID とし、存在しないテナントのサブドメインには 404 を返します
+// 認証済みのリクエストでは、JWTMiddleware の後の TenantClaim で JWT のクレームと照合します
+func Tenant(config TenantConfig, tenants usecase.TenantUsecase) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			tenantID, ok := tenantSubdomain(c.Request().Host, config.BaseDomain)
+			if !ok {
+				tenantID = config.DefaultTenantID
+			}
+			if err := setTenant(c, tenants, tenantID); err != nil {
+				if errors.Is(err, usecase.ErrNotFound) {
+					return echo.NewHTTPError(http.StatusNotFound, "テナントが存在しません")
+				}
+				return err
+			}
+			return next(c)
+		}
+	}
+}
+
+// TenantClaim は JWT のクレームからリクエストのテナントを決めるミドルウェアです（JWTMiddleware の後に使う）
+// 認証済みのユーザーはクレームのテナント（クレームがない場合は DefaultTenantID）のデータだけを扱えます
+// 別のテナントのサブドメインへのリクエストと、存在しないテナントのクレームには 403 を返します
+func TenantClaim(config TenantConfig, tenants usecase.TenantUsecase) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			claims, ok := c.Get(ContextKeyClaims).(jwt.MapClaims)
+			if !ok {
+				return echo.NewHTTPError(http.StatusUnauthorized, "クレーム取得失敗")
+			}
+			tenantID, _ := claims[config.Claim].(string)
+			if tenantID == "" {
+				tenantID = config.DefaultTenantID
+			}
+
+			if subdomain, ok := tenantSubdomain(c.Request().Host, config.BaseDomain); ok && subdomain != tenantID {
+				return echo.NewHTTPError(http.StatusForbidden, "このテナントへのアクセス権限がありません")
+			}
+			if current, _ := c.Get(ContextKeyTenantID).(string); current != tenantID {
+				if err := setTenant(c, tenants, tenantID); err != nil {
+					if errors.Is(err, usecase.ErrNotFound) {
+						return echo.NewHTTPError(http.StatusForbidden, "このテナントへのアクセス権限がありません")
+					}
+					return err
+				}
+			}
+			return next(c)
+		}
+	}
+}
+
+// ShareLinkTenant は共有リンクのトークン（パスパラメーター token）からリクエストのテナントを決めるミドルウェアです
+// 認証なしの共有リンクのルートで Tenant の後に使います
+// テナントのサブドメインへのリクエストはそのテナントの共有リンクだけを開けるよう、サブドメインのテナントのままにします
+// サブドメインがない場合は共有リンクを作成したテナントにします。一致する共有リンクがない場合はテナントを変えず、ハンドラーが 404 を返します
+func ShareLinkTenant(config TenantConfig, tenants usecase.TenantUsecase) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if _, ok := tenantSubdomain(c.Request().Host, config.BaseDomain); ok {
+				return next(c)
+			}
+			ctx, err := tenants.ContextWithShareLinkTenant(c.Request().Context(), c.Param("token"))
+			if err != nil {
+				if errors.Is(err, usecase.ErrNotFound) {
+					return next(c)
+				}
+				return err
+			}
+			tenant, _ := model.TenantFromContext(ctx)
+			useTenant(c, ctx, tenant.ID)
+			return next(c)
+		}
+	}
+}
+
+// setTenant はテナントをリクエストのコンテキストに設定し、以降のログにテナントの ID を出力します
+func setTenant(c echo.Context, tenants usecase.TenantUsecase, tenantID string) error {
+	ctx, err := tenants.ContextWithTenant(c.Request().Context(), tenantID)
+	if err != nil {
+		return err
+	}
+	useTenant(c, ctx, tenantID)
+	return nil
+}
+
+// useTenant はテナントを設定したコンテキストをリクエストに使い、以降のログにテナントの ID を出力します
+func useTenant(c echo.Context, ctx context.Context, tenantID string) {
+	ctx = logging.ContextWithAttrs(ctx, slog.String("tenant_id", tenantID))
+	c.SetRequest(c.Request().WithContext(ctx))
+	c.Set(ContextKeyTenantID, tenantID)
+}
+
+// tenantSubdomain はホスト名が baseDomain のサブドメインの場合にその部分を返します
+// "acme.stackies.example.com:8080" と "stackies.example.com" の場合は "acme" です
+func tenantSubdomain(host, baseDomain string) (string, bool) {
+	if baseDomain == "" {
+		return "", false
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	subdomain, ok := strings.CutSuffix(host, "."+strings.ToLower(baseDomain))
+	if !ok || subdomain == "" {
+		return "", false
+	}
+	return subdomain, true
+}
@@ -0,0 +1,253 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	appmiddleware "stackies/backend/application"
+	"stackies/backend/domain/model"
+	"stackies/backend/usecase"
+	mock_usecase "stackies/backend/usecase/mock"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+var tenantConfig = appmiddleware.TenantConfig{
+	BaseDomain:      "stackies.example.com",
+	DefaultTenantID: "default",
+	Claim:           "custom:tenant_id",
+}
+
+// contextWithTenant はテナントの ID だけを設定したコンテキストを返します
+func contextWithTenant(ctx context.Context, tenantID string) (context.Context, error) {
+	return model.ContextWithTenant(ctx, model.Tenant{ID: tenantID}), nil
+}
+
+// runTenantMiddleware はミドルウェアを実行し、ハンドラーで参照したテナントとレスポンスのステータスを返します
+func runTenantMiddleware(t *testing.T, c echo.Context, middleware echo.MiddlewareFunc) (string, int) {
+	t.Helper()
+	var tenantID string
+	err := middleware(func(c echo.Context) error {
+		tenant, _ := model.TenantFromContext(c.Request().Context())
+		tenantID = tenant.ID
+		return c.NoContent(http.StatusOK)
+	})(c)
+	if he, ok := err.(*echo.HTTPError); ok {
+		return "", he.Code
+	}
+	assert.NoError(t, err)
+	return tenantID, c.Response().Status
+}
+
+func TestTenant(t *testing.T) {
+	tests := []struct {
+		name           string
+		host           string
+		setupMock      func(mock *mock_usecase.MockTenantUsecase)
+		expectedTenant string
+		expectedStatus int
+	}{
+		{
+			name: "正常系: サブドメインのテナント",
+			host: "acme.stackies.example.com:443",
+			setupMock: func(mock *mock_usecase.MockTenantUsecase) {
+				mock.EXPECT().ContextWithTenant(gomock.Any(), "acme").DoAndReturn(contextWithTenant)
+			},
+			expectedTenant: "acme",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "正常系: サブドメインがない場合は既定のテナント",
+			host: "stackies.example.com",
+			setupMock: func(mock *mock_usecase.MockTenantUsecase) {
+				mock.EXPECT().ContextWithTenant(gomock.Any(), "default").DoAndReturn(contextWithTenant)
+			},
+			expectedTenant: "default",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "異常系: 存在しないテナントのサブドメイン",
+			host: "unknown.stackies.example.com",
+			setupMock: func(mock *mock_usecase.MockTenantUsecase) {
+				mock.EXPECT().ContextWithTenant(gomock.Any(), "unknown").Return(nil, usecase.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/share/abc", nil)
+			req.Host = tt.host
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockTenantUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			tenantID, status := runTenantMiddleware(t, c, appmiddleware.Tenant(tenantConfig, mockUsecase))
+			assert.Equal(t, tt.expectedStatus, status)
+			assert.Equal(t, tt.expectedTenant, tenantID)
+		})
+	}
+}
+
+func TestTenantClaim(t *testing.T) {
+	tests := []struct {
+		name           string
+		host           string
+		hostTenant     string
+		claims         jwt.MapClaims
+		setupMock      func(mock *mock_usecase.MockTenantUsecase)
+		expectedTenant string
+		expectedStatus int
+	}{
+		{
+			name:       "正常系: サブドメインがない場合はクレームのテナント",
+			host:       "api.example.com",
+			hostTenant: "default",
+			claims:     jwt.MapClaims{"sub": "user-1", "custom:tenant_id": "acme"},
+			setupMock: func(mock *mock_usecase.MockTenantUsecase) {
+				mock.EXPECT().ContextWithTenant(gomock.Any(), "acme").DoAndReturn(contextWithTenant)
+			},
+			expectedTenant: "acme",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "正常系: サブドメインとクレームのテナントが一致する",
+			host:           "acme.stackies.example.com",
+			hostTenant:     "acme",
+			claims:         jwt.MapClaims{"sub": "user-1", "custom:tenant_id": "acme"},
+			setupMock:      func(mock *mock_usecase.MockTenantUsecase) {},
+			expectedTenant: "acme",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "正常系: クレームがない場合は既定のテナント",
+			host:           "api.example.com",
+			hostTenant:     "default",
+			claims:         jwt.MapClaims{"sub": "user-1"},
+			setupMock:      func(mock *mock_usecase.MockTenantUsecase) {},
+			expectedTenant: "default",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "異常系: 別のテナントのサブドメイン",
+			host:           "other.stackies.example.com",
+			hostTenant:     "other",
+			claims:         jwt.MapClaims{"sub": "user-1", "custom:tenant_id": "acme"},
+			setupMock:      func(mock *mock_usecase.MockTenantUsecase) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "異常系: クレームのないユーザーがテナントのサブドメインにアクセスする",
+			host:           "acme.stackies.example.com",
+			hostTenant:     "acme",
+			claims:         jwt.MapClaims{"sub": "user-1"},
+			setupMock:      func(mock *mock_usecase.MockTenantUsecase) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:       "異常系: 存在しないテナントのクレーム",
+			host:       "api.example.com",
+			hostTenant: "default",
+			claims:     jwt.MapClaims{"sub": "user-1", "custom:tenant_id": "removed"},
+			setupMock: func(mock *mock_usecase.MockTenantUsecase) {
+				mock.EXPECT().ContextWithTenant(gomock.Any(), "removed").Return(nil, usecase.ErrNotFound)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/me/profile", nil)
+			req.Host = tt.host
+			req = req.WithContext(model.ContextWithTenant(req.Context(), model.Tenant{ID: tt.hostTenant}))
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set(appmiddleware.ContextKeyTenantID, tt.hostTenant)
+			c.Set(appmiddleware.ContextKeyClaims, tt.claims)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockTenantUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			tenantID, status := runTenantMiddleware(t, c, appmiddleware.TenantClaim(tenantConfig, mockUsecase))
+			assert.Equal(t, tt.expectedStatus, status)
+			assert.Equal(t, tt.expectedTenant, tenantID)
+		})
+	}
+}
+
+func TestShareLinkTenant(t *testing.T) {
+	tests := []struct {
+		name           string
+		host           string
+		hostTenant     string
+		setupMock      func(mock *mock_usecase.MockTenantUsecase)
+		expectedTenant string
+	}{
+		{
+			name:       "正常系: サブドメインがない場合は共有リンクを作成したテナント",
+			host:       "api.example.com",
+			hostTenant: "default",
+			setupMock: func(mock *mock_usecase.MockTenantUsecase) {
+				mock.EXPECT().ContextWithShareLinkTenant(gomock.Any(), "share-token").DoAndReturn(func(ctx context.Context, _ string) (context.Context, error) {
+					return contextWithTenant(ctx, "tenant-b")
+				})
+			},
+			expectedTenant: "tenant-b",
+		},
+		{
+			name:           "正常系: サブドメインがある場合はサブドメインのテナントのまま",
+			host:           "acme.stackies.example.com",
+			hostTenant:     "acme",
+			setupMock:      func(mock *mock_usecase.MockTenantUsecase) {},
+			expectedTenant: "acme",
+		},
+		{
+			name:       "正常系: 一致する共有リンクがない場合はテナントを変えない",
+			host:       "api.example.com",
+			hostTenant: "default",
+			setupMock: func(mock *mock_usecase.MockTenantUsecase) {
+				mock.EXPECT().ContextWithShareLinkTenant(gomock.Any(), "share-token").Return(nil, usecase.ErrNotFound)
+			},
+			expectedTenant: "default",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/share/share-token", nil)
+			req.Host = tt.host
+			req = req.WithContext(model.ContextWithTenant(req.Context(), model.Tenant{ID: tt.hostTenant}))
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("token")
+			c.SetParamValues("share-token")
+			c.Set(appmiddleware.ContextKeyTenantID, tt.hostTenant)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockTenantUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			tenantID, status := runTenantMiddleware(t, c, appmiddleware.ShareLinkTenant(tenantConfig, mockUsecase))
+			assert.Equal(t, http.StatusOK, status)
+			assert.Equal(t, tt.expectedTenant, tenantID)
+			assert.Equal(t, tt.expectedTenant, c.Get(appmiddleware.ContextKeyTenantID))
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/labstack/echo/otelecho"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// HeaderXTraceID はレスポンスに付与するトレースIDのヘッダー名です
+const HeaderXTraceID = "X-Trace-Id"
+
+// Tracing はリクエストごとにスパンを開始するミドルウェアです
+// traceparent ヘッダーがあれば親スパンとして引き継ぎ、トレースIDをレスポンスヘッダーに付与します
+func Tracing(serviceName string) echo.MiddlewareFunc {
+	otelMiddleware := otelecho.Middleware(serviceName)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return otelMiddleware(func(c echo.Context) error {
+			if traceID := TraceID(c); traceID != "" {
+				c.Response().Header().Set(HeaderXTraceID, traceID)
+			}
+			return next(c)
+		})
+	}
+}
+
+// TraceID はリクエストのコンテキストからトレースIDを取得します
+// トレースが開始されていない場合は空文字を返します
+func TraceID(c echo.Context) string {
+	spanContext := trace.SpanContextFromContext(c.Request().Context())
+	if !spanContext.HasTraceID() {
+		return ""
+	}
+	return spanContext.TraceID().String()
+}
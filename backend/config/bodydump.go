@@ -0,0 +1,75 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+)
+
+// BodyDumpConfig リクエスト・レスポンスボディのログ出力設定
+type BodyDumpConfig struct {
+	Enabled bool
+	// MaxBodySize はログに出力するボディの最大サイズ（バイト）。0 はミドルウェアのデフォルト
+	MaxBodySize int64
+	// ExcludeURLs はボディを出力しないパス（部分一致）
+	ExcludeURLs []string
+	// MaskFields はマスクするフィールド名。未指定の場合はミドルウェアのデフォルト
+	MaskFields []string
+	// MaskPaths は JSON パスで指定するマスク対象
+	MaskPaths []BodyDumpMaskPath
+	// MaskHeaders は値をマスクするヘッダー名（ミドルウェアのデフォルトに追加する）
+	MaskHeaders []string
+}
+
+// BodyDumpMaskPath JSON パスによるマスク設定
+type BodyDumpMaskPath struct {
+	Path string
+	// KeepLast は末尾を残す文字数（0 は完全にマスク）
+	KeepLast int
+}
+
+// NewBodyDumpConfig 新しいボディダンプ設定を作成
+// 個人情報を含むため、開発環境以外ではデフォルトで無効にする
+func NewBodyDumpConfig() *BodyDumpConfig {
+	defaultEnabled := "false"
+	if getEnv("APP_ENV", "development") == "development" {
+		defaultEnabled = "true"
+	}
+
+	enabled, _ := strconv.ParseBool(getEnv("BODY_DUMP_ENABLED", defaultEnabled))
+	maxBodySize, _ := strconv.ParseInt(getEnv("BODY_DUMP_MAX_BODY_SIZE", "0"), 10, 64)
+
+	return &BodyDumpConfig{
+		Enabled:     enabled,
+		MaxBodySize: maxBodySize,
+		ExcludeURLs: splitEnv(getEnv("BODY_DUMP_EXCLUDE_URLS", "/callback")),
+		MaskFields:  splitEnv(getEnv("BODY_DUMP_MASK_FIELDS", "")),
+		MaskPaths:   parseMaskPaths(splitEnv(getEnv("BODY_DUMP_MASK_PATHS", ""))),
+		MaskHeaders: splitEnv(getEnv("BODY_DUMP_MASK_HEADERS", "")),
+	}
+}
+
+// parseMaskPaths "$.users[*].email" や "$.card_number:4"（末尾4文字を残す）の形式を解析
+func parseMaskPaths(values []string) []BodyDumpMaskPath {
+	paths := make([]BodyDumpMaskPath, 0, len(values))
+	for _, v := range values {
+		path := BodyDumpMaskPath{Path: v}
+		if i := strings.LastIndex(v, ":"); i != -1 {
+			if keepLast, err := strconv.Atoi(v[i+1:]); err == nil {
+				path = BodyDumpMaskPath{Path: v[:i], KeepLast: keepLast}
+			}
+		}
+		paths = append(paths, path)
+	}
+	return paths
+}
+
+// splitEnv カンマ区切りの環境変数を分割（空要素は除外、空文字は nil）
+func splitEnv(value string) []string {
+	var result []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -2,12 +2,16 @@ package config
 
 import (
 	"fmt"
+	"log/slog"
 	"os"
 
+	"stackies/backend/infra/logging"
+	"stackies/backend/infra/repository"
+	"stackies/backend/infra/tracing"
+
 	_ "github.com/lib/pq"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
 )
 
 // DBConfig データベース設定
@@ -31,17 +35,26 @@ func NewDBConfig() *DBConfig {
 }
 
 // ConnectDB データベースに接続
-func ConnectDB(config *DBConfig) (*gorm.DB, error) {
+func ConnectDB(config *DBConfig, logger *slog.Logger) (*gorm.DB, error) {
 	connStr := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
 		config.Host, config.Port, config.User, config.Password, config.DBName)
 
 	db, err := gorm.Open(postgres.Open(connStr), &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
+		Logger: logging.NewGormLogger(logger),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect database: %w", err)
 	}
 
+	// 各クエリをリクエストのトレースにぶら下げる
+	if err := db.Use(tracing.NewGormPlugin()); err != nil {
+		return nil, fmt.Errorf("failed to register tracing plugin: %w", err)
+	}
+	// テナントごとのデータはコンテキストのテナントのものだけを読み書きする
+	if err := db.Use(repository.NewTenantPlugin()); err != nil {
+		return nil, fmt.Errorf("failed to register tenant plugin: %w", err)
+	}
+
 	return db, nil
 }
 
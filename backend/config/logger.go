@@ -0,0 +1,63 @@
+package config
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+
+	"stackies/backend/infra/logging"
+)
+
+// ログの出力形式
+const (
+	LogFormatJSON = "json"
+	LogFormatText = "text"
+)
+
+// LoggerConfig ロガー設定
+type LoggerConfig struct {
+	Level  slog.Level
+	Format string
+}
+
+// NewLoggerConfig 新しいロガー設定を作成
+// 出力形式は ECS（CloudWatch Logs）向けに JSON、ローカル開発では text をデフォルトにする
+func NewLoggerConfig() *LoggerConfig {
+	defaultFormat := LogFormatJSON
+	if getEnv("APP_ENV", "development") == "development" {
+		defaultFormat = LogFormatText
+	}
+
+	return &LoggerConfig{
+		Level:  parseLogLevel(getEnv("LOG_LEVEL", "info")),
+		Format: getEnv("LOG_FORMAT", defaultFormat),
+	}
+}
+
+// NewLogger 設定に応じた構造化ロガーを作成
+func NewLogger(config *LoggerConfig, w io.Writer) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: config.Level}
+
+	var handler slog.Handler
+	if config.Format == LogFormatText {
+		handler = slog.NewTextHandler(w, opts)
+	} else {
+		handler = slog.NewJSONHandler(w, opts)
+	}
+
+	return slog.New(logging.NewContextHandler(handler))
+}
+
+// parseLogLevel ログレベルの文字列を slog.Level に変換（不明な値は info）
+func parseLogLevel(level string) slog.Level {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
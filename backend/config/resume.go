@@ -0,0 +1,15 @@
+package config
+
+// ResumeConfig 業務経歴書の出力設定
+type ResumeConfig struct {
+	// FontPath は PDF に埋め込む日本語の TrueType フォント（.ttf）のパス
+	FontPath string
+}
+
+// NewResumeConfig 新しい業務経歴書の出力設定を作成
+// デフォルトは Docker イメージに同梱している IPAex ゴシック
+func NewResumeConfig() *ResumeConfig {
+	return &ResumeConfig{
+		FontPath: getEnv("RESUME_FONT_PATH", "/app/fonts/ipaexg.ttf"),
+	}
+}
@@ -0,0 +1,88 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+)
+
+// トレースのエクスポーター種別
+const (
+	TraceExporterOTLP   = "otlp"
+	TraceExporterStdout = "stdout"
+	TraceExporterNone   = "none"
+)
+
+// TelemetryConfig トレース設定
+type TelemetryConfig struct {
+	ServiceName string
+	Environment string
+	// Exporter は otlp / stdout / none のいずれか
+	// otlp の送信先は OTEL_EXPORTER_OTLP_ENDPOINT 等の標準環境変数で指定する
+	Exporter string
+}
+
+// NewTelemetryConfig 新しいトレース設定を作成
+func NewTelemetryConfig() *TelemetryConfig {
+	return &TelemetryConfig{
+		ServiceName: getEnv("OTEL_SERVICE_NAME", "stackies-backend"),
+		Environment: getEnv("APP_ENV", "development"),
+		Exporter:    getEnv("OTEL_TRACES_EXPORTER", TraceExporterNone),
+	}
+}
+
+// NewSpanExporter 設定に応じたスパンエクスポーターを作成
+// none の場合は nil を返す（トレースIDの採番のみ行い、どこにも送信しない）
+func NewSpanExporter(ctx context.Context, config *TelemetryConfig) (sdktrace.SpanExporter, error) {
+	switch config.Exporter {
+	case TraceExporterOTLP:
+		return otlptracehttp.New(ctx)
+	case TraceExporterStdout:
+		return stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case TraceExporterNone, "":
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown trace exporter: %s", config.Exporter)
+	}
+}
+
+// NewTracerProvider トレーサープロバイダーを作成し、グローバルに登録
+// テストでは tracetest.NewInMemoryExporter() などを exporter に渡して利用する
+func NewTracerProvider(config *TelemetryConfig, exporter sdktrace.SpanExporter) *sdktrace.TracerProvider {
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewSchemaless(
+			semconv.ServiceName(config.ServiceName),
+			semconv.DeploymentEnvironmentName(config.Environment),
+		)),
+	}
+	if exporter != nil {
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+	tp := sdktrace.NewTracerProvider(opts...)
+
+	otel.SetTracerProvider(tp)
+	// フロントエンドから送られる W3C traceparent / baggage を引き継ぐ
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	return tp
+}
+
+// InitTracer 設定からエクスポーターとトレーサープロバイダーを初期化
+func InitTracer(ctx context.Context, config *TelemetryConfig) (*sdktrace.TracerProvider, error) {
+	exporter, err := NewSpanExporter(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create span exporter: %w", err)
+	}
+	return NewTracerProvider(config, exporter), nil
+}
@@ -0,0 +1,23 @@
+package config
+
+import "stackies/backend/domain/model"
+
+// TenantConfig マルチテナントの設定
+type TenantConfig struct {
+	// BaseDomain はテナントのサブドメインの親ドメイン（空の場合はサブドメインでテナントを決めない）
+	BaseDomain string
+	// DefaultTenantID はサブドメインと JWT のクレームのいずれでも決まらない場合のテナント
+	DefaultTenantID string
+	// Claim はテナントの ID を持つ JWT のクレーム
+	Claim string
+}
+
+// NewTenantConfig 新しいマルチテナントの設定を作成
+// デフォルトは Cognito のカスタム属性 tenant_id
+func NewTenantConfig() *TenantConfig {
+	return &TenantConfig{
+		BaseDomain:      getEnv("TENANT_BASE_DOMAIN", ""),
+		DefaultTenantID: getEnv("TENANT_DEFAULT_ID", model.DefaultTenantID),
+		Claim:           getEnv("TENANT_CLAIM", "custom:tenant_id"),
+	}
+}
@@ -0,0 +1,19 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// AuditLog はデータの作成・更新・削除の記録です
+type AuditLog struct {
+	ID         int
+	ActorID    string
+	RequestID  string
+	Action     string
+	EntityType string
+	EntityID   string
+	Before     json.RawMessage
+	After      json.RawMessage
+	CreatedAt  time.Time
+}
@@ -0,0 +1,234 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	careerGoalTitleMaxLength       = 100
+	careerGoalNoteMaxLength        = 1000
+	learningActivityTitleMaxLength = 200
+	learningActivityURLMaxLength   = 500
+	// CareerGoalActivitiesMax は1つの目標に登録できる学習活動の上限です
+	CareerGoalActivitiesMax = 50
+)
+
+// CareerGoalKind はキャリアの目標の種類です
+type CareerGoalKind string
+
+const (
+	// CareerGoalKindRole は目標とする役割（"テックリード" など）です
+	CareerGoalKindRole CareerGoalKind = "role"
+	// CareerGoalKindSkill は習得したいスキルです
+	CareerGoalKindSkill CareerGoalKind = "skill"
+)
+
+// ParseCareerGoalKind は文字列から目標の種類を取得します
+func ParseCareerGoalKind(value string) (CareerGoalKind, error) {
+	switch k := CareerGoalKind(value); k {
+	case CareerGoalKindRole, CareerGoalKindSkill:
+		return k, nil
+	default:
+		return "", fmt.Errorf("%w: unknown career goal kind %q", ErrInvalidValue, value)
+	}
+}
+
+// CareerGoalStatus は目標の状態です
+type CareerGoalStatus string
+
+const (
+	CareerGoalStatusActive    CareerGoalStatus = "active"
+	CareerGoalStatusAchieved  CareerGoalStatus = "achieved"
+	CareerGoalStatusAbandoned CareerGoalStatus = "abandoned"
+)
+
+// ParseCareerGoalStatus は文字列から目標の状態を取得します
+func ParseCareerGoalStatus(value string) (CareerGoalStatus, error) {
+	switch s := CareerGoalStatus(value); s {
+	case CareerGoalStatusActive, CareerGoalStatusAchieved, CareerGoalStatusAbandoned:
+		return s, nil
+	default:
+		return "", fmt.Errorf("%w: unknown career goal status %q", ErrInvalidValue, value)
+	}
+}
+
+// CareerGoal はユーザーが設定したキャリアの目標です
+type CareerGoal struct {
+	ID     int
+	UserID string
+	Kind   CareerGoalKind
+	// Title は目標とする役割、またはスキルの目標の名前です
+	Title string
+	// Skill・TargetLevel は種類が skill の場合の目標とするスキルと習熟度です（TargetLevel の 0 は未設定）
+	Skill       *Skill
+	TargetLevel ProficiencyLevel
+	// TargetMonth は達成予定月の月初です
+	TargetMonth time.Time
+	Status      CareerGoalStatus
+	Note        string
+	// Activities は目標のための学習活動を登録順に並べたものです
+	Activities []LearningActivity
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// NewCareerGoal は進行中の目標を作成します。種類が skill の場合はスキルが必須で、role の場合はスキルを指定できません
+func NewCareerGoal(userID, kind, title string, skill *Skill, targetLevel int, targetMonth time.Time, note string) (*CareerGoal, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user id is required", ErrInvalidValue)
+	}
+	k, err := ParseCareerGoalKind(kind)
+	if err != nil {
+		return nil, err
+	}
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return nil, fmt.Errorf("%w: goal title is required", ErrInvalidValue)
+	}
+	if err := validateLength("goal title", title, careerGoalTitleMaxLength); err != nil {
+		return nil, err
+	}
+	goal := &CareerGoal{UserID: userID, Kind: k, Title: title, Status: CareerGoalStatusActive}
+	switch k {
+	case CareerGoalKindSkill:
+		if skill == nil {
+			return nil, fmt.Errorf("%w: skill is required for a skill goal", ErrInvalidValue)
+		}
+		goal.Skill = skill
+		if targetLevel != 0 {
+			if goal.TargetLevel, err = NewProficiencyLevel(targetLevel); err != nil {
+				return nil, err
+			}
+		}
+	case CareerGoalKindRole:
+		if skill != nil || targetLevel != 0 {
+			return nil, fmt.Errorf("%w: a role goal cannot have a skill", ErrInvalidValue)
+		}
+	}
+	if targetMonth.IsZero() {
+		return nil, fmt.Errorf("%w: target month is required", ErrInvalidValue)
+	}
+	goal.TargetMonth = firstOfMonth(targetMonth)
+	goal.Note = strings.TrimSpace(note)
+	if err := validateLength("goal note", goal.Note, careerGoalNoteMaxLength); err != nil {
+		return nil, err
+	}
+	return goal, nil
+}
+
+// Progress は目標の進捗率（0〜100）です
+// 達成した目標は 100、それ以外は学習活動の進捗率の平均で、学習活動がない場合は 0 です
+func (g CareerGoal) Progress() int {
+	if g.Status == CareerGoalStatusAchieved {
+		return 100
+	}
+	if len(g.Activities) == 0 {
+		return 0
+	}
+	total := 0
+	for _, activity := range g.Activities {
+		total += activity.Progress
+	}
+	return total / len(g.Activities)
+}
+
+// IsOverdue は進行中の目標が達成予定月を過ぎているかを返します
+func (g CareerGoal) IsOverdue(now time.Time) bool {
+	return g.Status == CareerGoalStatusActive && g.TargetMonth.Before(firstOfMonth(now))
+}
+
+// LearningActivityKind は学習活動の種類です
+type LearningActivityKind string
+
+const (
+	LearningActivityKindCourse        LearningActivityKind = "course"
+	LearningActivityKindCertification LearningActivityKind = "certification"
+	LearningActivityKindSideProject   LearningActivityKind = "side_project"
+)
+
+// ParseLearningActivityKind は文字列から学習活動の種類を取得します
+func ParseLearningActivityKind(value string) (LearningActivityKind, error) {
+	switch k := LearningActivityKind(value); k {
+	case LearningActivityKindCourse, LearningActivityKindCertification, LearningActivityKindSideProject:
+		return k, nil
+	default:
+		return "", fmt.Errorf("%w: unknown learning activity kind %q", ErrInvalidValue, value)
+	}
+}
+
+// LearningActivityStatus は学習活動の状態です
+type LearningActivityStatus string
+
+const (
+	LearningActivityStatusPlanned    LearningActivityStatus = "planned"
+	LearningActivityStatusInProgress LearningActivityStatus = "in_progress"
+	LearningActivityStatusCompleted  LearningActivityStatus = "completed"
+)
+
+// ParseLearningActivityStatus は文字列から学習活動の状態を取得します
+func ParseLearningActivityStatus(value string) (LearningActivityStatus, error) {
+	switch s := LearningActivityStatus(value); s {
+	case LearningActivityStatusPlanned, LearningActivityStatusInProgress, LearningActivityStatusCompleted:
+		return s, nil
+	default:
+		return "", fmt.Errorf("%w: unknown learning activity status %q", ErrInvalidValue, value)
+	}
+}
+
+// LearningActivity は目標のための学習活動（講座の受講、取得を目指す資格、個人開発など）です
+type LearningActivity struct {
+	ID     int
+	GoalID int
+	Kind   LearningActivityKind
+	Title  string
+	// URL は講座・資格・リポジトリなどの URL です（未設定は空文字）
+	URL    string
+	Status LearningActivityStatus
+	// Progress は進捗率（0〜100）です。未着手は 0、完了は 100 です
+	Progress int
+	// DueMonth は完了予定月の月初です（nil は未設定）
+	DueMonth  *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewLearningActivity は学習活動を作成します
+// 状態が未着手（planned）の場合は進捗率を 0、完了（completed）の場合は 100 にします
+func NewLearningActivity(goalID int, kind, title, url, status string, progress int, dueMonth *time.Time) (*LearningActivity, error) {
+	k, err := ParseLearningActivityKind(kind)
+	if err != nil {
+		return nil, err
+	}
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return nil, fmt.Errorf("%w: activity title is required", ErrInvalidValue)
+	}
+	if err := validateLength("activity title", title, learningActivityTitleMaxLength); err != nil {
+		return nil, err
+	}
+	url = strings.TrimSpace(url)
+	if err := validateLength("activity url", url, learningActivityURLMaxLength); err != nil {
+		return nil, err
+	}
+	s, err := ParseLearningActivityStatus(status)
+	if err != nil {
+		return nil, err
+	}
+	if progress < 0 || progress > 100 {
+		return nil, fmt.Errorf("%w: progress must be between 0 and 100", ErrInvalidValue)
+	}
+	switch s {
+	case LearningActivityStatusPlanned:
+		progress = 0
+	case LearningActivityStatusCompleted:
+		progress = 100
+	}
+	activity := &LearningActivity{GoalID: goalID, Kind: k, Title: title, URL: url, Status: s, Progress: progress}
+	if dueMonth != nil {
+		due := firstOfMonth(*dueMonth)
+		activity.DueMonth = &due
+	}
+	return activity, nil
+}
@@ -0,0 +1,209 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"stackies/backend/domain/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCareerGoal(t *testing.T) {
+	goSkill := &model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}
+	target := time.Date(2027, time.March, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		kind        string
+		title       string
+		skill       *model.Skill
+		targetLevel int
+		targetMonth time.Time
+		note        string
+		want        *model.CareerGoal
+		wantErr     bool
+	}{
+		{
+			name:        "正常系: 役割の目標を作成し、達成予定月を月初にする",
+			kind:        "role",
+			title:       " テックリード ",
+			targetMonth: target,
+			note:        " 設計レビューを担当する ",
+			want: &model.CareerGoal{
+				UserID: "user-1", Kind: model.CareerGoalKindRole, Title: "テックリード",
+				TargetMonth: month(2027, time.March), Status: model.CareerGoalStatusActive, Note: "設計レビューを担当する",
+			},
+		},
+		{
+			name:        "正常系: スキルの目標を目標の習熟度とともに作成する",
+			kind:        "skill",
+			title:       "Go で設計できる",
+			skill:       goSkill,
+			targetLevel: 4,
+			targetMonth: target,
+			want: &model.CareerGoal{
+				UserID: "user-1", Kind: model.CareerGoalKindSkill, Title: "Go で設計できる", Skill: goSkill, TargetLevel: 4,
+				TargetMonth: month(2027, time.March), Status: model.CareerGoalStatusActive,
+			},
+		},
+		{
+			name:        "異常系: スキルの目標にスキルがない",
+			kind:        "skill",
+			title:       "Go で設計できる",
+			targetMonth: target,
+			wantErr:     true,
+		},
+		{
+			name:        "異常系: 役割の目標にスキルを指定した",
+			kind:        "role",
+			title:       "テックリード",
+			skill:       goSkill,
+			targetMonth: target,
+			wantErr:     true,
+		},
+		{
+			name:        "異常系: 目標の習熟度が範囲外",
+			kind:        "skill",
+			title:       "Go で設計できる",
+			skill:       goSkill,
+			targetLevel: 6,
+			targetMonth: target,
+			wantErr:     true,
+		},
+		{
+			name:    "異常系: 達成予定月がない",
+			kind:    "role",
+			title:   "テックリード",
+			wantErr: true,
+		},
+		{
+			name:        "異常系: 種類が不正",
+			kind:        "hobby",
+			title:       "テックリード",
+			targetMonth: target,
+			wantErr:     true,
+		},
+		{
+			name:        "異常系: タイトルが空",
+			kind:        "role",
+			title:       " ",
+			targetMonth: target,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := model.NewCareerGoal("user-1", tt.kind, tt.title, tt.skill, tt.targetLevel, tt.targetMonth, tt.note)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, model.ErrInvalidValue)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCareerGoal_Progress(t *testing.T) {
+	activities := []model.LearningActivity{{Progress: 100}, {Progress: 50}, {Progress: 0}}
+
+	assert.Equal(t, 50, model.CareerGoal{Status: model.CareerGoalStatusActive, Activities: activities}.Progress())
+	assert.Equal(t, 0, model.CareerGoal{Status: model.CareerGoalStatusActive}.Progress())
+	assert.Equal(t, 100, model.CareerGoal{Status: model.CareerGoalStatusAchieved, Activities: activities}.Progress())
+}
+
+func TestCareerGoal_IsOverdue(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+
+	assert.True(t, model.CareerGoal{Status: model.CareerGoalStatusActive, TargetMonth: month(2026, time.September)}.IsOverdue(now))
+	assert.False(t, model.CareerGoal{Status: model.CareerGoalStatusActive, TargetMonth: month(2026, time.October)}.IsOverdue(now))
+	assert.False(t, model.CareerGoal{Status: model.CareerGoalStatusAchieved, TargetMonth: month(2026, time.September)}.IsOverdue(now))
+}
+
+func TestNewLearningActivity(t *testing.T) {
+	due := time.Date(2026, time.December, 20, 0, 0, 0, 0, time.UTC)
+	dueMonth := month(2026, time.December)
+
+	tests := []struct {
+		name     string
+		kind     string
+		title    string
+		status   string
+		progress int
+		dueMonth *time.Time
+		want     *model.LearningActivity
+		wantErr  bool
+	}{
+		{
+			name:     "正常系: 進行中の学習活動を作成し、完了予定月を月初にする",
+			kind:     "course",
+			title:    " Go 言語による並行処理 ",
+			status:   "in_progress",
+			progress: 40,
+			dueMonth: &due,
+			want: &model.LearningActivity{
+				GoalID: 1, Kind: model.LearningActivityKindCourse, Title: "Go 言語による並行処理", URL: "https://example.com/course",
+				Status: model.LearningActivityStatusInProgress, Progress: 40, DueMonth: &dueMonth,
+			},
+		},
+		{
+			name:     "正常系: 完了した学習活動の進捗率は 100",
+			kind:     "certification",
+			title:    "AWS SAA",
+			status:   "completed",
+			progress: 30,
+			want: &model.LearningActivity{
+				GoalID: 1, Kind: model.LearningActivityKindCertification, Title: "AWS SAA", URL: "https://example.com/course",
+				Status: model.LearningActivityStatusCompleted, Progress: 100,
+			},
+		},
+		{
+			name:     "正常系: 未着手の学習活動の進捗率は 0",
+			kind:     "side_project",
+			title:    "CLI ツールの開発",
+			status:   "planned",
+			progress: 30,
+			want: &model.LearningActivity{
+				GoalID: 1, Kind: model.LearningActivityKindSideProject, Title: "CLI ツールの開発", URL: "https://example.com/course",
+				Status: model.LearningActivityStatusPlanned, Progress: 0,
+			},
+		},
+		{
+			name:     "異常系: 進捗率が範囲外",
+			kind:     "course",
+			title:    "Go 言語による並行処理",
+			status:   "in_progress",
+			progress: 120,
+			wantErr:  true,
+		},
+		{
+			name:    "異常系: 状態が不正",
+			kind:    "course",
+			title:   "Go 言語による並行処理",
+			status:  "paused",
+			wantErr: true,
+		},
+		{
+			name:    "異常系: 種類が不正",
+			kind:    "book",
+			title:   "Go 言語による並行処理",
+			status:  "planned",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := model.NewLearningActivity(1, tt.kind, tt.title, " https://example.com/course ", tt.status, tt.progress, tt.dueMonth)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, model.ErrInvalidValue)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// カタログの項目の名前・別名の上限
+const (
+	catalogNameMaxLength = 100
+	catalogAliasesMax    = 20
+)
+
+// CatalogItem は言語・ツールのカタログの項目です
+// Aliases は表記ゆれ（"golang"、"NodeJS" など）で、取り込み時のスキル名の対応付けに使います
+type CatalogItem struct {
+	ID       int
+	Category SkillCategory
+	Name     string
+	Aliases  []string
+	// Global は全テナント共通のカタログの項目であることを表します（false はテナント独自の項目）
+	Global bool
+}
+
+// NewCatalogItem はテナント独自のカタログの項目を作成します。名前と別名の前後の空白は取り除き、空の別名は除きます
+func NewCatalogItem(category, name string, aliases []string) (*CatalogItem, error) {
+	c, err := ParseSkillCategory(category)
+	if err != nil {
+		return nil, err
+	}
+	name = strings.TrimSpace(name)
+	if normalizeCatalogName(name) == "" {
+		return nil, fmt.Errorf("%w: catalog item name is required", ErrInvalidValue)
+	}
+	if err := validateLength("catalog item name", name, catalogNameMaxLength); err != nil {
+		return nil, err
+	}
+	if len(aliases) > catalogAliasesMax {
+		return nil, fmt.Errorf("%w: catalog item must have at most %d aliases", ErrInvalidValue, catalogAliasesMax)
+	}
+	item := &CatalogItem{Category: c, Name: name, Aliases: []string{}}
+	for _, alias := range aliases {
+		alias = strings.TrimSpace(alias)
+		if normalizeCatalogName(alias) == "" {
+			continue
+		}
+		if err := validateLength("catalog item alias", alias, catalogNameMaxLength); err != nil {
+			return nil, err
+		}
+		item.Aliases = append(item.Aliases, alias)
+	}
+	return item, nil
+}
+
+// SameName は区分と名前が同じ項目かを返します（大文字・小文字や記号の違いは同じ名前とみなします）
+func (i CatalogItem) SameName(other CatalogItem) bool {
+	return i.Category == other.Category && normalizeCatalogName(i.Name) == normalizeCatalogName(other.Name)
+}
+
+// MergeCatalogItems はテナント独自の項目と全テナント共通の項目をまとめます
+// テナント独自の項目を先に並べ、同じ名前の共通の項目はテナント独自の項目で置き換えます
+// 別名が重複する場合も NewCatalog で先に並べたテナント独自の項目が優先されます
+func MergeCatalogItems(tenantItems, globalItems []CatalogItem) []CatalogItem {
+	items := append([]CatalogItem{}, tenantItems...)
+	for _, global := range globalItems {
+		overridden := false
+		for _, item := range tenantItems {
+			if item.SameName(global) {
+				overridden = true
+				break
+			}
+		}
+		if !overridden {
+			items = append(items, global)
+		}
+	}
+	return items
+}
+
+// Catalog は言語・ツールのカタログです
+type Catalog struct {
+	index map[string]CatalogItem
+}
+
+// NewCatalog はカタログを作成します
+// 名前と別名が重複する場合は先の項目を優先します
+func NewCatalog(items []CatalogItem) Catalog {
+	c := Catalog{index: make(map[string]CatalogItem)}
+	for _, item := range items {
+		for _, name := range append([]string{item.Name}, item.Aliases...) {
+			key := normalizeCatalogName(name)
+			if _, ok := c.index[key]; key != "" && !ok {
+				c.index[key] = item
+			}
+		}
+	}
+	return c
+}
+
+// Match は名前に対応するカタログのスキルを返します
+// 大文字・小文字、空白や「.」「-」「_」の有無の違いは同じ名前とみなします（"node.js" と "NodeJS" など）
+func (c Catalog) Match(name string) (Skill, bool) {
+	item, ok := c.index[normalizeCatalogName(name)]
+	if !ok {
+		return Skill{}, false
+	}
+	return Skill{Category: item.Category, Name: item.Name}, true
+}
+
+func normalizeCatalogName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '.' || r == '-' || r == '_' {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, name)
+}
@@ -0,0 +1,158 @@
+package model_test
+
+import (
+	"testing"
+
+	"stackies/backend/domain/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalog_Match(t *testing.T) {
+	catalog := model.NewCatalog([]model.CatalogItem{
+		{ID: 1, Category: model.SkillCategoryLanguage, Name: "Go", Aliases: []string{"golang"}},
+		{ID: 2, Category: model.SkillCategoryLanguage, Name: "JavaScript", Aliases: []string{"JS"}},
+		{ID: 1, Category: model.SkillCategoryTool, Name: "Node.js"},
+		{ID: 2, Category: model.SkillCategoryTool, Name: "Go", Aliases: []string{"golang"}},
+	})
+
+	tests := []struct {
+		name   string
+		input  string
+		want   model.Skill
+		wantOK bool
+	}{
+		{
+			name:   "正常系: 名前が一致する",
+			input:  "JavaScript",
+			want:   model.Skill{Category: model.SkillCategoryLanguage, Name: "JavaScript"},
+			wantOK: true,
+		},
+		{
+			name:   "正常系: 別名と大文字・小文字の違いを吸収する",
+			input:  " GoLang ",
+			want:   model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"},
+			wantOK: true,
+		},
+		{
+			name:   "正常系: 記号の有無の違いを吸収する",
+			input:  "nodejs",
+			want:   model.Skill{Category: model.SkillCategoryTool, Name: "Node.js"},
+			wantOK: true,
+		},
+		{
+			name:   "異常系: カタログにない",
+			input:  "COBOL",
+			wantOK: false,
+		},
+		{
+			name:   "異常系: 空文字",
+			input:  " ",
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := catalog.Match(tt.input)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewCatalogItem(t *testing.T) {
+	tests := []struct {
+		name     string
+		category string
+		itemName string
+		aliases  []string
+		want     *model.CatalogItem
+		wantErr  bool
+	}{
+		{
+			name:     "正常系: 前後の空白と空の別名を除く",
+			category: "tool",
+			itemName: " 社内フレームワーク ",
+			aliases:  []string{" inhouse-fw ", " "},
+			want:     &model.CatalogItem{Category: model.SkillCategoryTool, Name: "社内フレームワーク", Aliases: []string{"inhouse-fw"}},
+		},
+		{
+			name:     "異常系: 未知の区分",
+			category: "framework",
+			itemName: "Rails",
+			wantErr:  true,
+		},
+		{
+			name:     "異常系: 名前が記号だけ",
+			category: "language",
+			itemName: " .- ",
+			wantErr:  true,
+		},
+		{
+			name:     "異常系: 別名が上限を超える",
+			category: "language",
+			itemName: "Go",
+			aliases:  make([]string, 21),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := model.NewCatalogItem(tt.category, tt.itemName, tt.aliases)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, model.ErrInvalidValue)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMergeCatalogItems(t *testing.T) {
+	global := []model.CatalogItem{
+		{ID: 1, Category: model.SkillCategoryLanguage, Name: "Go", Aliases: []string{"golang"}, Global: true},
+		{ID: 2, Category: model.SkillCategoryLanguage, Name: "Java", Global: true},
+		{ID: 1, Category: model.SkillCategoryTool, Name: "Node.js", Global: true},
+	}
+
+	tests := []struct {
+		name   string
+		tenant []model.CatalogItem
+		want   []model.CatalogItem
+	}{
+		{
+			name: "正常系: テナント独自の項目を先に並べる",
+			tenant: []model.CatalogItem{
+				{ID: 10, Category: model.SkillCategoryTool, Name: "社内フレームワーク"},
+			},
+			want: []model.CatalogItem{
+				{ID: 10, Category: model.SkillCategoryTool, Name: "社内フレームワーク"},
+				global[0], global[1], global[2],
+			},
+		},
+		{
+			name: "正常系: 同じ名前の共通の項目はテナント独自の項目で置き換える",
+			tenant: []model.CatalogItem{
+				{ID: 11, Category: model.SkillCategoryTool, Name: "nodejs", Aliases: []string{"Node"}},
+			},
+			want: []model.CatalogItem{
+				{ID: 11, Category: model.SkillCategoryTool, Name: "nodejs", Aliases: []string{"Node"}},
+				global[0], global[1],
+			},
+		},
+		{
+			name:   "正常系: テナント独自の項目がない",
+			tenant: nil,
+			want:   global,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, model.MergeCatalogItems(tt.tenant, global))
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	certificationNameMaxLength   = 200
+	certificationIssuerMaxLength = 200
+)
+
+// Certification は保有資格です（AWS SAA、基本情報技術者など）
+type Certification struct {
+	Name   string
+	Issuer string
+	// AcquiredMonth は取得月の月初です。ゼロ値は未設定を表します
+	AcquiredMonth time.Time
+}
+
+// NewCertification は資格を作成します。資格名は必須です
+func NewCertification(name, issuer string, acquiredMonth time.Time) (Certification, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return Certification{}, fmt.Errorf("%w: certification name is required", ErrInvalidValue)
+	}
+	if err := validateLength("certification name", name, certificationNameMaxLength); err != nil {
+		return Certification{}, err
+	}
+	issuer = strings.TrimSpace(issuer)
+	if err := validateLength("certification issuer", issuer, certificationIssuerMaxLength); err != nil {
+		return Certification{}, err
+	}
+
+	c := Certification{Name: name, Issuer: issuer}
+	if !acquiredMonth.IsZero() {
+		c.AcquiredMonth = firstOfMonth(acquiredMonth)
+	}
+	return c, nil
+}
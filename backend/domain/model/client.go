@@ -0,0 +1,45 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// clientMaxLength は顧客名・公開用の名前の最大文字数です
+const clientMaxLength = 100
+
+// Client は案件の顧客です。ゼロ値は顧客が未設定であることを表します
+// 顧客名は社外に出せないことが多いため、公開用の名前（「大手金融機関向け」など）を別に持ちます
+type Client struct {
+	name  string
+	alias string
+}
+
+// NewClient は前後の空白を除いた顧客を作成します。どちらも空の場合は未設定として扱います
+func NewClient(name, alias string) (Client, error) {
+	name = strings.TrimSpace(name)
+	alias = strings.TrimSpace(alias)
+	if utf8.RuneCountInString(name) > clientMaxLength {
+		return Client{}, fmt.Errorf("%w: client name must be at most %d characters", ErrInvalidValue, clientMaxLength)
+	}
+	if utf8.RuneCountInString(alias) > clientMaxLength {
+		return Client{}, fmt.Errorf("%w: client alias must be at most %d characters", ErrInvalidValue, clientMaxLength)
+	}
+	return Client{name: name, alias: alias}, nil
+}
+
+// Name は顧客名です（社外秘）
+func (c Client) Name() string {
+	return c.name
+}
+
+// Alias は顧客名の代わりに公開する名前です
+func (c Client) Alias() string {
+	return c.alias
+}
+
+// IsZero は顧客が未設定かどうかを返します
+func (c Client) IsZero() bool {
+	return c.name == "" && c.alias == ""
+}
@@ -0,0 +1,77 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"stackies/backend/domain/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewClient(t *testing.T) {
+	tests := []struct {
+		name      string
+		client    string
+		alias     string
+		wantName  string
+		wantAlias string
+		wantZero  bool
+		wantErr   bool
+	}{
+		{
+			name:      "正常系: 前後の空白を除く",
+			client:    " 株式会社ABC銀行 ",
+			alias:     " 大手金融機関向け ",
+			wantName:  "株式会社ABC銀行",
+			wantAlias: "大手金融機関向け",
+		},
+		{
+			name:     "正常系: 空白のみは未設定",
+			client:   " ",
+			wantZero: true,
+		},
+		{
+			name:    "異常系: 公開用の名前が上限を超える",
+			alias:   strings.Repeat("あ", 101),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := model.NewClient(tt.client, tt.alias)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, model.ErrInvalidValue)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantName, got.Name())
+			assert.Equal(t, tt.wantAlias, got.Alias())
+			assert.Equal(t, tt.wantZero, got.IsZero())
+		})
+	}
+}
+
+func TestTeamSize_Approximate(t *testing.T) {
+	tests := []struct {
+		name  string
+		value int
+		want  int
+	}{
+		{name: "正常系: 未設定", value: 0, want: 0},
+		{name: "正常系: 5名以下は5名", value: 3, want: 5},
+		{name: "正常系: 10名未満は10名", value: 6, want: 10},
+		{name: "正常系: 10名単位に四捨五入（切り捨て）", value: 34, want: 30},
+		{name: "正常系: 10名単位に四捨五入（切り上げ）", value: 35, want: 40},
+		{name: "正常系: 100名以上は50名単位", value: 174, want: 150},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teamSize, err := model.NewTeamSize(tt.value)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, teamSize.Approximate().Int())
+		})
+	}
+}
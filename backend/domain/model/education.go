@@ -0,0 +1,47 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	educationSchoolNameMaxLength = 200
+	educationFacultyMaxLength    = 200
+	educationDegreeMaxLength     = 100
+)
+
+// Education は学歴です
+type Education struct {
+	SchoolName string
+	// Faculty は学部・学科です
+	Faculty string
+	// Degree は学位です（学士、修士など）
+	Degree string
+	// Period は在学期間です。終了月がない場合は在学中を表します
+	Period Period
+}
+
+// NewEducation は学歴を作成します。学校名は必須です
+func NewEducation(schoolName, faculty, degree string, period Period) (Education, error) {
+	schoolName = strings.TrimSpace(schoolName)
+	if schoolName == "" {
+		return Education{}, fmt.Errorf("%w: school name is required", ErrInvalidValue)
+	}
+	faculty = strings.TrimSpace(faculty)
+	degree = strings.TrimSpace(degree)
+	for _, f := range []struct {
+		name  string
+		value string
+		max   int
+	}{
+		{"school name", schoolName, educationSchoolNameMaxLength},
+		{"faculty", faculty, educationFacultyMaxLength},
+		{"degree", degree, educationDegreeMaxLength},
+	} {
+		if err := validateLength(f.name, f.value, f.max); err != nil {
+			return Education{}, err
+		}
+	}
+	return Education{SchoolName: schoolName, Faculty: faculty, Degree: degree, Period: period}, nil
+}
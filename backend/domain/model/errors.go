@@ -0,0 +1,6 @@
+package model
+
+import "errors"
+
+// ErrInvalidValue は値オブジェクトの制約を満たさない値であることを表します
+var ErrInvalidValue = errors.New("invalid value")
@@ -1,21 +1,47 @@
 package model
 
-import "stackies/backend/infra/repository/model"
+import "time"
 
+// Experience は業務経歴（1つの案件）です
 type Experience struct {
-	ID    int
-	Title string
+	ID int
+	// UserID は業務経歴を登録したユーザー（JWT の sub）です。登録者を記録する前の業務経歴は空文字です
+	UserID string
+	Title  ExperienceTitle
+	// Client は案件の顧客です（未設定の場合はゼロ値）
+	Client Client
+	// Industry は顧客の業種です（未設定の場合はゼロ値）
+	Industry Industry
+	Period   Period
+	TeamSize TeamSize
+	// Skills は案件で使用した言語・ツールです（登録順）
+	Skills []Skill
+	// Phases は担当した工程です（上流から順）
+	Phases []Phase
+	// Version は楽観的排他制御のためのバージョンです（更新のたびに1つ進みます）
+	Version   int
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
-func NewExperience(title string) *model.Experience {
-	return &model.Experience{
-		Title: title,
+func NewExperience(userID string, title ExperienceTitle, period Period, teamSize TeamSize, skills []Skill, phases []Phase) *Experience {
+	return &Experience{
+		UserID:   userID,
+		Title:    title,
+		Period:   period,
+		TeamSize: teamSize,
+		Skills:   skills,
+		Phases:   phases,
 	}
 }
 
-func (e *Experience) ConvertToEntity() *model.Experience {
-	return &model.Experience{
-		ID:    e.ID,
-		Title: e.Title,
-	}
+// Edit は業務経歴の内容を changed の内容に変更します（ID・登録したユーザー・バージョンは変わりません）
+func (e *Experience) Edit(changed Experience) {
+	e.Title = changed.Title
+	e.Client = changed.Client
+	e.Industry = changed.Industry
+	e.Period = changed.Period
+	e.TeamSize = changed.TeamSize
+	e.Skills = changed.Skills
+	e.Phases = changed.Phases
 }
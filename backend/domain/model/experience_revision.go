@@ -0,0 +1,15 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ExperienceRevision は業務経歴のある時点の内容です
+type ExperienceRevision struct {
+	ExperienceID int
+	Revision     int
+	Snapshot     json.RawMessage
+	ActorID      string
+	CreatedAt    time.Time
+}
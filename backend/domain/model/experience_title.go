@@ -0,0 +1,32 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// experienceTitleMaxLength はタイトルの最大文字数です（experiences.title の桁数に合わせる）
+const experienceTitleMaxLength = 255
+
+// ExperienceTitle は業務経歴のタイトル（案件名）です
+type ExperienceTitle struct {
+	value string
+}
+
+// NewExperienceTitle は前後の空白を除いたタイトルを作成します
+// 空の場合と最大文字数を超える場合はエラーを返します
+func NewExperienceTitle(value string) (ExperienceTitle, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return ExperienceTitle{}, fmt.Errorf("%w: title is required", ErrInvalidValue)
+	}
+	if utf8.RuneCountInString(value) > experienceTitleMaxLength {
+		return ExperienceTitle{}, fmt.Errorf("%w: title must be at most %d characters", ErrInvalidValue, experienceTitleMaxLength)
+	}
+	return ExperienceTitle{value: value}, nil
+}
+
+func (t ExperienceTitle) String() string {
+	return t.value
+}
@@ -0,0 +1,36 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"stackies/backend/domain/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewExperienceTitle(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{name: "正常系: 前後の空白を除く", value: "  ECサイト構築  ", want: "ECサイト構築"},
+		{name: "正常系: 最大文字数", value: strings.Repeat("あ", 255), want: strings.Repeat("あ", 255)},
+		{name: "異常系: 空白のみ", value: " ", wantErr: true},
+		{name: "異常系: 最大文字数を超える", value: strings.Repeat("あ", 256), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := model.NewExperienceTitle(tt.value)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, model.ErrInvalidValue)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got.String())
+			}
+		})
+	}
+}
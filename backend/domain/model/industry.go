@@ -0,0 +1,43 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// industryMaxLength は業種の最大文字数です
+const industryMaxLength = 50
+
+// Industry は案件の顧客の業種です（「金融」「製造」など）。ゼロ値は業種が未設定であることを表します
+type Industry struct {
+	value string
+}
+
+// NewIndustry は前後の空白を除いた業種を作成します。空の場合は未設定として扱います
+func NewIndustry(value string) (Industry, error) {
+	value = strings.TrimSpace(value)
+	if utf8.RuneCountInString(value) > industryMaxLength {
+		return Industry{}, fmt.Errorf("%w: industry must be at most %d characters", ErrInvalidValue, industryMaxLength)
+	}
+	return Industry{value: value}, nil
+}
+
+func (i Industry) String() string {
+	return i.value
+}
+
+// IsZero は業種が未設定かどうかを返します
+func (i Industry) IsZero() bool {
+	return i.value == ""
+}
+
+// Matches は業種が query を含むかを返します（大文字・小文字は区別しません）
+// 「金融」は「金融（銀行）」や「金融・保険」にも一致します
+func (i Industry) Matches(query string) bool {
+	query = strings.TrimSpace(query)
+	if i.IsZero() || query == "" {
+		return false
+	}
+	return strings.Contains(strings.ToLower(i.value), strings.ToLower(query))
+}
@@ -0,0 +1,50 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"stackies/backend/domain/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewIndustry(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		want     string
+		wantZero bool
+		wantErr  bool
+	}{
+		{name: "正常系: 前後の空白を除く", value: " 金融 ", want: "金融"},
+		{name: "正常系: 空白のみは未設定", value: " ", wantZero: true},
+		{name: "異常系: 上限を超える", value: strings.Repeat("あ", 51), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := model.NewIndustry(tt.value)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, model.ErrInvalidValue)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+			assert.Equal(t, tt.wantZero, got.IsZero())
+		})
+	}
+}
+
+func TestIndustry_Matches(t *testing.T) {
+	industry, err := model.NewIndustry("金融（銀行）")
+	assert.NoError(t, err)
+	retail, err := model.NewIndustry("Retail")
+	assert.NoError(t, err)
+
+	assert.True(t, industry.Matches("金融"))
+	assert.False(t, industry.Matches("製造"))
+	assert.False(t, industry.Matches(" "))
+	assert.True(t, retail.Matches("retail"))
+	assert.False(t, model.Industry{}.Matches("金融"))
+}
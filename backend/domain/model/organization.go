@@ -0,0 +1,111 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// 組織・チームの名前の上限
+const (
+	organizationNameMaxLength = 100
+	teamNameMaxLength         = 100
+)
+
+// Organization は会社などの組織です。ユーザーは組織のチームに所属します
+type Organization struct {
+	ID        int
+	Name      string
+	CreatedAt time.Time
+}
+
+// NewOrganization は組織を作成します。名前の前後の空白は取り除きます
+func NewOrganization(name string) (*Organization, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: organization name is required", ErrInvalidValue)
+	}
+	if err := validateLength("organization name", name, organizationNameMaxLength); err != nil {
+		return nil, err
+	}
+	return &Organization{Name: name}, nil
+}
+
+// Team は組織のチームです
+type Team struct {
+	ID             int
+	OrganizationID int
+	Name           string
+	CreatedAt      time.Time
+}
+
+// NewTeam はチームを作成します。名前の前後の空白は取り除きます
+func NewTeam(organizationID int, name string) (*Team, error) {
+	if organizationID <= 0 {
+		return nil, fmt.Errorf("%w: organization id is required", ErrInvalidValue)
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: team name is required", ErrInvalidValue)
+	}
+	if err := validateLength("team name", name, teamNameMaxLength); err != nil {
+		return nil, err
+	}
+	return &Team{OrganizationID: organizationID, Name: name}, nil
+}
+
+// TeamRole はチームでの役割です
+type TeamRole string
+
+const (
+	TeamRoleMember  TeamRole = "member"  // メンバー
+	TeamRoleManager TeamRole = "manager" // マネージャー（メンバーの業務経歴とスキルを閲覧できる）
+)
+
+// NewTeamRole はチームでの役割を作成します。空の場合はメンバーとします
+func NewTeamRole(value string) (TeamRole, error) {
+	switch role := TeamRole(value); role {
+	case "":
+		return TeamRoleMember, nil
+	case TeamRoleMember, TeamRoleManager:
+		return role, nil
+	default:
+		return "", fmt.Errorf("%w: unknown team role %q", ErrInvalidValue, value)
+	}
+}
+
+// TeamMember はチームへの所属です。1人のユーザーが複数のチームに所属できます
+type TeamMember struct {
+	TeamID int
+	// UserID は所属するユーザー（JWT の sub）です
+	UserID    string
+	Role      TeamRole
+	CreatedAt time.Time
+}
+
+// NewTeamMember はチームへの所属を作成します
+func NewTeamMember(teamID int, userID string, role TeamRole) (*TeamMember, error) {
+	if teamID <= 0 {
+		return nil, fmt.Errorf("%w: team id is required", ErrInvalidValue)
+	}
+	if strings.TrimSpace(userID) == "" {
+		return nil, fmt.Errorf("%w: user id is required", ErrInvalidValue)
+	}
+	return &TeamMember{TeamID: teamID, UserID: userID, Role: role}, nil
+}
+
+// IsManager はチームのマネージャーかを返します
+func (m TeamMember) IsManager() bool {
+	return m.Role == TeamRoleManager
+}
+
+// TeamMembershipPeriod はチームに同じ役割で所属していた期間です
+// 役割を変更すると期間を区切るため、チームに所属している間に複数の期間ができます
+type TeamMembershipPeriod struct {
+	TeamID    int
+	UserID    string
+	Role      TeamRole
+	StartedAt time.Time
+	// EndedAt はチームから外れたか役割を変更した日時です（nil は現在の役割）
+	EndedAt *time.Time
+}
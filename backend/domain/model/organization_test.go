@@ -0,0 +1,82 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"stackies/backend/domain/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTeam(t *testing.T) {
+	tests := []struct {
+		name           string
+		organizationID int
+		teamName       string
+		want           string
+		wantErr        bool
+	}{
+		{
+			name:           "正常系: 前後の空白を除く",
+			organizationID: 1,
+			teamName:       " 決済チーム ",
+			want:           "決済チーム",
+		},
+		{
+			name:           "異常系: 名前が空",
+			organizationID: 1,
+			teamName:       " ",
+			wantErr:        true,
+		},
+		{
+			name:           "異常系: 名前が上限を超える",
+			organizationID: 1,
+			teamName:       strings.Repeat("あ", 101),
+			wantErr:        true,
+		},
+		{
+			name:     "異常系: 組織が未指定",
+			teamName: "決済チーム",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := model.NewTeam(tt.organizationID, tt.teamName)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, model.ErrInvalidValue)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got.Name)
+			assert.Equal(t, tt.organizationID, got.OrganizationID)
+		})
+	}
+}
+
+func TestNewTeamRole(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    model.TeamRole
+		wantErr bool
+	}{
+		{name: "正常系: 空はメンバー", value: "", want: model.TeamRoleMember},
+		{name: "正常系: マネージャー", value: "manager", want: model.TeamRoleManager},
+		{name: "異常系: 未知の役割", value: "owner", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := model.NewTeamRole(tt.value)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, model.ErrInvalidValue)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
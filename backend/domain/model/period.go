@@ -0,0 +1,80 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// Period は案件に参画していた期間です（月単位）
+// 終了月がない場合は参画中を表します。ゼロ値は期間が未設定であることを表します
+type Period struct {
+	start time.Time
+	end   time.Time
+}
+
+// NewPeriod は開始月と終了月から期間を作成します。日以下は切り捨てて月初にそろえます
+// end が nil の場合は参画中とし、終了月が開始月より前の場合はエラーを返します
+func NewPeriod(start time.Time, end *time.Time) (Period, error) {
+	if start.IsZero() {
+		return Period{}, fmt.Errorf("%w: period start is required", ErrInvalidValue)
+	}
+	p := Period{start: firstOfMonth(start)}
+	if end != nil {
+		p.end = firstOfMonth(*end)
+		if p.end.Before(p.start) {
+			return Period{}, fmt.Errorf("%w: period end must not be before start", ErrInvalidValue)
+		}
+	}
+	return p, nil
+}
+
+// Start は開始月の月初を返します
+func (p Period) Start() time.Time {
+	return p.start
+}
+
+// End は終了月の月初を返します。参画中の場合は false を返します
+func (p Period) End() (time.Time, bool) {
+	return p.end, !p.end.IsZero()
+}
+
+// IsZero は期間が未設定かどうかを返します
+func (p Period) IsZero() bool {
+	return p.start.IsZero()
+}
+
+// IsOngoing は参画中かどうかを返します
+func (p Period) IsOngoing() bool {
+	return !p.IsZero() && p.end.IsZero()
+}
+
+// Months は開始月と終了月を含めた月数を返します
+// 参画中の場合は now の月までを数え、期間が未設定の場合は 0 を返します
+func (p Period) Months(now time.Time) int {
+	if p.IsZero() {
+		return 0
+	}
+	end := p.end
+	if end.IsZero() {
+		end = firstOfMonth(now)
+	}
+	months := (end.Year()-p.start.Year())*12 + int(end.Month()-p.start.Month()) + 1
+	if months < 0 {
+		return 0
+	}
+	return months
+}
+
+// monthsUntil は期間に含まれる月の月初を古い順に返します
+// 参画中の場合は now の月までを返し、期間が未設定の場合は空です
+func (p Period) monthsUntil(now time.Time) []time.Time {
+	months := make([]time.Time, 0, p.Months(now))
+	for i := 0; i < cap(months); i++ {
+		months = append(months, p.start.AddDate(0, i, 0))
+	}
+	return months
+}
+
+func firstOfMonth(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
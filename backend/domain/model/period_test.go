@@ -0,0 +1,65 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"stackies/backend/domain/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func month(year int, m time.Month) time.Time {
+	return time.Date(year, m, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func TestNewPeriod(t *testing.T) {
+	end := month(2025, time.March)
+	before := month(2024, time.March)
+
+	tests := []struct {
+		name    string
+		start   time.Time
+		end     *time.Time
+		wantErr bool
+	}{
+		{name: "正常系: 開始月と終了月", start: month(2024, time.April), end: &end},
+		{name: "正常系: 参画中", start: month(2024, time.April)},
+		{name: "正常系: 開始月と終了月が同じ", start: month(2025, time.March), end: &end},
+		{name: "異常系: 終了月が開始月より前", start: month(2024, time.April), end: &before, wantErr: true},
+		{name: "異常系: 開始月がない", end: &end, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.NewPeriod(tt.start, tt.end)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, model.ErrInvalidValue)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPeriod_Months(t *testing.T) {
+	now := time.Date(2025, time.June, 15, 12, 0, 0, 0, time.UTC)
+	end := time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC)
+
+	// 日は切り捨てて月単位で数えること
+	finished, err := model.NewPeriod(time.Date(2024, time.April, 10, 0, 0, 0, 0, time.UTC), &end)
+	require.NoError(t, err)
+	assert.Equal(t, 12, finished.Months(now))
+	assert.Equal(t, month(2024, time.April), finished.Start())
+	assert.False(t, finished.IsOngoing())
+
+	// 参画中は現在の月まで数えること
+	ongoing, err := model.NewPeriod(month(2025, time.January), nil)
+	require.NoError(t, err)
+	assert.Equal(t, 6, ongoing.Months(now))
+	assert.True(t, ongoing.IsOngoing())
+
+	assert.Equal(t, 0, model.Period{}.Months(now))
+	assert.True(t, model.Period{}.IsZero())
+}
@@ -0,0 +1,56 @@
+package model
+
+import "fmt"
+
+// Phase は案件で担当した工程です
+type Phase string
+
+const (
+	PhaseRequirements   Phase = "requirements"    // 要件定義
+	PhaseBasicDesign    Phase = "basic_design"    // 基本設計
+	PhaseDetailedDesign Phase = "detailed_design" // 詳細設計
+	PhaseImplementation Phase = "implementation"  // 実装
+	PhaseTesting        Phase = "testing"         // テスト
+	PhaseOperation      Phase = "operation"       // 運用・保守
+)
+
+// AllPhases は全ての工程を上流から順に返します
+func AllPhases() []Phase {
+	return []Phase{
+		PhaseRequirements,
+		PhaseBasicDesign,
+		PhaseDetailedDesign,
+		PhaseImplementation,
+		PhaseTesting,
+		PhaseOperation,
+	}
+}
+
+// ParsePhase は文字列から工程を取得します
+func ParsePhase(value string) (Phase, error) {
+	for _, p := range AllPhases() {
+		if string(p) == value {
+			return p, nil
+		}
+	}
+	return "", fmt.Errorf("%w: unknown phase %q", ErrInvalidValue, value)
+}
+
+// NewPhases は担当した工程を重複を除いて上流から順に並べます
+func NewPhases(values []string) ([]Phase, error) {
+	selected := make(map[Phase]bool, len(values))
+	for _, value := range values {
+		p, err := ParsePhase(value)
+		if err != nil {
+			return nil, err
+		}
+		selected[p] = true
+	}
+	var phases []Phase
+	for _, p := range AllPhases() {
+		if selected[p] {
+			phases = append(phases, p)
+		}
+	}
+	return phases, nil
+}
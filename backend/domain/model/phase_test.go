@@ -0,0 +1,44 @@
+package model_test
+
+import (
+	"testing"
+
+	"stackies/backend/domain/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPhases(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []string
+		want    []model.Phase
+		wantErr bool
+	}{
+		{
+			name:   "正常系: 重複を除いて上流から順に並べる",
+			values: []string{"testing", "implementation", "basic_design", "testing"},
+			want:   []model.Phase{model.PhaseBasicDesign, model.PhaseImplementation, model.PhaseTesting},
+		},
+		{
+			name: "正常系: 工程が未指定",
+		},
+		{
+			name:    "異常系: 不明な工程",
+			values:  []string{"implementation", "pmo"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := model.NewPhases(tt.values)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, model.ErrInvalidValue)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
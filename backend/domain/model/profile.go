@@ -0,0 +1,130 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// プロフィールの各項目の上限
+const (
+	profileDisplayNameMaxLength       = 100
+	profileInitialsMaxLength          = 10
+	profileHeadlineMaxLength          = 200
+	profileSelfPRMaxLength            = 4000
+	profileNearestStationMaxLength    = 100
+	profilePreferredLocationMaxLength = 100
+	profilePreferredLocationsMax      = 10
+	profileCertificationsMax          = 50
+	profileEducationsMax              = 20
+)
+
+// Profile はユーザーごとの業務経歴書のプロフィールです
+// 資格と学歴を含めた1つの集約として保存します
+type Profile struct {
+	// UserID は JWT の sub です
+	UserID      string
+	DisplayName string
+	// Initials は匿名化したスキルシートに氏名の代わりに表示するイニシャル（"T.Y." など）です
+	Initials string
+	// Headline は「バックエンドエンジニア / Go・AWS」のような一行の肩書きです
+	Headline       string
+	SelfPR         string
+	NearestStation string
+	WorkStyle      WorkStyle
+	// PreferredLocations は希望する勤務地です（都道府県や地域名）
+	PreferredLocations []string
+	Certifications     []Certification
+	Educations         []Education
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// NewProfile は項目が空のプロフィールを作成します
+func NewProfile(userID string) *Profile {
+	return &Profile{UserID: userID}
+}
+
+// Validate はプロフィールの各項目が上限を超えていないかを検証します
+func (p *Profile) Validate() error {
+	if p.UserID == "" {
+		return fmt.Errorf("%w: user id is required", ErrInvalidValue)
+	}
+	for _, f := range []struct {
+		name  string
+		value string
+		max   int
+	}{
+		{"display name", p.DisplayName, profileDisplayNameMaxLength},
+		{"initials", p.Initials, profileInitialsMaxLength},
+		{"headline", p.Headline, profileHeadlineMaxLength},
+		{"self pr", p.SelfPR, profileSelfPRMaxLength},
+		{"nearest station", p.NearestStation, profileNearestStationMaxLength},
+	} {
+		if err := validateLength(f.name, f.value, f.max); err != nil {
+			return err
+		}
+	}
+
+	if len(p.PreferredLocations) > profilePreferredLocationsMax {
+		return fmt.Errorf("%w: preferred locations must be at most %d", ErrInvalidValue, profilePreferredLocationsMax)
+	}
+	for _, location := range p.PreferredLocations {
+		if strings.TrimSpace(location) == "" {
+			return fmt.Errorf("%w: preferred location must not be empty", ErrInvalidValue)
+		}
+		if err := validateLength("preferred location", location, profilePreferredLocationMaxLength); err != nil {
+			return err
+		}
+	}
+	if len(p.Certifications) > profileCertificationsMax {
+		return fmt.Errorf("%w: certifications must be at most %d", ErrInvalidValue, profileCertificationsMax)
+	}
+	if len(p.Educations) > profileEducationsMax {
+		return fmt.Errorf("%w: educations must be at most %d", ErrInvalidValue, profileEducationsMax)
+	}
+	return nil
+}
+
+// NameInitials は英字の氏名（"Taro Yamada"）からイニシャル（"T.Y."）を作成します
+// 漢字・かなの氏名は読みが分からないため空文字を返します
+func NameInitials(displayName string) string {
+	var b strings.Builder
+	for _, word := range strings.Fields(displayName) {
+		r, _ := utf8.DecodeRuneInString(word)
+		if !('a' <= r && r <= 'z' || 'A' <= r && r <= 'Z') {
+			return ""
+		}
+		b.WriteRune(unicode.ToUpper(r))
+		b.WriteByte('.')
+	}
+	return b.String()
+}
+
+func validateLength(name, value string, max int) error {
+	if utf8.RuneCountInString(value) > max {
+		return fmt.Errorf("%w: %s must be at most %d characters", ErrInvalidValue, name, max)
+	}
+	return nil
+}
+
+// WorkStyle は希望する働き方です。ゼロ値は未設定を表します
+type WorkStyle string
+
+const (
+	WorkStyleRemote WorkStyle = "remote"
+	WorkStyleHybrid WorkStyle = "hybrid"
+	WorkStyleOnsite WorkStyle = "onsite"
+)
+
+// ParseWorkStyle は文字列から働き方を取得します。空文字は未設定として扱います
+func ParseWorkStyle(value string) (WorkStyle, error) {
+	switch w := WorkStyle(value); w {
+	case "", WorkStyleRemote, WorkStyleHybrid, WorkStyleOnsite:
+		return w, nil
+	default:
+		return "", fmt.Errorf("%w: unknown work style %q", ErrInvalidValue, value)
+	}
+}
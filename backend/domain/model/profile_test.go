@@ -0,0 +1,85 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"stackies/backend/domain/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfile_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile model.Profile
+		wantErr bool
+	}{
+		{
+			name:    "正常系: 項目が空のプロフィール",
+			profile: *model.NewProfile("user-1"),
+		},
+		{
+			name:    "正常系: 表示名が上限ちょうど",
+			profile: model.Profile{UserID: "user-1", DisplayName: strings.Repeat("あ", 100)},
+		},
+		{
+			name:    "異常系: ユーザーIDが空",
+			profile: model.Profile{},
+			wantErr: true,
+		},
+		{
+			name:    "異常系: 表示名が上限を超える",
+			profile: model.Profile{UserID: "user-1", DisplayName: strings.Repeat("あ", 101)},
+			wantErr: true,
+		},
+		{
+			name:    "異常系: 希望勤務地が空文字",
+			profile: model.Profile{UserID: "user-1", PreferredLocations: []string{" "}},
+			wantErr: true,
+		},
+		{
+			name:    "異常系: 希望勤務地が多すぎる",
+			profile: model.Profile{UserID: "user-1", PreferredLocations: strings.Split("a,b,c,d,e,f,g,h,i,j,k", ",")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.profile.Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, model.ErrInvalidValue)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNameInitials(t *testing.T) {
+	tests := []struct {
+		name        string
+		displayName string
+		want        string
+	}{
+		{
+			name:        "正常系: 英字の氏名",
+			displayName: "Taro  yamada",
+			want:        "T.Y.",
+		},
+		{
+			name:        "正常系: 漢字の氏名は空文字",
+			displayName: "山田 太郎",
+		},
+		{
+			name: "正常系: 氏名が未設定",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, model.NameInitials(tt.displayName))
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// 共有リンクの各項目の上限
+const (
+	shareLinkLabelMaxLength = 100
+	// ShareLinkMaxLifetime は共有リンクの有効期間の上限です
+	ShareLinkMaxLifetime = 90 * 24 * time.Hour
+)
+
+// ShareSection は共有リンクで公開する業務経歴書の項目です
+type ShareSection string
+
+const (
+	ShareSectionProfile        ShareSection = "profile"        // 氏名・肩書き・最寄り駅・希望する働き方・希望勤務地
+	ShareSectionSelfPR         ShareSection = "self_pr"        // 自己PR
+	ShareSectionSkills         ShareSection = "skills"         // スキル（経験期間）
+	ShareSectionExperiences    ShareSection = "experiences"    // 業務経歴
+	ShareSectionCertifications ShareSection = "certifications" // 保有資格
+	ShareSectionEducations     ShareSection = "educations"     // 学歴
+)
+
+// AllShareSections は全ての項目を業務経歴書の並び順で返します
+func AllShareSections() []ShareSection {
+	return []ShareSection{
+		ShareSectionProfile,
+		ShareSectionSelfPR,
+		ShareSectionSkills,
+		ShareSectionExperiences,
+		ShareSectionCertifications,
+		ShareSectionEducations,
+	}
+}
+
+// NewShareSections は公開する項目を重複を除いて業務経歴書の並び順に並べます
+// 空の場合は全ての項目を公開します
+func NewShareSections(values []string) ([]ShareSection, error) {
+	if len(values) == 0 {
+		return AllShareSections(), nil
+	}
+	selected := make(map[ShareSection]bool, len(values))
+	for _, value := range values {
+		found := false
+		for _, s := range AllShareSections() {
+			if string(s) == value {
+				selected[s] = true
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("%w: unknown share section %q", ErrInvalidValue, value)
+		}
+	}
+	var sections []ShareSection
+	for _, s := range AllShareSections() {
+		if selected[s] {
+			sections = append(sections, s)
+		}
+	}
+	return sections, nil
+}
+
+// ShareVisibility は共有リンクで公開する範囲です
+type ShareVisibility struct {
+	Sections []ShareSection
+	// HideClientNames は業務経歴の顧客名を公開用の名前に置き換えることを表します
+	HideClientNames bool
+	// HidePeriod は業務経歴の参画期間とスキルの最終使用月を公開しないことを表します
+	HidePeriod bool
+	// Anonymize は匿名化したスキルシート（顧客名を公開用の名前に置き換え、チーム人数を丸め、氏名をイニシャルにする）を公開することを表します
+	Anonymize bool
+}
+
+// Shows は項目を公開するかを返します
+func (v ShareVisibility) Shows(section ShareSection) bool {
+	for _, s := range v.Sections {
+		if s == section {
+			return true
+		}
+	}
+	return false
+}
+
+// ShareLink は業務経歴書を認証なしで閲覧できる共有リンクです
+// トークンそのものは保存せず、ハッシュ値で照合します
+type ShareLink struct {
+	ID int
+	// UserID は業務経歴書を共有するユーザー（JWT の sub）です
+	UserID string
+	// Label は「A社 面談用」のような共有先を見分けるための名前です
+	Label     string
+	TokenHash string
+	// PasswordHash は閲覧用パスワードの bcrypt のハッシュ値です（空はパスワードなし）
+	PasswordHash string
+	ShareVisibility
+	ExpiresAt time.Time
+	// RevokedAt は無効にした日時です（ゼロ値は有効）
+	RevokedAt time.Time
+	ViewCount int
+	// LastViewedAt は最後に閲覧された日時です（ゼロ値は未閲覧）
+	LastViewedAt time.Time
+	CreatedAt    time.Time
+}
+
+// NewShareLink は共有リンクを作成します
+// 有効期限は now より後かつ ShareLinkMaxLifetime 以内である必要があります
+func NewShareLink(userID, label, tokenHash, passwordHash string, visibility ShareVisibility, expiresAt, now time.Time) (*ShareLink, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user id is required", ErrInvalidValue)
+	}
+	if tokenHash == "" {
+		return nil, fmt.Errorf("%w: token hash is required", ErrInvalidValue)
+	}
+	if err := validateLength("label", label, shareLinkLabelMaxLength); err != nil {
+		return nil, err
+	}
+	if len(visibility.Sections) == 0 {
+		return nil, fmt.Errorf("%w: at least one section is required", ErrInvalidValue)
+	}
+	if !expiresAt.After(now) {
+		return nil, fmt.Errorf("%w: expiry must be in the future", ErrInvalidValue)
+	}
+	if expiresAt.Sub(now) > ShareLinkMaxLifetime {
+		return nil, fmt.Errorf("%w: expiry must be within %d days", ErrInvalidValue, int(ShareLinkMaxLifetime.Hours()/24))
+	}
+	return &ShareLink{
+		UserID:          userID,
+		Label:           label,
+		TokenHash:       tokenHash,
+		PasswordHash:    passwordHash,
+		ShareVisibility: visibility,
+		ExpiresAt:       expiresAt,
+	}, nil
+}
+
+// IsActive は now の時点で共有リンクを閲覧できるか（無効にされておらず期限切れでないか）を返します
+func (l ShareLink) IsActive(now time.Time) bool {
+	return l.RevokedAt.IsZero() && now.Before(l.ExpiresAt)
+}
+
+// HasPassword は閲覧にパスワードが必要かを返します
+func (l ShareLink) HasPassword() bool {
+	return l.PasswordHash != ""
+}
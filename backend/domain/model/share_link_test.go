@@ -0,0 +1,127 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"stackies/backend/domain/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewShareSections(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []string
+		want    []model.ShareSection
+		wantErr bool
+	}{
+		{
+			name:   "正常系: 重複を除いて業務経歴書の並び順に並べる",
+			values: []string{"experiences", "profile", "skills", "profile"},
+			want:   []model.ShareSection{model.ShareSectionProfile, model.ShareSectionSkills, model.ShareSectionExperiences},
+		},
+		{
+			name: "正常系: 未指定の場合は全ての項目",
+			want: model.AllShareSections(),
+		},
+		{
+			name:    "異常系: 不明な項目",
+			values:  []string{"profile", "salary"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := model.NewShareSections(tt.values)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, model.ErrInvalidValue)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewShareLink(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	sections := []model.ShareSection{model.ShareSectionSkills}
+
+	tests := []struct {
+		name      string
+		label     string
+		sections  []model.ShareSection
+		expiresAt time.Time
+		wantErr   bool
+	}{
+		{
+			name:      "正常系: 有効期限が上限ちょうど",
+			label:     "A社 面談用",
+			sections:  sections,
+			expiresAt: now.Add(model.ShareLinkMaxLifetime),
+		},
+		{
+			name:      "異常系: 有効期限が過去",
+			sections:  sections,
+			expiresAt: now,
+			wantErr:   true,
+		},
+		{
+			name:      "異常系: 有効期限が上限を超える",
+			sections:  sections,
+			expiresAt: now.Add(model.ShareLinkMaxLifetime + time.Second),
+			wantErr:   true,
+		},
+		{
+			name:      "異常系: 公開する項目がない",
+			expiresAt: now.Add(time.Hour),
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := model.NewShareLink("user-1", tt.label, "hash", "", model.ShareVisibility{Sections: tt.sections, HidePeriod: true}, tt.expiresAt, now)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, model.ErrInvalidValue)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.label, got.Label)
+			assert.True(t, got.HidePeriod)
+			assert.True(t, got.IsActive(now))
+		})
+	}
+}
+
+func TestShareLink_IsActive(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		link model.ShareLink
+		want bool
+	}{
+		{
+			name: "正常系: 有効期限内",
+			link: model.ShareLink{ExpiresAt: now.Add(time.Minute)},
+			want: true,
+		},
+		{
+			name: "正常系: 期限切れ",
+			link: model.ShareLink{ExpiresAt: now},
+		},
+		{
+			name: "正常系: 無効にされている",
+			link: model.ShareLink{ExpiresAt: now.Add(time.Hour), RevokedAt: now.Add(-time.Minute)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.link.IsActive(now))
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	skillNameMaxLength = 100
+	// experienceSkillsMax は1つの業務経歴に登録できるスキルの上限です
+	experienceSkillsMax = 50
+)
+
+// SkillCategory はスキルの区分です
+type SkillCategory string
+
+const (
+	SkillCategoryLanguage SkillCategory = "language"
+	SkillCategoryTool     SkillCategory = "tool"
+)
+
+// ParseSkillCategory は文字列からスキルの区分を取得します
+func ParseSkillCategory(value string) (SkillCategory, error) {
+	switch c := SkillCategory(value); c {
+	case SkillCategoryLanguage, SkillCategoryTool:
+		return c, nil
+	default:
+		return "", fmt.Errorf("%w: unknown skill category %q", ErrInvalidValue, value)
+	}
+}
+
+// Skill は業務経歴で使用した言語・ツールです（Go、AWS など）
+type Skill struct {
+	Category SkillCategory
+	Name     string
+}
+
+// NewSkill はスキルを作成します。スキル名は必須です
+func NewSkill(category, name string) (Skill, error) {
+	c, err := ParseSkillCategory(category)
+	if err != nil {
+		return Skill{}, err
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return Skill{}, fmt.Errorf("%w: skill name is required", ErrInvalidValue)
+	}
+	if err := validateLength("skill name", name, skillNameMaxLength); err != nil {
+		return Skill{}, err
+	}
+	return Skill{Category: c, Name: name}, nil
+}
+
+// key は集計で同じスキルとみなすためのキーです（大文字・小文字は区別しません）
+func (s Skill) key() string {
+	return string(s.Category) + ":" + strings.ToLower(s.Name)
+}
+
+// SameAs は区分とスキル名が同じかを返します（大文字・小文字は区別しません）
+func (s Skill) SameAs(other Skill) bool {
+	return s.key() == other.key()
+}
+
+// ValidateSkills は1つの業務経歴に登録するスキルが上限を超えていないか、重複していないかを検証します
+func ValidateSkills(skills []Skill) error {
+	if len(skills) > experienceSkillsMax {
+		return fmt.Errorf("%w: skills must be at most %d", ErrInvalidValue, experienceSkillsMax)
+	}
+	seen := make(map[string]struct{}, len(skills))
+	for _, s := range skills {
+		if _, ok := seen[s.key()]; ok {
+			return fmt.Errorf("%w: duplicate skill %q", ErrInvalidValue, s.Name)
+		}
+		seen[s.key()] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// skillAssessmentCommentMaxLength は自己評価・推薦のコメントの上限です
+const skillAssessmentCommentMaxLength = 500
+
+// ProficiencyLevel はスキルの習熟度です（1: 学習中 〜 5: 他者に指導できる）
+type ProficiencyLevel int
+
+const (
+	ProficiencyLevelMin ProficiencyLevel = 1
+	ProficiencyLevelMax ProficiencyLevel = 5
+)
+
+// NewProficiencyLevel は習熟度を作成します。1〜5 以外はエラーを返します
+func NewProficiencyLevel(value int) (ProficiencyLevel, error) {
+	level := ProficiencyLevel(value)
+	if level < ProficiencyLevelMin || level > ProficiencyLevelMax {
+		return 0, fmt.Errorf("%w: proficiency level must be between %d and %d", ErrInvalidValue, ProficiencyLevelMin, ProficiencyLevelMax)
+	}
+	return level, nil
+}
+
+// SkillAssessment はユーザーによるスキルの習熟度の自己評価です（ユーザーとスキルの組で1つ）
+// 経験月数は業務経歴から計算するため、ここでは習熟度だけを持ちます
+type SkillAssessment struct {
+	// UserID は評価したユーザー（JWT の sub）です
+	UserID    string
+	Skill     Skill
+	Level     ProficiencyLevel
+	Comment   string
+	UpdatedAt time.Time
+}
+
+// NewSkillAssessment はスキルの自己評価を作成します。コメントの前後の空白は取り除きます
+func NewSkillAssessment(userID string, skill Skill, level int, comment string) (*SkillAssessment, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user id is required", ErrInvalidValue)
+	}
+	l, err := NewProficiencyLevel(level)
+	if err != nil {
+		return nil, err
+	}
+	comment = strings.TrimSpace(comment)
+	if err := validateLength("assessment comment", comment, skillAssessmentCommentMaxLength); err != nil {
+		return nil, err
+	}
+	return &SkillAssessment{UserID: userID, Skill: skill, Level: l, Comment: comment}, nil
+}
+
+// SkillEndorsement は同じ組織のユーザーによるスキルの推薦です（推薦するユーザーとスキルの組で1つ）
+type SkillEndorsement struct {
+	ID int
+	// UserID は推薦されたユーザー、EndorserID は推薦したユーザーです
+	UserID     string
+	EndorserID string
+	Skill      Skill
+	// Level は推薦したユーザーから見た習熟度です
+	Level     ProficiencyLevel
+	Comment   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewSkillEndorsement はスキルの推薦を作成します。自分自身は推薦できません
+func NewSkillEndorsement(userID, endorserID string, skill Skill, level int, comment string) (*SkillEndorsement, error) {
+	if userID == "" || endorserID == "" {
+		return nil, fmt.Errorf("%w: user id and endorser id are required", ErrInvalidValue)
+	}
+	if userID == endorserID {
+		return nil, fmt.Errorf("%w: users cannot endorse themselves", ErrInvalidValue)
+	}
+	l, err := NewProficiencyLevel(level)
+	if err != nil {
+		return nil, err
+	}
+	comment = strings.TrimSpace(comment)
+	if err := validateLength("endorsement comment", comment, skillAssessmentCommentMaxLength); err != nil {
+		return nil, err
+	}
+	return &SkillEndorsement{UserID: userID, EndorserID: endorserID, Skill: skill, Level: l, Comment: comment}, nil
+}
+
+// SkillLevel は1つのスキルの自己評価と他者評価です
+type SkillLevel struct {
+	// Self は自己評価の習熟度です（0 は未評価）
+	Self ProficiencyLevel
+	// Peer は推薦の習熟度の平均で、小数第1位に丸めます（0 は推薦なし）
+	Peer float64
+	// EndorsementCount は推薦したユーザーの数です
+	EndorsementCount int
+}
+
+// SkillLevels はスキルごとの自己評価と他者評価です（スキル名の大文字・小文字は区別しません）
+type SkillLevels struct {
+	levels map[string]SkillLevel
+	skills []Skill
+}
+
+// SummarizeSkillLevels は1人のユーザーの自己評価と受けた推薦をスキルごとにまとめます
+func SummarizeSkillLevels(assessments []SkillAssessment, endorsements []SkillEndorsement) SkillLevels {
+	s := SkillLevels{levels: make(map[string]SkillLevel)}
+	ensure := func(skill Skill) SkillLevel {
+		level, ok := s.levels[skill.key()]
+		if !ok {
+			s.skills = append(s.skills, skill)
+		}
+		return level
+	}
+	for _, a := range assessments {
+		level := ensure(a.Skill)
+		level.Self = a.Level
+		s.levels[a.Skill.key()] = level
+	}
+	totals := make(map[string]int)
+	for _, e := range endorsements {
+		level := ensure(e.Skill)
+		level.EndorsementCount++
+		totals[e.Skill.key()] += int(e.Level)
+		s.levels[e.Skill.key()] = level
+	}
+	for key, total := range totals {
+		level := s.levels[key]
+		level.Peer = math.Round(float64(total)/float64(level.EndorsementCount)*10) / 10
+		s.levels[key] = level
+	}
+	return s
+}
+
+// Of はスキルの自己評価と他者評価を返します。どちらもない場合はゼロ値です
+func (s SkillLevels) Of(skill Skill) SkillLevel {
+	return s.levels[skill.key()]
+}
+
+// Skills は自己評価または推薦のあるスキルを、最初に評価・推薦された順に返します
+func (s SkillLevels) Skills() []Skill {
+	return s.skills
+}
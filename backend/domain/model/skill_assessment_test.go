@@ -0,0 +1,71 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"stackies/backend/domain/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSkillAssessment(t *testing.T) {
+	goSkill := model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}
+
+	tests := []struct {
+		name    string
+		level   int
+		comment string
+		wantErr bool
+	}{
+		{name: "正常系: 習熟度の下限", level: 1},
+		{name: "正常系: 習熟度の上限", level: 5, comment: "チームのレビューを担当"},
+		{name: "異常系: 習熟度が 0", level: 0, wantErr: true},
+		{name: "異常系: 習熟度が 6", level: 6, wantErr: true},
+		{name: "異常系: コメントが上限を超える", level: 3, comment: strings.Repeat("あ", 501), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := model.NewSkillAssessment("user-1", goSkill, tt.level, tt.comment)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, model.ErrInvalidValue)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, model.ProficiencyLevel(tt.level), got.Level)
+		})
+	}
+}
+
+func TestNewSkillEndorsement(t *testing.T) {
+	goSkill := model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}
+
+	got, err := model.NewSkillEndorsement("user-1", "user-2", goSkill, 4, " 設計のレビューが的確 ")
+	require.NoError(t, err)
+	assert.Equal(t, "設計のレビューが的確", got.Comment)
+
+	_, err = model.NewSkillEndorsement("user-1", "user-1", goSkill, 4, "")
+	assert.ErrorIs(t, err, model.ErrInvalidValue)
+}
+
+func TestSummarizeSkillLevels(t *testing.T) {
+	goSkill := model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}
+	awsSkill := model.Skill{Category: model.SkillCategoryTool, Name: "AWS"}
+
+	levels := model.SummarizeSkillLevels(
+		[]model.SkillAssessment{{UserID: "user-1", Skill: goSkill, Level: 4}},
+		[]model.SkillEndorsement{
+			{UserID: "user-1", EndorserID: "user-2", Skill: model.Skill{Category: model.SkillCategoryLanguage, Name: "go"}, Level: 3},
+			{UserID: "user-1", EndorserID: "user-3", Skill: goSkill, Level: 4},
+			{UserID: "user-1", EndorserID: "user-4", Skill: goSkill, Level: 4},
+			{UserID: "user-1", EndorserID: "user-2", Skill: awsSkill, Level: 2},
+		},
+	)
+
+	assert.Equal(t, model.SkillLevel{Self: 4, Peer: 3.7, EndorsementCount: 3}, levels.Of(goSkill))
+	assert.Equal(t, model.SkillLevel{Peer: 2, EndorsementCount: 1}, levels.Of(awsSkill))
+	assert.Equal(t, model.SkillLevel{}, levels.Of(model.Skill{Category: model.SkillCategoryLanguage, Name: "Rust"}))
+	assert.Equal(t, []model.Skill{goSkill, awsSkill}, levels.Skills())
+}
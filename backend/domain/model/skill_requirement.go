@@ -0,0 +1,59 @@
+package model
+
+import "fmt"
+
+// skillRequirementsMax は1回のギャップ分析で指定できる必要なスキルの上限です
+const skillRequirementsMax = 50
+
+// SkillRequirement は体制に必要なスキルです
+// 「Go の経験が 24 か月以上の人が 2 人」は Skill が Go、MinMonths が 24、MinPeople が 2 です
+type SkillRequirement struct {
+	Skill Skill
+	// MinMonths は必要な経験月数です（0 は経験があればよい）
+	MinMonths int
+	// MinPeople は必要な人数です
+	MinPeople int
+}
+
+// NewSkillRequirement は必要なスキルを作成します。人数を省略（0）した場合は1人とします
+func NewSkillRequirement(category, name string, minMonths, minPeople int) (SkillRequirement, error) {
+	skill, err := NewSkill(category, name)
+	if err != nil {
+		return SkillRequirement{}, err
+	}
+	if minMonths < 0 {
+		return SkillRequirement{}, fmt.Errorf("%w: min months must not be negative", ErrInvalidValue)
+	}
+	if minPeople < 0 {
+		return SkillRequirement{}, fmt.Errorf("%w: min people must not be negative", ErrInvalidValue)
+	}
+	if minPeople == 0 {
+		minPeople = 1
+	}
+	return SkillRequirement{Skill: skill, MinMonths: minMonths, MinPeople: minPeople}, nil
+}
+
+// Matches は必要なスキルと同じスキルかを返します（大文字・小文字は区別しません）
+func (r SkillRequirement) Matches(skill Skill) bool {
+	return r.Skill.key() == skill.key()
+}
+
+// SatisfiedBy はスキルの経験月数が必要な経験月数を満たすかを返します
+func (r SkillRequirement) SatisfiedBy(summary SkillSummary) bool {
+	return r.Matches(summary.Skill) && summary.Months >= r.MinMonths
+}
+
+// ValidateSkillRequirements は必要なスキルが上限を超えていないか、重複していないかを検証します
+func ValidateSkillRequirements(requirements []SkillRequirement) error {
+	if len(requirements) > skillRequirementsMax {
+		return fmt.Errorf("%w: skill requirements must be at most %d", ErrInvalidValue, skillRequirementsMax)
+	}
+	seen := make(map[string]struct{}, len(requirements))
+	for _, r := range requirements {
+		if _, ok := seen[r.Skill.key()]; ok {
+			return fmt.Errorf("%w: duplicate skill requirement %q", ErrInvalidValue, r.Skill.Name)
+		}
+		seen[r.Skill.key()] = struct{}{}
+	}
+	return nil
+}
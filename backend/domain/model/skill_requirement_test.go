@@ -0,0 +1,93 @@
+package model_test
+
+import (
+	"testing"
+
+	"stackies/backend/domain/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSkillRequirement(t *testing.T) {
+	tests := []struct {
+		name      string
+		category  string
+		skillName string
+		minMonths int
+		minPeople int
+		want      model.SkillRequirement
+		wantErr   bool
+	}{
+		{
+			name:      "正常系: 経験月数と人数を指定する",
+			category:  "language",
+			skillName: " Go ",
+			minMonths: 24,
+			minPeople: 2,
+			want:      model.SkillRequirement{Skill: model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}, MinMonths: 24, MinPeople: 2},
+		},
+		{
+			name:      "正常系: 人数を省略した場合は1人",
+			category:  "tool",
+			skillName: "AWS",
+			want:      model.SkillRequirement{Skill: model.Skill{Category: model.SkillCategoryTool, Name: "AWS"}, MinPeople: 1},
+		},
+		{name: "異常系: 未知の区分", category: "framework", skillName: "Rails", wantErr: true},
+		{name: "異常系: 経験月数が負", category: "language", skillName: "Go", minMonths: -1, wantErr: true},
+		{name: "異常系: 人数が負", category: "language", skillName: "Go", minPeople: -1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := model.NewSkillRequirement(tt.category, tt.skillName, tt.minMonths, tt.minPeople)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, model.ErrInvalidValue)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSkillRequirement_SatisfiedBy(t *testing.T) {
+	requirement := model.SkillRequirement{Skill: model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}, MinMonths: 24, MinPeople: 1}
+
+	tests := []struct {
+		name    string
+		summary model.SkillSummary
+		want    bool
+	}{
+		{
+			name:    "正常系: 必要な経験月数ちょうど",
+			summary: model.SkillSummary{Skill: model.Skill{Category: model.SkillCategoryLanguage, Name: "go"}, Months: 24},
+			want:    true,
+		},
+		{
+			name:    "正常系: 経験月数が足りない",
+			summary: model.SkillSummary{Skill: model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}, Months: 23},
+		},
+		{
+			name:    "正常系: 区分が異なる",
+			summary: model.SkillSummary{Skill: model.Skill{Category: model.SkillCategoryTool, Name: "Go"}, Months: 36},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, requirement.SatisfiedBy(tt.summary))
+		})
+	}
+}
+
+func TestValidateSkillRequirements(t *testing.T) {
+	goSkill := model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}
+	assert.NoError(t, model.ValidateSkillRequirements([]model.SkillRequirement{
+		{Skill: goSkill, MinPeople: 1},
+		{Skill: model.Skill{Category: model.SkillCategoryTool, Name: "Go"}, MinPeople: 1},
+	}))
+	assert.ErrorIs(t, model.ValidateSkillRequirements([]model.SkillRequirement{
+		{Skill: goSkill, MinPeople: 1},
+		{Skill: model.Skill{Category: model.SkillCategoryLanguage, Name: "GO"}, MinPeople: 2},
+	}), model.ErrInvalidValue)
+}
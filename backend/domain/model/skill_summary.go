@@ -0,0 +1,61 @@
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+// SkillSummary はスキルごとの経験期間の集計です
+type SkillSummary struct {
+	Skill Skill
+	// Months は経験月数です。同じ月に複数の案件で使っていても1か月として数えます
+	Months int
+	// LastUsed は最後に使った月の月初です。期間が未設定の案件でしか使っていない場合はゼロ値です
+	LastUsed time.Time
+	// ExperienceCount は使った案件の数です
+	ExperienceCount int
+}
+
+// SummarizeSkills は業務経歴からスキルごとの経験月数を集計し、経験月数の多い順に返します
+// 参画中の案件は now の月までを数えます
+func SummarizeSkills(experiences []Experience, now time.Time) []SkillSummary {
+	type aggregate struct {
+		summary SkillSummary
+		months  map[time.Time]struct{}
+	}
+	aggregates := make(map[string]*aggregate)
+	var keys []string
+
+	for _, experience := range experiences {
+		months := experience.Period.monthsUntil(now)
+		for _, skill := range experience.Skills {
+			a, ok := aggregates[skill.key()]
+			if !ok {
+				a = &aggregate{summary: SkillSummary{Skill: skill}, months: make(map[time.Time]struct{})}
+				aggregates[skill.key()] = a
+				keys = append(keys, skill.key())
+			}
+			a.summary.ExperienceCount++
+			for _, m := range months {
+				a.months[m] = struct{}{}
+				if m.After(a.summary.LastUsed) {
+					a.summary.LastUsed = m
+				}
+			}
+		}
+	}
+
+	summaries := make([]SkillSummary, len(keys))
+	for i, key := range keys {
+		a := aggregates[key]
+		a.summary.Months = len(a.months)
+		summaries[i] = a.summary
+	}
+	sort.SliceStable(summaries, func(i, j int) bool {
+		if summaries[i].Months != summaries[j].Months {
+			return summaries[i].Months > summaries[j].Months
+		}
+		return summaries[i].LastUsed.After(summaries[j].LastUsed)
+	})
+	return summaries
+}
@@ -0,0 +1,106 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"stackies/backend/domain/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newExperienceWithSkills(t *testing.T, start time.Time, end *time.Time, skills ...model.Skill) model.Experience {
+	t.Helper()
+	var period model.Period
+	if !start.IsZero() {
+		var err error
+		period, err = model.NewPeriod(start, end)
+		require.NoError(t, err)
+	}
+	return model.Experience{Period: period, Skills: skills}
+}
+
+func TestSummarizeSkills(t *testing.T) {
+	goSkill := model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}
+	awsSkill := model.Skill{Category: model.SkillCategoryTool, Name: "AWS"}
+	now := month(2025, time.June)
+
+	end2024 := month(2024, time.December)
+	endMarch := month(2025, time.March)
+
+	tests := []struct {
+		name        string
+		experiences []model.Experience
+		want        []model.SkillSummary
+	}{
+		{
+			name: "正常系: 期間が重なる案件の月は1か月として数える",
+			experiences: []model.Experience{
+				newExperienceWithSkills(t, month(2024, time.January), &end2024, goSkill),
+				newExperienceWithSkills(t, month(2024, time.October), &endMarch, model.Skill{Category: model.SkillCategoryLanguage, Name: "go"}, awsSkill),
+			},
+			want: []model.SkillSummary{
+				{Skill: goSkill, Months: 15, LastUsed: month(2025, time.March), ExperienceCount: 2},
+				{Skill: awsSkill, Months: 6, LastUsed: month(2025, time.March), ExperienceCount: 1},
+			},
+		},
+		{
+			name: "正常系: 参画中の案件は現在の月まで数える",
+			experiences: []model.Experience{
+				newExperienceWithSkills(t, month(2025, time.January), nil, awsSkill),
+			},
+			want: []model.SkillSummary{
+				{Skill: awsSkill, Months: 6, LastUsed: now, ExperienceCount: 1},
+			},
+		},
+		{
+			name: "正常系: 期間が未設定の案件は案件数のみ数える",
+			experiences: []model.Experience{
+				newExperienceWithSkills(t, time.Time{}, nil, goSkill),
+			},
+			want: []model.SkillSummary{
+				{Skill: goSkill, ExperienceCount: 1},
+			},
+		},
+		{
+			name: "正常系: 業務経歴がない",
+			want: []model.SkillSummary{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, model.SummarizeSkills(tt.experiences, now))
+		})
+	}
+}
+
+func TestValidateSkills(t *testing.T) {
+	tests := []struct {
+		name    string
+		skills  []model.Skill
+		wantErr bool
+	}{
+		{
+			name:   "正常系: 同じ名前でも区分が異なれば登録できる",
+			skills: []model.Skill{{Category: model.SkillCategoryLanguage, Name: "SQL"}, {Category: model.SkillCategoryTool, Name: "SQL"}},
+		},
+		{
+			name:    "異常系: 大文字・小文字だけが異なるスキルは重複",
+			skills:  []model.Skill{{Category: model.SkillCategoryTool, Name: "AWS"}, {Category: model.SkillCategoryTool, Name: "aws"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := model.ValidateSkills(tt.skills)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, model.ErrInvalidValue)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
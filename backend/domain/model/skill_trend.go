@@ -0,0 +1,148 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// スキルの増減の判定に使う、直近の期間と前の期間の人月の変化率です
+const (
+	skillTrendGrowingRate   = 0.2
+	skillTrendDecliningRate = -0.2
+)
+
+// Quarter は暦年の四半期です（1〜3月が第1四半期）
+type Quarter struct {
+	Year   int
+	Number int
+}
+
+// QuarterOf は t を含む四半期を返します
+func QuarterOf(t time.Time) Quarter {
+	return Quarter{Year: t.Year(), Number: (int(t.Month())-1)/3 + 1}
+}
+
+// Start は四半期の最初の月の月初を返します
+func (q Quarter) Start() time.Time {
+	return time.Date(q.Year, time.Month((q.Number-1)*3+1), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// LastMonth は四半期の最後の月の月初を返します
+func (q Quarter) LastMonth() time.Time {
+	return q.Start().AddDate(0, 2, 0)
+}
+
+// Add は n 四半期後（n が負の場合は前）の四半期を返します
+func (q Quarter) Add(n int) Quarter {
+	return QuarterOf(q.Start().AddDate(0, 3*n, 0))
+}
+
+// Before は q が other より前の四半期かを返します
+func (q Quarter) Before(other Quarter) bool {
+	return q.Start().Before(other.Start())
+}
+
+// String は "2025-Q3" の形式で返します
+func (q Quarter) String() string {
+	return fmt.Sprintf("%d-Q%d", q.Year, q.Number)
+}
+
+// SkillQuarterUsage は1つの四半期に1つのスキルを使用したエンジニアの人月です
+// 1人が同じ月に同じスキルを複数の業務経歴で使用した場合も1人月と数えます
+type SkillQuarterUsage struct {
+	Skill          Skill
+	Quarter        Quarter
+	EngineerMonths int
+}
+
+// SkillHolder はスキルの経験者です
+type SkillHolder struct {
+	Skill  Skill
+	UserID string
+}
+
+// SkillTrendDirection はスキルの人月の増減です
+type SkillTrendDirection string
+
+const (
+	SkillTrendGrowing   SkillTrendDirection = "growing"
+	SkillTrendDeclining SkillTrendDirection = "declining"
+	SkillTrendStable    SkillTrendDirection = "stable"
+)
+
+// SkillTrend は直近の期間と前の期間のスキルの人月の比較です
+type SkillTrend struct {
+	Skill    Skill
+	Recent   int
+	Previous int
+	// Direction は前の期間から20%以上増えた場合は growing、20%以上減った場合は declining です
+	Direction SkillTrendDirection
+}
+
+// Change は前の期間からの人月の増減です
+func (t SkillTrend) Change() int {
+	return t.Recent - t.Previous
+}
+
+// AnalyzeSkillTrends は latest までの window 四半期（直近の期間）と、その前の window 四半期（前の期間）の人月をスキルごとに比較します
+// いずれの期間にも使用していないスキルは含めず、増えた人月の多い順に返します
+func AnalyzeSkillTrends(usages []SkillQuarterUsage, latest Quarter, window int) []SkillTrend {
+	recentFrom := latest.Add(-(window - 1))
+	previousFrom := latest.Add(-(2*window - 1))
+
+	trends := make(map[string]*SkillTrend)
+	var keys []string
+	for _, usage := range usages {
+		if usage.Quarter.Before(previousFrom) || latest.Before(usage.Quarter) {
+			continue
+		}
+		trend, ok := trends[usage.Skill.key()]
+		if !ok {
+			trend = &SkillTrend{Skill: usage.Skill}
+			trends[usage.Skill.key()] = trend
+			keys = append(keys, usage.Skill.key())
+		}
+		if usage.Quarter.Before(recentFrom) {
+			trend.Previous += usage.EngineerMonths
+		} else {
+			trend.Recent += usage.EngineerMonths
+		}
+	}
+
+	result := make([]SkillTrend, 0, len(keys))
+	for _, key := range keys {
+		trend := *trends[key]
+		if trend.Recent == 0 && trend.Previous == 0 {
+			continue
+		}
+		trend.Direction = skillTrendDirection(trend.Previous, trend.Recent)
+		result = append(result, trend)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Change() != result[j].Change() {
+			return result[i].Change() > result[j].Change()
+		}
+		return result[i].Skill.key() < result[j].Skill.key()
+	})
+	return result
+}
+
+func skillTrendDirection(previous, recent int) SkillTrendDirection {
+	if previous == 0 {
+		// 前の期間に使用していないスキルは、直近の期間に使用していれば増えたとみなす
+		if recent > 0 {
+			return SkillTrendGrowing
+		}
+		return SkillTrendStable
+	}
+	rate := float64(recent-previous) / float64(previous)
+	switch {
+	case rate >= skillTrendGrowingRate:
+		return SkillTrendGrowing
+	case rate <= skillTrendDecliningRate:
+		return SkillTrendDeclining
+	default:
+		return SkillTrendStable
+	}
+}
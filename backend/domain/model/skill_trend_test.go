@@ -0,0 +1,106 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"stackies/backend/domain/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuarter(t *testing.T) {
+	tests := []struct {
+		name      string
+		t         time.Time
+		want      model.Quarter
+		wantStart time.Time
+		wantLast  time.Time
+		wantLabel string
+	}{
+		{
+			name:      "正常系: 1月は第1四半期",
+			t:         time.Date(2026, time.January, 31, 23, 0, 0, 0, time.UTC),
+			want:      model.Quarter{Year: 2026, Number: 1},
+			wantStart: month(2026, time.January),
+			wantLast:  month(2026, time.March),
+			wantLabel: "2026-Q1",
+		},
+		{
+			name:      "正常系: 10月は第4四半期",
+			t:         time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC),
+			want:      model.Quarter{Year: 2026, Number: 4},
+			wantStart: month(2026, time.October),
+			wantLast:  month(2026, time.December),
+			wantLabel: "2026-Q4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := model.QuarterOf(tt.t)
+			assert.Equal(t, tt.want, q)
+			assert.Equal(t, tt.wantStart, q.Start())
+			assert.Equal(t, tt.wantLast, q.LastMonth())
+			assert.Equal(t, tt.wantLabel, q.String())
+		})
+	}
+
+	t.Run("正常系: 年をまたいで四半期を加減する", func(t *testing.T) {
+		q := model.Quarter{Year: 2026, Number: 1}
+		assert.Equal(t, model.Quarter{Year: 2025, Number: 3}, q.Add(-2))
+		assert.Equal(t, model.Quarter{Year: 2027, Number: 1}, q.Add(4))
+		assert.True(t, q.Add(-1).Before(q))
+		assert.False(t, q.Before(q))
+	})
+}
+
+func TestAnalyzeSkillTrends(t *testing.T) {
+	goSkill := model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}
+	php := model.Skill{Category: model.SkillCategoryLanguage, Name: "PHP"}
+	aws := model.Skill{Category: model.SkillCategoryTool, Name: "AWS"}
+	rust := model.Skill{Category: model.SkillCategoryLanguage, Name: "Rust"}
+	cobol := model.Skill{Category: model.SkillCategoryLanguage, Name: "COBOL"}
+	latest := model.Quarter{Year: 2026, Number: 3}
+	usage := func(skill model.Skill, quarter model.Quarter, months int) model.SkillQuarterUsage {
+		return model.SkillQuarterUsage{Skill: skill, Quarter: quarter, EngineerMonths: months}
+	}
+
+	t.Run("正常系: 直近の期間と前の期間の人月を比較し、増えた人月の多い順に返す", func(t *testing.T) {
+		trends := model.AnalyzeSkillTrends([]model.SkillQuarterUsage{
+			usage(goSkill, latest.Add(-3), 6),
+			usage(goSkill, latest.Add(-2), 6),
+			usage(goSkill, latest.Add(-1), 9),
+			usage(goSkill, latest, 9),
+			usage(php, latest.Add(-3), 9),
+			usage(php, latest.Add(-2), 9),
+			usage(php, latest, 6),
+			usage(aws, latest.Add(-2), 10),
+			usage(aws, latest, 11),
+			usage(rust, latest, 3),
+		}, latest, 2)
+
+		assert.Equal(t, []model.SkillTrend{
+			{Skill: goSkill, Recent: 18, Previous: 12, Direction: model.SkillTrendGrowing},
+			{Skill: rust, Recent: 3, Previous: 0, Direction: model.SkillTrendGrowing},
+			{Skill: aws, Recent: 11, Previous: 10, Direction: model.SkillTrendStable},
+			{Skill: php, Recent: 6, Previous: 18, Direction: model.SkillTrendDeclining},
+		}, trends)
+	})
+
+	t.Run("正常系: 比較する期間より前と後の人月は含めない", func(t *testing.T) {
+		trends := model.AnalyzeSkillTrends([]model.SkillQuarterUsage{
+			usage(cobol, latest.Add(-4), 30),
+			usage(cobol, latest.Add(1), 30),
+			usage(goSkill, latest.Add(-3), 3),
+		}, latest, 2)
+
+		assert.Equal(t, []model.SkillTrend{
+			{Skill: goSkill, Recent: 0, Previous: 3, Direction: model.SkillTrendDeclining},
+		}, trends)
+	})
+
+	t.Run("正常系: 人月がない場合は空", func(t *testing.T) {
+		assert.Empty(t, model.AnalyzeSkillTrends(nil, latest, 2))
+	})
+}
@@ -0,0 +1,230 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 案件の募集要件との照合の配点
+const (
+	// staffingRequiredSkillPoints は必須スキルを満たす場合の点数です
+	staffingRequiredSkillPoints = 20
+	// staffingPreferredSkillPoints は歓迎スキルを満たす場合の点数です。経験月数が足りない場合は半分です
+	staffingPreferredSkillPoints = 10
+	// staffingExtraMonthsPerPoint・staffingExtraPointsMax は必須スキルの必要な経験月数を超える経験の加点です（6 か月ごとに 1 点、最大 10 点）
+	staffingExtraMonthsPerPoint = 6
+	staffingExtraPointsMax      = 10
+	staffingIndustryPoints      = 15
+	staffingPhasePoints         = 5
+	staffingAvailabilityPoints  = 10
+)
+
+// StaffingScoreKind は照合した要件の種類です
+type StaffingScoreKind string
+
+const (
+	StaffingScoreRequiredSkill  StaffingScoreKind = "required_skill"  // 必須スキル
+	StaffingScorePreferredSkill StaffingScoreKind = "preferred_skill" // 歓迎スキル
+	StaffingScoreIndustry       StaffingScoreKind = "industry"        // 業種
+	StaffingScorePhase          StaffingScoreKind = "phase"           // 担当工程
+	StaffingScoreAvailability   StaffingScoreKind = "availability"    // 稼働可能時期
+)
+
+// StaffingRequirement は案件の募集要件です
+type StaffingRequirement struct {
+	// Required は必須スキルです。1つでも満たさないエンジニアは候補にしません
+	Required []SkillRequirement
+	// Preferred は歓迎スキルです
+	Preferred []SkillRequirement
+	// Industry は経験のある業種です（空の場合は問わない）
+	Industry string
+	// Phases は経験のある工程です
+	Phases []Phase
+	// AvailableBy は参画開始月の月初です。この月までに稼働できないエンジニアは候補にしません（ゼロ値は問わない）
+	AvailableBy time.Time
+}
+
+// NewStaffingRequirement は案件の募集要件を作成します
+// 候補者をスキルで絞り込むため、必須スキル・歓迎スキルが1つもない場合はエラーを返します
+func NewStaffingRequirement(required, preferred []SkillRequirement, industry string, phases []Phase, availableBy *time.Time) (StaffingRequirement, error) {
+	if err := ValidateSkillRequirements(append(append([]SkillRequirement{}, required...), preferred...)); err != nil {
+		return StaffingRequirement{}, err
+	}
+	industry = strings.TrimSpace(industry)
+	if utf8.RuneCountInString(industry) > industryMaxLength {
+		return StaffingRequirement{}, fmt.Errorf("%w: industry must be at most %d characters", ErrInvalidValue, industryMaxLength)
+	}
+	r := StaffingRequirement{Required: required, Preferred: preferred, Industry: industry, Phases: phases}
+	if availableBy != nil {
+		r.AvailableBy = firstOfMonth(*availableBy)
+	}
+	if len(required) == 0 && len(preferred) == 0 {
+		return StaffingRequirement{}, fmt.Errorf("%w: staffing requirement needs at least one required or preferred skill", ErrInvalidValue)
+	}
+	return r, nil
+}
+
+// CandidateSkills は候補者の絞り込みに使うスキルです
+// 必須スキルがある場合は必須スキル、ない場合は歓迎スキルで、いずれかの経験があるエンジニアだけが候補になります
+func (r StaffingRequirement) CandidateSkills() []Skill {
+	requirements := r.Required
+	if len(requirements) == 0 {
+		requirements = r.Preferred
+	}
+	skills := make([]Skill, len(requirements))
+	for i, requirement := range requirements {
+		skills[i] = requirement.Skill
+	}
+	return skills
+}
+
+// StaffingMatch はエンジニアと募集要件の照合の結果です
+type StaffingMatch struct {
+	// Score は Breakdown の点数の合計です
+	Score int
+	// Breakdown は要件ごとの照合の内訳で、必須スキル・歓迎スキル・業種・工程・稼働可能時期の順です
+	Breakdown []StaffingScoreItem
+	// AvailableFrom は稼働可能になる月の月初です（参画中で終了月が未定の場合はゼロ値）
+	AvailableFrom time.Time
+}
+
+// StaffingScoreItem は1つの要件の照合の結果です
+type StaffingScoreItem struct {
+	Kind StaffingScoreKind
+	// Name はスキル名・業種・工程です（稼働可能時期は空）
+	Name   string
+	Points int
+	// Months は要件に該当する経験月数です（稼働可能時期は 0）
+	Months int
+	// MinMonths は必要な経験月数です（スキル以外は 0）
+	MinMonths int
+	// Matched は要件を満たしていることを表します
+	Matched bool
+}
+
+// Evaluate は1人のエンジニアの業務経歴を募集要件と照合します
+// 必須スキルを満たさない場合、必須スキルがなくいずれの歓迎スキルの経験もない場合と、参画開始月までに稼働できない場合は false を返します
+func (r StaffingRequirement) Evaluate(experiences []Experience, now time.Time) (StaffingMatch, bool) {
+	var match StaffingMatch
+	add := func(item StaffingScoreItem) {
+		match.Breakdown = append(match.Breakdown, item)
+		match.Score += item.Points
+	}
+
+	summaries := SummarizeSkills(experiences, now)
+	for _, requirement := range r.Required {
+		months, ok := skillMonths(summaries, requirement)
+		if !ok || months < requirement.MinMonths {
+			return StaffingMatch{}, false
+		}
+		extra := (months - requirement.MinMonths) / staffingExtraMonthsPerPoint
+		if extra > staffingExtraPointsMax {
+			extra = staffingExtraPointsMax
+		}
+		add(StaffingScoreItem{
+			Kind:      StaffingScoreRequiredSkill,
+			Name:      requirement.Skill.Name,
+			Points:    staffingRequiredSkillPoints + extra,
+			Months:    months,
+			MinMonths: requirement.MinMonths,
+			Matched:   true,
+		})
+	}
+	hasPreferred := false
+	for _, requirement := range r.Preferred {
+		months, ok := skillMonths(summaries, requirement)
+		hasPreferred = hasPreferred || ok
+		item := StaffingScoreItem{Kind: StaffingScorePreferredSkill, Name: requirement.Skill.Name, Months: months, MinMonths: requirement.MinMonths}
+		switch {
+		case ok && months >= requirement.MinMonths:
+			item.Points = staffingPreferredSkillPoints
+			item.Matched = true
+		case ok:
+			item.Points = staffingPreferredSkillPoints / 2
+		}
+		add(item)
+	}
+	if len(r.Required) == 0 && !hasPreferred {
+		return StaffingMatch{}, false
+	}
+	if r.Industry != "" {
+		months := countMonths(experiences, now, func(e Experience) bool { return e.Industry.Matches(r.Industry) })
+		item := StaffingScoreItem{Kind: StaffingScoreIndustry, Name: r.Industry, Months: months, Matched: months > 0}
+		if item.Matched {
+			item.Points = staffingIndustryPoints
+		}
+		add(item)
+	}
+	for _, phase := range r.Phases {
+		months := countMonths(experiences, now, func(e Experience) bool { return hasPhase(e.Phases, phase) })
+		item := StaffingScoreItem{Kind: StaffingScorePhase, Name: string(phase), Months: months, Matched: months > 0}
+		if item.Matched {
+			item.Points = staffingPhasePoints
+		}
+		add(item)
+	}
+
+	availableFrom, ok := AvailableFrom(experiences, now)
+	if ok {
+		match.AvailableFrom = availableFrom
+	}
+	if !r.AvailableBy.IsZero() {
+		if !ok || availableFrom.After(r.AvailableBy) {
+			return StaffingMatch{}, false
+		}
+		add(StaffingScoreItem{Kind: StaffingScoreAvailability, Points: staffingAvailabilityPoints, Matched: true})
+	}
+	return match, true
+}
+
+// AvailableFrom は業務経歴から稼働可能になる月の月初を返します
+// 終了月が now より後の案件がある場合はその翌月、それ以外は now の月です。終了月が未定の参画中の案件がある場合は false を返します
+func AvailableFrom(experiences []Experience, now time.Time) (time.Time, bool) {
+	from := firstOfMonth(now)
+	for _, experience := range experiences {
+		if experience.Period.IsOngoing() {
+			return time.Time{}, false
+		}
+		if end, ok := experience.Period.End(); ok {
+			if next := end.AddDate(0, 1, 0); next.After(from) {
+				from = next
+			}
+		}
+	}
+	return from, true
+}
+
+// skillMonths は必要なスキルの経験月数を返します。スキルを使った案件がない場合は false を返します
+func skillMonths(summaries []SkillSummary, requirement SkillRequirement) (int, bool) {
+	for _, summary := range summaries {
+		if requirement.Matches(summary.Skill) {
+			return summary.Months, true
+		}
+	}
+	return 0, false
+}
+
+// countMonths は条件に当てはまる案件の月数を返します。同じ月に複数の案件があっても1か月として数えます
+func countMonths(experiences []Experience, now time.Time, filter func(Experience) bool) int {
+	months := make(map[time.Time]struct{})
+	for _, experience := range experiences {
+		if !filter(experience) {
+			continue
+		}
+		for _, m := range experience.Period.monthsUntil(now) {
+			months[m] = struct{}{}
+		}
+	}
+	return len(months)
+}
+
+func hasPhase(phases []Phase, phase Phase) bool {
+	for _, p := range phases {
+		if p == phase {
+			return true
+		}
+	}
+	return false
+}
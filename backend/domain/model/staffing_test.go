@@ -0,0 +1,127 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"stackies/backend/domain/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStaffingRequirement(t *testing.T) {
+	goRequirement := model.SkillRequirement{Skill: model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}, MinMonths: 24, MinPeople: 1}
+	availableBy := time.Date(2026, time.December, 15, 0, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: 参画開始月は月初にそろえる", func(t *testing.T) {
+		got, err := model.NewStaffingRequirement([]model.SkillRequirement{goRequirement}, nil, " 金融 ", nil, &availableBy)
+		require.NoError(t, err)
+		assert.Equal(t, "金融", got.Industry)
+		assert.Equal(t, month(2026, time.December), got.AvailableBy)
+	})
+	t.Run("異常系: 必須スキルと歓迎スキルで同じスキルを指定する", func(t *testing.T) {
+		_, err := model.NewStaffingRequirement([]model.SkillRequirement{goRequirement}, []model.SkillRequirement{goRequirement}, "", nil, nil)
+		assert.ErrorIs(t, err, model.ErrInvalidValue)
+	})
+	t.Run("異常系: 要件が1つもない", func(t *testing.T) {
+		_, err := model.NewStaffingRequirement(nil, nil, " ", nil, nil)
+		assert.ErrorIs(t, err, model.ErrInvalidValue)
+	})
+	t.Run("異常系: スキルを指定していない", func(t *testing.T) {
+		_, err := model.NewStaffingRequirement(nil, nil, "金融", []model.Phase{model.PhaseBasicDesign}, &availableBy)
+		assert.ErrorIs(t, err, model.ErrInvalidValue)
+	})
+}
+
+func TestStaffingRequirement_CandidateSkills(t *testing.T) {
+	goRequirement := model.SkillRequirement{Skill: model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}, MinPeople: 1}
+	awsRequirement := model.SkillRequirement{Skill: model.Skill{Category: model.SkillCategoryTool, Name: "AWS"}, MinPeople: 1}
+
+	t.Run("正常系: 必須スキルがある場合は必須スキルで絞り込む", func(t *testing.T) {
+		r := model.StaffingRequirement{Required: []model.SkillRequirement{goRequirement}, Preferred: []model.SkillRequirement{awsRequirement}}
+		assert.Equal(t, []model.Skill{goRequirement.Skill}, r.CandidateSkills())
+	})
+	t.Run("正常系: 必須スキルがない場合は歓迎スキルで絞り込む", func(t *testing.T) {
+		r := model.StaffingRequirement{Preferred: []model.SkillRequirement{goRequirement, awsRequirement}}
+		assert.Equal(t, []model.Skill{goRequirement.Skill, awsRequirement.Skill}, r.CandidateSkills())
+	})
+}
+
+func TestStaffingRequirement_Evaluate(t *testing.T) {
+	goSkill := model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}
+	awsSkill := model.Skill{Category: model.SkillCategoryTool, Name: "AWS"}
+	now := month(2026, time.October)
+	finance, err := model.NewIndustry("金融（銀行）")
+	require.NoError(t, err)
+
+	// 2023/04〜2025/09 の 30 か月、金融の案件で Go と AWS を使い基本設計から担当した
+	end := month(2025, time.September)
+	past := newExperienceWithSkills(t, month(2023, time.April), &end, goSkill, awsSkill)
+	past.Industry = finance
+	past.Phases = []model.Phase{model.PhaseBasicDesign, model.PhaseImplementation}
+	ongoing := newExperienceWithSkills(t, month(2025, time.October), nil, goSkill)
+
+	requirement := model.StaffingRequirement{
+		Required:    []model.SkillRequirement{{Skill: goSkill, MinMonths: 24, MinPeople: 1}},
+		Preferred:   []model.SkillRequirement{{Skill: awsSkill, MinMonths: 36, MinPeople: 1}},
+		Industry:    "金融",
+		Phases:      []model.Phase{model.PhaseBasicDesign, model.PhaseRequirements},
+		AvailableBy: month(2026, time.December),
+	}
+
+	t.Run("正常系: 要件ごとの点数の内訳を返す", func(t *testing.T) {
+		got, ok := requirement.Evaluate([]model.Experience{past}, now)
+		require.True(t, ok)
+		assert.Equal(t, []model.StaffingScoreItem{
+			{Kind: model.StaffingScoreRequiredSkill, Name: "Go", Points: 21, Months: 30, MinMonths: 24, Matched: true},
+			{Kind: model.StaffingScorePreferredSkill, Name: "AWS", Points: 5, Months: 30, MinMonths: 36},
+			{Kind: model.StaffingScoreIndustry, Name: "金融", Points: 15, Months: 30, Matched: true},
+			{Kind: model.StaffingScorePhase, Name: "basic_design", Points: 5, Months: 30, Matched: true},
+			{Kind: model.StaffingScorePhase, Name: "requirements"},
+			{Kind: model.StaffingScoreAvailability, Points: 10, Matched: true},
+		}, got.Breakdown)
+		assert.Equal(t, 56, got.Score)
+		assert.Equal(t, month(2026, time.October), got.AvailableFrom)
+	})
+	t.Run("正常系: 必須スキルの経験月数が足りない", func(t *testing.T) {
+		short := newExperienceWithSkills(t, month(2025, time.January), &end, goSkill)
+		_, ok := requirement.Evaluate([]model.Experience{short}, now)
+		assert.False(t, ok)
+	})
+	t.Run("正常系: 終了月が未定の案件に参画中", func(t *testing.T) {
+		_, ok := requirement.Evaluate([]model.Experience{past, ongoing}, now)
+		assert.False(t, ok)
+	})
+	t.Run("正常系: 稼働可能時期を問わない場合は参画中でも候補にする", func(t *testing.T) {
+		r := requirement
+		r.AvailableBy = time.Time{}
+		got, ok := r.Evaluate([]model.Experience{past, ongoing}, now)
+		require.True(t, ok)
+		assert.True(t, got.AvailableFrom.IsZero())
+		assert.Equal(t, 48, got.Score)
+	})
+	t.Run("正常系: 必須スキルがない場合は歓迎スキルの経験がなければ候補にしない", func(t *testing.T) {
+		r := model.StaffingRequirement{Preferred: []model.SkillRequirement{{Skill: awsSkill, MinMonths: 12, MinPeople: 1}}}
+		_, ok := r.Evaluate([]model.Experience{ongoing}, now)
+		assert.False(t, ok)
+
+		got, ok := r.Evaluate([]model.Experience{past}, now)
+		require.True(t, ok)
+		assert.Equal(t, 10, got.Score)
+	})
+}
+
+func TestAvailableFrom(t *testing.T) {
+	now := month(2026, time.October)
+	end := month(2027, time.March)
+	planned := newExperienceWithSkills(t, month(2026, time.April), &end)
+
+	got, ok := model.AvailableFrom([]model.Experience{planned}, now)
+	assert.True(t, ok)
+	assert.Equal(t, month(2027, time.April), got)
+
+	got, ok = model.AvailableFrom(nil, now.AddDate(0, 0, 10))
+	assert.True(t, ok)
+	assert.Equal(t, now, got)
+}
@@ -0,0 +1,44 @@
+package model
+
+import "fmt"
+
+// teamSizeMax は入力ミスを防ぐためのチーム人数の上限です
+const teamSizeMax = 10000
+
+// TeamSize は案件のチーム人数です。ゼロ値は人数が未設定であることを表します
+type TeamSize struct {
+	value int
+}
+
+// NewTeamSize はチーム人数を作成します。0 は未設定として扱います
+func NewTeamSize(value int) (TeamSize, error) {
+	if value < 0 || value > teamSizeMax {
+		return TeamSize{}, fmt.Errorf("%w: team size must be between 0 and %d", ErrInvalidValue, teamSizeMax)
+	}
+	return TeamSize{value: value}, nil
+}
+
+func (t TeamSize) Int() int {
+	return t.value
+}
+
+// IsZero は人数が未設定かどうかを返します
+func (t TeamSize) IsZero() bool {
+	return t.value == 0
+}
+
+// Approximate は人数を特定されないよう丸めたチーム人数を返します
+// 10名未満は5名単位に切り上げ、100名未満は10名単位、それ以上は50名単位に四捨五入します
+func (t TeamSize) Approximate() TeamSize {
+	v := t.value
+	switch {
+	case v == 0:
+		return t
+	case v < 10:
+		return TeamSize{value: (v + 4) / 5 * 5}
+	case v < 100:
+		return TeamSize{value: (v + 5) / 10 * 10}
+	default:
+		return TeamSize{value: (v + 25) / 50 * 50}
+	}
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// DefaultTenantID はテナントの導入前から登録されているデータのテナントです
+const DefaultTenantID = "default"
+
+// tenantIDPattern はテナントの ID の形式です。サブドメインに使うため DNS のラベルの形式に合わせます
+var tenantIDPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// Tenant は同じデプロイメントを利用する会社です。データはテナントごとに分離します
+type Tenant struct {
+	// ID はサブドメインにも使う識別子です（英小文字・数字・ハイフン）
+	ID   string
+	Name string
+	// InheritGlobalCatalog は全テナント共通の言語・ツールのカタログを引き継ぐかを表します
+	InheritGlobalCatalog bool
+	CreatedAt            time.Time
+}
+
+// ValidateTenantID はテナントの ID がサブドメインとして使える形式かを検証します
+func ValidateTenantID(id string) error {
+	if !tenantIDPattern.MatchString(id) {
+		return fmt.Errorf("%w: invalid tenant id %q", ErrInvalidValue, id)
+	}
+	return nil
+}
+
+type tenantKey struct{}
+
+// ContextWithTenant はリクエストのテナントをコンテキストに設定します
+// リポジトリはこのテナントのデータだけを読み書きします
+func ContextWithTenant(ctx context.Context, tenant Tenant) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// TenantFromContext はコンテキストからリクエストのテナントを取得します
+func TenantFromContext(ctx context.Context) (Tenant, bool) {
+	tenant, ok := ctx.Value(tenantKey{}).(Tenant)
+	return tenant, ok
+}
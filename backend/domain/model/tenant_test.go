@@ -0,0 +1,37 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"stackies/backend/domain/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTenantID(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		wantErr bool
+	}{
+		{name: "正常系: 英小文字・数字・ハイフン", id: "acme-2"},
+		{name: "正常系: 1文字", id: "a"},
+		{name: "異常系: 空", id: "", wantErr: true},
+		{name: "異常系: 大文字", id: "Acme", wantErr: true},
+		{name: "異常系: ハイフンで始まる", id: "-acme", wantErr: true},
+		{name: "異常系: ドットを含む", id: "acme.example", wantErr: true},
+		{name: "異常系: 63文字を超える", id: strings.Repeat("a", 64), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := model.ValidateTenantID(tt.id)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, model.ErrInvalidValue)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// TimelineKind はキャリアの年表の項目の種類です
+type TimelineKind string
+
+const (
+	TimelineKindExperience    TimelineKind = "experience"
+	TimelineKindCertification TimelineKind = "certification"
+	TimelineKindMembership    TimelineKind = "membership"
+	TimelineKindRoleChange    TimelineKind = "role_change"
+	// TimelineKindGap は業務経歴のない期間です（BuildTimeline が追加します）
+	TimelineKindGap TimelineKind = "gap"
+)
+
+// IsMilestone は期間ではなく、ある月の出来事を表す種類かを返します
+func (k TimelineKind) IsMilestone() bool {
+	return k == TimelineKindCertification || k == TimelineKindRoleChange
+}
+
+// TimelineEntry はキャリアの年表の1つの項目です
+type TimelineEntry struct {
+	// ID は年表の中で項目を一意に識別する文字列です（"experience:1" など）
+	ID     string
+	Kind   TimelineKind
+	Title  string
+	Detail string
+	// Period は項目の期間です。出来事の場合は開始月と終了月が同じで、期間が未設定の項目は年表に並べません
+	Period Period
+	// Overlaps は期間が重なる業務経歴の ID です（業務経歴のみ）
+	Overlaps []string
+}
+
+// TimelineYear は開始月の年ごとの項目です
+type TimelineYear struct {
+	Year    int
+	Entries []TimelineEntry
+}
+
+// TimelineOverlap は2つの業務経歴が並行していた期間です
+type TimelineOverlap struct {
+	Period   Period
+	EntryIDs []string
+}
+
+// Timeline はキャリアの年表です
+type Timeline struct {
+	// Years は年の古い順で、各年の項目は開始月の古い順です
+	Years    []TimelineYear
+	Overlaps []TimelineOverlap
+	// Undated は期間が未設定のため年表に並べられない項目です
+	Undated []TimelineEntry
+}
+
+// BuildTimeline は項目を開始月の年ごとにまとめ、業務経歴の空白期間と重複期間を加えます
+// 空白期間は最初の業務経歴の開始月から最後の業務経歴の終了月までの間で、業務経歴のない月が続く期間です
+// 参画中の業務経歴は now の月までとして重複期間を求めます
+func BuildTimeline(entries []TimelineEntry, now time.Time) Timeline {
+	var timeline Timeline
+	var dated, experiences []TimelineEntry
+	for _, entry := range entries {
+		if entry.Period.IsZero() {
+			timeline.Undated = append(timeline.Undated, entry)
+			continue
+		}
+		dated = append(dated, entry)
+	}
+	sortTimelineEntries(dated)
+
+	for _, entry := range dated {
+		if entry.Kind == TimelineKindExperience {
+			experiences = append(experiences, entry)
+		}
+	}
+	timeline.Overlaps = findOverlaps(experiences, now)
+	overlapsByID := make(map[string][]string)
+	for _, overlap := range timeline.Overlaps {
+		a, b := overlap.EntryIDs[0], overlap.EntryIDs[1]
+		overlapsByID[a] = append(overlapsByID[a], b)
+		overlapsByID[b] = append(overlapsByID[b], a)
+	}
+	for i := range dated {
+		dated[i].Overlaps = overlapsByID[dated[i].ID]
+	}
+	dated = append(dated, findGaps(experiences)...)
+	sortTimelineEntries(dated)
+
+	for _, entry := range dated {
+		year := entry.Period.Start().Year()
+		if n := len(timeline.Years); n == 0 || timeline.Years[n-1].Year != year {
+			timeline.Years = append(timeline.Years, TimelineYear{Year: year})
+		}
+		last := &timeline.Years[len(timeline.Years)-1]
+		last.Entries = append(last.Entries, entry)
+	}
+	return timeline
+}
+
+// sortTimelineEntries は開始月の古い順に並べます。同じ月の場合は期間の長い項目を先にします
+func sortTimelineEntries(entries []TimelineEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		si, sj := entries[i].Period.Start(), entries[j].Period.Start()
+		if !si.Equal(sj) {
+			return si.Before(sj)
+		}
+		return entries[i].Period.Months(si) > entries[j].Period.Months(sj)
+	})
+}
+
+// findOverlaps は期間が重なる業務経歴の組を返します。業務経歴は開始月の古い順に並んでいる必要があります
+func findOverlaps(experiences []TimelineEntry, now time.Time) []TimelineOverlap {
+	var overlaps []TimelineOverlap
+	for i := range experiences {
+		for j := i + 1; j < len(experiences); j++ {
+			a, b := experiences[i], experiences[j]
+			start := b.Period.Start()
+			end := periodEndUntil(a.Period, now)
+			if bEnd := periodEndUntil(b.Period, now); bEnd.Before(end) {
+				end = bEnd
+			}
+			if end.Before(start) {
+				continue
+			}
+			// 開始月・終了月ともに業務経歴の期間内のため、常に作成できる
+			period, _ := NewPeriod(start, &end)
+			overlaps = append(overlaps, TimelineOverlap{Period: period, EntryIDs: []string{a.ID, b.ID}})
+		}
+	}
+	return overlaps
+}
+
+// findGaps は業務経歴の間の空白期間を返します。業務経歴は開始月の古い順に並んでいる必要があります
+func findGaps(experiences []TimelineEntry) []TimelineEntry {
+	var gaps []TimelineEntry
+	var covered time.Time
+	for i, experience := range experiences {
+		start := experience.Period.Start()
+		if i > 0 && start.After(covered.AddDate(0, 1, 0)) {
+			gapStart, gapEnd := covered.AddDate(0, 1, 0), start.AddDate(0, -1, 0)
+			period, _ := NewPeriod(gapStart, &gapEnd)
+			gaps = append(gaps, TimelineEntry{
+				ID:     fmt.Sprintf("gap:%d", len(gaps)+1),
+				Kind:   TimelineKindGap,
+				Title:  "空白期間",
+				Period: period,
+			})
+		}
+		end, ok := experience.Period.End()
+		if !ok {
+			// 参画中の業務経歴以降に空白期間はない
+			break
+		}
+		if i == 0 || end.After(covered) {
+			covered = end
+		}
+	}
+	return gaps
+}
+
+// periodEndUntil は期間の終了月を返します。終了月がない場合は now の月です
+func periodEndUntil(p Period, now time.Time) time.Time {
+	if end, ok := p.End(); ok {
+		return end
+	}
+	return firstOfMonth(now)
+}
@@ -0,0 +1,114 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"stackies/backend/domain/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTimelineEntry はテスト用の年表の項目を作成します（end が nil は参画中）
+func newTimelineEntry(t *testing.T, id string, kind model.TimelineKind, start time.Time, end *time.Time) model.TimelineEntry {
+	t.Helper()
+	period, err := model.NewPeriod(start, end)
+	require.NoError(t, err)
+	return model.TimelineEntry{ID: id, Kind: kind, Title: id, Period: period}
+}
+
+func TestBuildTimeline(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+	ptr := func(v time.Time) *time.Time { return &v }
+
+	t.Run("正常系: 業務経歴の空白期間と重複期間を加え、開始月の年ごとにまとめる", func(t *testing.T) {
+		acquired := month(2024, time.June)
+		timeline := model.BuildTimeline([]model.TimelineEntry{
+			newTimelineEntry(t, "experience:2", model.TimelineKindExperience, month(2024, time.October), nil),
+			newTimelineEntry(t, "experience:1", model.TimelineKindExperience, month(2023, time.April), ptr(month(2024, time.March))),
+			newTimelineEntry(t, "experience:3", model.TimelineKindExperience, month(2025, time.January), ptr(month(2025, time.June))),
+			newTimelineEntry(t, "certification:1", model.TimelineKindCertification, acquired, &acquired),
+			{ID: "experience:4", Kind: model.TimelineKindExperience, Title: "期間未設定"},
+		}, now)
+
+		require.Len(t, timeline.Years, 3)
+		assert.Equal(t, 2023, timeline.Years[0].Year)
+		assert.Equal(t, 2024, timeline.Years[1].Year)
+		assert.Equal(t, 2025, timeline.Years[2].Year)
+
+		var ids []string
+		for _, entry := range timeline.Years[1].Entries {
+			ids = append(ids, entry.ID)
+		}
+		assert.Equal(t, []string{"gap:1", "certification:1", "experience:2"}, ids)
+
+		gap := timeline.Years[1].Entries[0]
+		assert.Equal(t, model.TimelineKindGap, gap.Kind)
+		assert.Equal(t, month(2024, time.April), gap.Period.Start())
+		assert.Equal(t, 6, gap.Period.Months(now))
+
+		require.Len(t, timeline.Overlaps, 1)
+		assert.Equal(t, []string{"experience:2", "experience:3"}, timeline.Overlaps[0].EntryIDs)
+		assert.Equal(t, 6, timeline.Overlaps[0].Period.Months(now))
+		assert.Equal(t, []string{"experience:3"}, timeline.Years[1].Entries[2].Overlaps)
+		assert.Equal(t, []string{"experience:2"}, timeline.Years[2].Entries[0].Overlaps)
+
+		require.Len(t, timeline.Undated, 1)
+		assert.Equal(t, "experience:4", timeline.Undated[0].ID)
+	})
+
+	t.Run("正常系: 連続する業務経歴の間は空白期間にしない", func(t *testing.T) {
+		timeline := model.BuildTimeline([]model.TimelineEntry{
+			newTimelineEntry(t, "experience:1", model.TimelineKindExperience, month(2023, time.April), ptr(month(2024, time.March))),
+			newTimelineEntry(t, "experience:2", model.TimelineKindExperience, month(2024, time.April), ptr(month(2024, time.September))),
+		}, now)
+
+		assert.Empty(t, timeline.Overlaps)
+		for _, year := range timeline.Years {
+			for _, entry := range year.Entries {
+				assert.NotEqual(t, model.TimelineKindGap, entry.Kind)
+			}
+		}
+	})
+
+	t.Run("正常系: 長い業務経歴に含まれる業務経歴の後は、長い業務経歴の終了月から空白期間を求める", func(t *testing.T) {
+		timeline := model.BuildTimeline([]model.TimelineEntry{
+			newTimelineEntry(t, "experience:1", model.TimelineKindExperience, month(2022, time.January), ptr(month(2023, time.December))),
+			newTimelineEntry(t, "experience:2", model.TimelineKindExperience, month(2022, time.March), ptr(month(2022, time.May))),
+			newTimelineEntry(t, "experience:3", model.TimelineKindExperience, month(2024, time.March), ptr(month(2024, time.May))),
+		}, now)
+
+		require.Len(t, timeline.Years, 2)
+		gap := timeline.Years[1].Entries[0]
+		assert.Equal(t, model.TimelineKindGap, gap.Kind)
+		assert.Equal(t, month(2024, time.January), gap.Period.Start())
+		assert.Equal(t, 2, gap.Period.Months(now))
+	})
+
+	t.Run("正常系: 業務経歴以外の項目は空白期間・重複期間の対象にしない", func(t *testing.T) {
+		timeline := model.BuildTimeline([]model.TimelineEntry{
+			newTimelineEntry(t, "membership:1:1", model.TimelineKindMembership, month(2020, time.April), nil),
+			newTimelineEntry(t, "experience:1", model.TimelineKindExperience, month(2023, time.April), nil),
+		}, now)
+
+		assert.Empty(t, timeline.Overlaps)
+		require.Len(t, timeline.Years, 2)
+		assert.Len(t, timeline.Years[0].Entries, 1)
+		assert.Len(t, timeline.Years[1].Entries, 1)
+	})
+
+	t.Run("正常系: 項目がない場合は空の年表", func(t *testing.T) {
+		timeline := model.BuildTimeline(nil, now)
+		assert.Empty(t, timeline.Years)
+		assert.Empty(t, timeline.Overlaps)
+		assert.Empty(t, timeline.Undated)
+	})
+}
+
+func TestTimelineKind_IsMilestone(t *testing.T) {
+	assert.True(t, model.TimelineKindCertification.IsMilestone())
+	assert.True(t, model.TimelineKindRoleChange.IsMilestone())
+	assert.False(t, model.TimelineKindExperience.IsMilestone())
+	assert.False(t, model.TimelineKindGap.IsMilestone())
+}
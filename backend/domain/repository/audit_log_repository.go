@@ -0,0 +1,25 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/mock_$GOFILE -package=mock
+package repository
+
+import (
+	"context"
+	"stackies/backend/domain/model"
+	"time"
+)
+
+// AuditLogFilter は監査ログの検索条件です（ゼロ値の項目は条件に含めません）
+type AuditLogFilter struct {
+	ActorID    string
+	EntityType string
+	EntityID   string
+	Action     string
+	From       time.Time
+	To         time.Time
+	Limit      int
+	Offset     int
+}
+
+type AuditLogRepository interface {
+	Create(ctx context.Context, auditLog model.AuditLog) error
+	Find(ctx context.Context, filter AuditLogFilter) ([]model.AuditLog, error)
+}
@@ -0,0 +1,24 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/mock_$GOFILE -package=mock
+package repository
+
+import (
+	"context"
+	"stackies/backend/domain/model"
+)
+
+type CareerGoalRepository interface {
+	// FindByUserIDs はユーザーの目標を学習活動とともに、ユーザーごとに達成予定月の早い順に返します
+	FindByUserIDs(ctx context.Context, userIDs []string) ([]model.CareerGoal, error)
+	// FindByID は目標を学習活動とともに返します。存在しない場合は ErrNotFound を返します
+	FindByID(ctx context.Context, id int) (model.CareerGoal, error)
+	Create(ctx context.Context, goal model.CareerGoal) (model.CareerGoal, error)
+	// Update は目標を更新します。学習活動は更新しません。存在しない場合は ErrNotFound を返します
+	Update(ctx context.Context, goal model.CareerGoal) (model.CareerGoal, error)
+	// Delete は目標を学習活動とともに削除します。存在しない場合は ErrNotFound を返します
+	Delete(ctx context.Context, id int) error
+	CreateActivity(ctx context.Context, activity model.LearningActivity) (model.LearningActivity, error)
+	// UpdateActivity は学習活動を更新します。目標の学習活動でない場合は ErrNotFound を返します
+	UpdateActivity(ctx context.Context, activity model.LearningActivity) (model.LearningActivity, error)
+	// DeleteActivity は学習活動を削除します。目標の学習活動でない場合は ErrNotFound を返します
+	DeleteActivity(ctx context.Context, goalID, activityID int) error
+}
@@ -0,0 +1,15 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/mock_$GOFILE -package=mock
+package repository
+
+import (
+	"context"
+	"stackies/backend/domain/model"
+)
+
+type CatalogRepository interface {
+	// GetAll はコンテキストのテナントの言語・ツールのカタログの全項目を返します
+	// テナントが共通のカタログを引き継ぐ場合は model.MergeCatalogItems で共通の項目とまとめます
+	GetAll(ctx context.Context) ([]model.CatalogItem, error)
+	// Create はコンテキストのテナント独自の項目を登録します
+	Create(ctx context.Context, item model.CatalogItem) (model.CatalogItem, error)
+}
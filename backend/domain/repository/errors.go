@@ -0,0 +1,12 @@
+package repository
+
+import "errors"
+
+var (
+	// ErrNotFound は対象のレコードが存在しない（論理削除済みを含む）ことを表します
+	ErrNotFound = errors.New("not found")
+	// ErrConflict は更新・削除しようとしたレコードのバージョンが一致しないことを表します
+	ErrConflict = errors.New("conflict")
+	// ErrTenantRequired はテナントごとのデータをコンテキストにテナントがない状態で読み書きしようとしたことを表します
+	ErrTenantRequired = errors.New("tenant is required")
+)
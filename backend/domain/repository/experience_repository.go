@@ -2,10 +2,28 @@
 package repository
 
 import (
-	"stackies/backend/infra/repository/model"
+	"context"
+	"stackies/backend/domain/model"
 )
 
 type ExperienceRepository interface {
-	GetAll() ([]model.Experience, error)
-	Create(experience model.Experience) error
+	// GetAll はテナントの全てのユーザーの業務経歴を返します。サーバー側の集計だけに使い、ユーザーに一覧として返さないでください
+	GetAll(ctx context.Context) ([]model.Experience, error)
+	FindByID(ctx context.Context, id int) (model.Experience, error)
+	// FindByUserID はユーザーが登録した業務経歴を返します
+	FindByUserID(ctx context.Context, userID string) ([]model.Experience, error)
+	// FindByUserIDs は複数のユーザーが登録した業務経歴をまとめて返します
+	FindByUserIDs(ctx context.Context, userIDs []string) ([]model.Experience, error)
+	// FindByUserSkills はいずれかのスキルを業務経歴に登録しているユーザーの、全ての業務経歴を返します
+	// スキルは区分と名前（大文字・小文字は区別しない）で照合します
+	FindByUserSkills(ctx context.Context, skills []model.Skill) ([]model.Experience, error)
+	Create(ctx context.Context, experience model.Experience) (model.Experience, error)
+	// Update は experience.Version が現在のバージョンと一致する場合のみ更新し、バージョンを1つ進めます
+	// 一致しない場合は ErrConflict を返します
+	Update(ctx context.Context, experience model.Experience) (model.Experience, error)
+	// Delete は version が現在のバージョンと一致する場合のみ論理削除します
+	Delete(ctx context.Context, id int, version int) error
+	// Restore は userID のユーザーが登録した業務経歴の論理削除を取り消します
+	// 論理削除されていない場合や他のユーザーの業務経歴の場合は ErrNotFound を返します
+	Restore(ctx context.Context, id int, userID string) (model.Experience, error)
 }
@@ -0,0 +1,15 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/mock_$GOFILE -package=mock
+package repository
+
+import (
+	"context"
+	"stackies/backend/domain/model"
+)
+
+type ExperienceRevisionRepository interface {
+	// Create は次のリビジョン番号を採番して保存します
+	Create(ctx context.Context, revision model.ExperienceRevision) (model.ExperienceRevision, error)
+	// FindByExperienceID はリビジョンの古い順に返します
+	FindByExperienceID(ctx context.Context, experienceID int) ([]model.ExperienceRevision, error)
+	FindByRevision(ctx context.Context, experienceID int, revision int) (model.ExperienceRevision, error)
+}
@@ -0,0 +1,154 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: career_goal_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	model "stackies/backend/domain/model"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCareerGoalRepository is a mock of CareerGoalRepository interface.
+type MockCareerGoalRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCareerGoalRepositoryMockRecorder
+}
+
+// MockCareerGoalRepositoryMockRecorder is the mock recorder for MockCareerGoalRepository.
+type MockCareerGoalRepositoryMockRecorder struct {
+	mock *MockCareerGoalRepository
+}
+
+// NewMockCareerGoalRepository creates a new mock instance.
+func NewMockCareerGoalRepository(ctrl *gomock.Controller) *MockCareerGoalRepository {
+	mock := &MockCareerGoalRepository{ctrl: ctrl}
+	mock.recorder = &MockCareerGoalRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCareerGoalRepository) EXPECT() *MockCareerGoalRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCareerGoalRepository) Create(ctx context.Context, goal model.CareerGoal) (model.CareerGoal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, goal)
+	ret0, _ := ret[0].(model.CareerGoal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCareerGoalRepositoryMockRecorder) Create(ctx, goal interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCareerGoalRepository)(nil).Create), ctx, goal)
+}
+
+// CreateActivity mocks base method.
+func (m *MockCareerGoalRepository) CreateActivity(ctx context.Context, activity model.LearningActivity) (model.LearningActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateActivity", ctx, activity)
+	ret0, _ := ret[0].(model.LearningActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateActivity indicates an expected call of CreateActivity.
+func (mr *MockCareerGoalRepositoryMockRecorder) CreateActivity(ctx, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateActivity", reflect.TypeOf((*MockCareerGoalRepository)(nil).CreateActivity), ctx, activity)
+}
+
+// Delete mocks base method.
+func (m *MockCareerGoalRepository) Delete(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCareerGoalRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCareerGoalRepository)(nil).Delete), ctx, id)
+}
+
+// DeleteActivity mocks base method.
+func (m *MockCareerGoalRepository) DeleteActivity(ctx context.Context, goalID, activityID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteActivity", ctx, goalID, activityID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteActivity indicates an expected call of DeleteActivity.
+func (mr *MockCareerGoalRepositoryMockRecorder) DeleteActivity(ctx, goalID, activityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteActivity", reflect.TypeOf((*MockCareerGoalRepository)(nil).DeleteActivity), ctx, goalID, activityID)
+}
+
+// FindByID mocks base method.
+func (m *MockCareerGoalRepository) FindByID(ctx context.Context, id int) (model.CareerGoal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(model.CareerGoal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockCareerGoalRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockCareerGoalRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByUserIDs mocks base method.
+func (m *MockCareerGoalRepository) FindByUserIDs(ctx context.Context, userIDs []string) ([]model.CareerGoal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserIDs", ctx, userIDs)
+	ret0, _ := ret[0].([]model.CareerGoal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserIDs indicates an expected call of FindByUserIDs.
+func (mr *MockCareerGoalRepositoryMockRecorder) FindByUserIDs(ctx, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserIDs", reflect.TypeOf((*MockCareerGoalRepository)(nil).FindByUserIDs), ctx, userIDs)
+}
+
+// Update mocks base method.
+func (m *MockCareerGoalRepository) Update(ctx context.Context, goal model.CareerGoal) (model.CareerGoal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, goal)
+	ret0, _ := ret[0].(model.CareerGoal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCareerGoalRepositoryMockRecorder) Update(ctx, goal interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCareerGoalRepository)(nil).Update), ctx, goal)
+}
+
+// UpdateActivity mocks base method.
+func (m *MockCareerGoalRepository) UpdateActivity(ctx context.Context, activity model.LearningActivity) (model.LearningActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActivity", ctx, activity)
+	ret0, _ := ret[0].(model.LearningActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActivity indicates an expected call of UpdateActivity.
+func (mr *MockCareerGoalRepositoryMockRecorder) UpdateActivity(ctx, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivity", reflect.TypeOf((*MockCareerGoalRepository)(nil).UpdateActivity), ctx, activity)
+}
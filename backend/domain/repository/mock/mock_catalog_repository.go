@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: catalog_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	model "stackies/backend/domain/model"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCatalogRepository is a mock of CatalogRepository interface.
+type MockCatalogRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCatalogRepositoryMockRecorder
+}
+
+// MockCatalogRepositoryMockRecorder is the mock recorder for MockCatalogRepository.
+type MockCatalogRepositoryMockRecorder struct {
+	mock *MockCatalogRepository
+}
+
+// NewMockCatalogRepository creates a new mock instance.
+func NewMockCatalogRepository(ctrl *gomock.Controller) *MockCatalogRepository {
+	mock := &MockCatalogRepository{ctrl: ctrl}
+	mock.recorder = &MockCatalogRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCatalogRepository) EXPECT() *MockCatalogRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCatalogRepository) Create(ctx context.Context, item model.CatalogItem) (model.CatalogItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, item)
+	ret0, _ := ret[0].(model.CatalogItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCatalogRepositoryMockRecorder) Create(ctx, item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCatalogRepository)(nil).Create), ctx, item)
+}
+
+// GetAll mocks base method.
+func (m *MockCatalogRepository) GetAll(ctx context.Context) ([]model.CatalogItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]model.CatalogItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockCatalogRepositoryMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockCatalogRepository)(nil).GetAll), ctx)
+}
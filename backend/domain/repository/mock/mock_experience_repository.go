@@ -5,8 +5,9 @@
 package mock
 
 import (
+	context "context"
 	reflect "reflect"
-	model "stackies/backend/infra/repository/model"
+	model "stackies/backend/domain/model"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -35,30 +36,135 @@ func (m *MockExperienceRepository) EXPECT() *MockExperienceRepositoryMockRecorde
 }
 
 // Create mocks base method.
-func (m *MockExperienceRepository) Create(experience model.Experience) error {
+func (m *MockExperienceRepository) Create(ctx context.Context, experience model.Experience) (model.Experience, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", experience)
+	ret := m.ctrl.Call(m, "Create", ctx, experience)
+	ret0, _ := ret[0].(model.Experience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockExperienceRepositoryMockRecorder) Create(ctx, experience interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockExperienceRepository)(nil).Create), ctx, experience)
+}
+
+// Delete mocks base method.
+func (m *MockExperienceRepository) Delete(ctx context.Context, id, version int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Create indicates an expected call of Create.
-func (mr *MockExperienceRepositoryMockRecorder) Create(experience interface{}) *gomock.Call {
+// Delete indicates an expected call of Delete.
+func (mr *MockExperienceRepositoryMockRecorder) Delete(ctx, id, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockExperienceRepository)(nil).Create), experience)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockExperienceRepository)(nil).Delete), ctx, id, version)
+}
+
+// FindByID mocks base method.
+func (m *MockExperienceRepository) FindByID(ctx context.Context, id int) (model.Experience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(model.Experience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockExperienceRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockExperienceRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByUserID mocks base method.
+func (m *MockExperienceRepository) FindByUserID(ctx context.Context, userID string) ([]model.Experience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID)
+	ret0, _ := ret[0].([]model.Experience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockExperienceRepositoryMockRecorder) FindByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockExperienceRepository)(nil).FindByUserID), ctx, userID)
+}
+
+// FindByUserIDs mocks base method.
+func (m *MockExperienceRepository) FindByUserIDs(ctx context.Context, userIDs []string) ([]model.Experience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserIDs", ctx, userIDs)
+	ret0, _ := ret[0].([]model.Experience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserIDs indicates an expected call of FindByUserIDs.
+func (mr *MockExperienceRepositoryMockRecorder) FindByUserIDs(ctx, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserIDs", reflect.TypeOf((*MockExperienceRepository)(nil).FindByUserIDs), ctx, userIDs)
+}
+
+// FindByUserSkills mocks base method.
+func (m *MockExperienceRepository) FindByUserSkills(ctx context.Context, skills []model.Skill) ([]model.Experience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserSkills", ctx, skills)
+	ret0, _ := ret[0].([]model.Experience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserSkills indicates an expected call of FindByUserSkills.
+func (mr *MockExperienceRepositoryMockRecorder) FindByUserSkills(ctx, skills interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserSkills", reflect.TypeOf((*MockExperienceRepository)(nil).FindByUserSkills), ctx, skills)
 }
 
 // GetAll mocks base method.
-func (m *MockExperienceRepository) GetAll() ([]model.Experience, error) {
+func (m *MockExperienceRepository) GetAll(ctx context.Context) ([]model.Experience, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll")
+	ret := m.ctrl.Call(m, "GetAll", ctx)
 	ret0, _ := ret[0].([]model.Experience)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockExperienceRepositoryMockRecorder) GetAll() *gomock.Call {
+func (mr *MockExperienceRepositoryMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockExperienceRepository)(nil).GetAll), ctx)
+}
+
+// Restore mocks base method.
+func (m *MockExperienceRepository) Restore(ctx context.Context, id int, userID string) (model.Experience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id, userID)
+	ret0, _ := ret[0].(model.Experience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockExperienceRepositoryMockRecorder) Restore(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockExperienceRepository)(nil).Restore), ctx, id, userID)
+}
+
+// Update mocks base method.
+func (m *MockExperienceRepository) Update(ctx context.Context, experience model.Experience) (model.Experience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, experience)
+	ret0, _ := ret[0].(model.Experience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockExperienceRepositoryMockRecorder) Update(ctx, experience interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockExperienceRepository)(nil).GetAll))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockExperienceRepository)(nil).Update), ctx, experience)
 }
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: experience_revision_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	model "stackies/backend/domain/model"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockExperienceRevisionRepository is a mock of ExperienceRevisionRepository interface.
+type MockExperienceRevisionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExperienceRevisionRepositoryMockRecorder
+}
+
+// MockExperienceRevisionRepositoryMockRecorder is the mock recorder for MockExperienceRevisionRepository.
+type MockExperienceRevisionRepositoryMockRecorder struct {
+	mock *MockExperienceRevisionRepository
+}
+
+// NewMockExperienceRevisionRepository creates a new mock instance.
+func NewMockExperienceRevisionRepository(ctrl *gomock.Controller) *MockExperienceRevisionRepository {
+	mock := &MockExperienceRevisionRepository{ctrl: ctrl}
+	mock.recorder = &MockExperienceRevisionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExperienceRevisionRepository) EXPECT() *MockExperienceRevisionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockExperienceRevisionRepository) Create(ctx context.Context, revision model.ExperienceRevision) (model.ExperienceRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, revision)
+	ret0, _ := ret[0].(model.ExperienceRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockExperienceRevisionRepositoryMockRecorder) Create(ctx, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockExperienceRevisionRepository)(nil).Create), ctx, revision)
+}
+
+// FindByExperienceID mocks base method.
+func (m *MockExperienceRevisionRepository) FindByExperienceID(ctx context.Context, experienceID int) ([]model.ExperienceRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByExperienceID", ctx, experienceID)
+	ret0, _ := ret[0].([]model.ExperienceRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByExperienceID indicates an expected call of FindByExperienceID.
+func (mr *MockExperienceRevisionRepositoryMockRecorder) FindByExperienceID(ctx, experienceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByExperienceID", reflect.TypeOf((*MockExperienceRevisionRepository)(nil).FindByExperienceID), ctx, experienceID)
+}
+
+// FindByRevision mocks base method.
+func (m *MockExperienceRevisionRepository) FindByRevision(ctx context.Context, experienceID, revision int) (model.ExperienceRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByRevision", ctx, experienceID, revision)
+	ret0, _ := ret[0].(model.ExperienceRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByRevision indicates an expected call of FindByRevision.
+func (mr *MockExperienceRevisionRepositoryMockRecorder) FindByRevision(ctx, experienceID, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByRevision", reflect.TypeOf((*MockExperienceRevisionRepository)(nil).FindByRevision), ctx, experienceID, revision)
+}
@@ -0,0 +1,200 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: organization_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	model "stackies/backend/domain/model"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOrganizationRepository is a mock of OrganizationRepository interface.
+type MockOrganizationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizationRepositoryMockRecorder
+}
+
+// MockOrganizationRepositoryMockRecorder is the mock recorder for MockOrganizationRepository.
+type MockOrganizationRepositoryMockRecorder struct {
+	mock *MockOrganizationRepository
+}
+
+// NewMockOrganizationRepository creates a new mock instance.
+func NewMockOrganizationRepository(ctrl *gomock.Controller) *MockOrganizationRepository {
+	mock := &MockOrganizationRepository{ctrl: ctrl}
+	mock.recorder = &MockOrganizationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizationRepository) EXPECT() *MockOrganizationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockOrganizationRepository) Create(ctx context.Context, organization model.Organization) (model.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, organization)
+	ret0, _ := ret[0].(model.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOrganizationRepositoryMockRecorder) Create(ctx, organization interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrganizationRepository)(nil).Create), ctx, organization)
+}
+
+// CreateTeam mocks base method.
+func (m *MockOrganizationRepository) CreateTeam(ctx context.Context, team model.Team) (model.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTeam", ctx, team)
+	ret0, _ := ret[0].(model.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTeam indicates an expected call of CreateTeam.
+func (mr *MockOrganizationRepositoryMockRecorder) CreateTeam(ctx, team interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeam", reflect.TypeOf((*MockOrganizationRepository)(nil).CreateTeam), ctx, team)
+}
+
+// DeleteMember mocks base method.
+func (m *MockOrganizationRepository) DeleteMember(ctx context.Context, teamID int, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMember", ctx, teamID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMember indicates an expected call of DeleteMember.
+func (mr *MockOrganizationRepositoryMockRecorder) DeleteMember(ctx, teamID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMember", reflect.TypeOf((*MockOrganizationRepository)(nil).DeleteMember), ctx, teamID, userID)
+}
+
+// FindByID mocks base method.
+func (m *MockOrganizationRepository) FindByID(ctx context.Context, id int) (model.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(model.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockOrganizationRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockOrganizationRepository)(nil).FindByID), ctx, id)
+}
+
+// FindMembersByOrganizationID mocks base method.
+func (m *MockOrganizationRepository) FindMembersByOrganizationID(ctx context.Context, organizationID int) ([]model.TeamMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMembersByOrganizationID", ctx, organizationID)
+	ret0, _ := ret[0].([]model.TeamMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMembersByOrganizationID indicates an expected call of FindMembersByOrganizationID.
+func (mr *MockOrganizationRepositoryMockRecorder) FindMembersByOrganizationID(ctx, organizationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMembersByOrganizationID", reflect.TypeOf((*MockOrganizationRepository)(nil).FindMembersByOrganizationID), ctx, organizationID)
+}
+
+// FindMembersByTeamID mocks base method.
+func (m *MockOrganizationRepository) FindMembersByTeamID(ctx context.Context, teamID int) ([]model.TeamMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMembersByTeamID", ctx, teamID)
+	ret0, _ := ret[0].([]model.TeamMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMembersByTeamID indicates an expected call of FindMembersByTeamID.
+func (mr *MockOrganizationRepositoryMockRecorder) FindMembersByTeamID(ctx, teamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMembersByTeamID", reflect.TypeOf((*MockOrganizationRepository)(nil).FindMembersByTeamID), ctx, teamID)
+}
+
+// FindMembershipPeriodsByUserID mocks base method.
+func (m *MockOrganizationRepository) FindMembershipPeriodsByUserID(ctx context.Context, userID string) ([]model.TeamMembershipPeriod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMembershipPeriodsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]model.TeamMembershipPeriod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMembershipPeriodsByUserID indicates an expected call of FindMembershipPeriodsByUserID.
+func (mr *MockOrganizationRepositoryMockRecorder) FindMembershipPeriodsByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMembershipPeriodsByUserID", reflect.TypeOf((*MockOrganizationRepository)(nil).FindMembershipPeriodsByUserID), ctx, userID)
+}
+
+// FindMembershipsByUserID mocks base method.
+func (m *MockOrganizationRepository) FindMembershipsByUserID(ctx context.Context, userID string) ([]model.TeamMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMembershipsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]model.TeamMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMembershipsByUserID indicates an expected call of FindMembershipsByUserID.
+func (mr *MockOrganizationRepositoryMockRecorder) FindMembershipsByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMembershipsByUserID", reflect.TypeOf((*MockOrganizationRepository)(nil).FindMembershipsByUserID), ctx, userID)
+}
+
+// FindTeamByID mocks base method.
+func (m *MockOrganizationRepository) FindTeamByID(ctx context.Context, id int) (model.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTeamByID", ctx, id)
+	ret0, _ := ret[0].(model.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTeamByID indicates an expected call of FindTeamByID.
+func (mr *MockOrganizationRepositoryMockRecorder) FindTeamByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTeamByID", reflect.TypeOf((*MockOrganizationRepository)(nil).FindTeamByID), ctx, id)
+}
+
+// FindTeamsByOrganizationID mocks base method.
+func (m *MockOrganizationRepository) FindTeamsByOrganizationID(ctx context.Context, organizationID int) ([]model.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTeamsByOrganizationID", ctx, organizationID)
+	ret0, _ := ret[0].([]model.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTeamsByOrganizationID indicates an expected call of FindTeamsByOrganizationID.
+func (mr *MockOrganizationRepositoryMockRecorder) FindTeamsByOrganizationID(ctx, organizationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTeamsByOrganizationID", reflect.TypeOf((*MockOrganizationRepository)(nil).FindTeamsByOrganizationID), ctx, organizationID)
+}
+
+// SaveMember mocks base method.
+func (m *MockOrganizationRepository) SaveMember(ctx context.Context, member model.TeamMember) (model.TeamMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveMember", ctx, member)
+	ret0, _ := ret[0].(model.TeamMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveMember indicates an expected call of SaveMember.
+func (mr *MockOrganizationRepositoryMockRecorder) SaveMember(ctx, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMember", reflect.TypeOf((*MockOrganizationRepository)(nil).SaveMember), ctx, member)
+}
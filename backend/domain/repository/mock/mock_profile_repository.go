@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: profile_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	model "stackies/backend/domain/model"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockProfileRepository is a mock of ProfileRepository interface.
+type MockProfileRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockProfileRepositoryMockRecorder
+}
+
+// MockProfileRepositoryMockRecorder is the mock recorder for MockProfileRepository.
+type MockProfileRepositoryMockRecorder struct {
+	mock *MockProfileRepository
+}
+
+// NewMockProfileRepository creates a new mock instance.
+func NewMockProfileRepository(ctrl *gomock.Controller) *MockProfileRepository {
+	mock := &MockProfileRepository{ctrl: ctrl}
+	mock.recorder = &MockProfileRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProfileRepository) EXPECT() *MockProfileRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByUserID mocks base method.
+func (m *MockProfileRepository) FindByUserID(ctx context.Context, userID string) (model.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID)
+	ret0, _ := ret[0].(model.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockProfileRepositoryMockRecorder) FindByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockProfileRepository)(nil).FindByUserID), ctx, userID)
+}
+
+// FindByUserIDs mocks base method.
+func (m *MockProfileRepository) FindByUserIDs(ctx context.Context, userIDs []string) ([]model.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserIDs", ctx, userIDs)
+	ret0, _ := ret[0].([]model.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserIDs indicates an expected call of FindByUserIDs.
+func (mr *MockProfileRepositoryMockRecorder) FindByUserIDs(ctx, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserIDs", reflect.TypeOf((*MockProfileRepository)(nil).FindByUserIDs), ctx, userIDs)
+}
+
+// Save mocks base method.
+func (m *MockProfileRepository) Save(ctx context.Context, profile model.Profile) (model.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, profile)
+	ret0, _ := ret[0].(model.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockProfileRepositoryMockRecorder) Save(ctx, profile interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockProfileRepository)(nil).Save), ctx, profile)
+}
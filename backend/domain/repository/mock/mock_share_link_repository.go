@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: share_link_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	model "stackies/backend/domain/model"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockShareLinkRepository is a mock of ShareLinkRepository interface.
+type MockShareLinkRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockShareLinkRepositoryMockRecorder
+}
+
+// MockShareLinkRepositoryMockRecorder is the mock recorder for MockShareLinkRepository.
+type MockShareLinkRepositoryMockRecorder struct {
+	mock *MockShareLinkRepository
+}
+
+// NewMockShareLinkRepository creates a new mock instance.
+func NewMockShareLinkRepository(ctrl *gomock.Controller) *MockShareLinkRepository {
+	mock := &MockShareLinkRepository{ctrl: ctrl}
+	mock.recorder = &MockShareLinkRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShareLinkRepository) EXPECT() *MockShareLinkRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockShareLinkRepository) Create(ctx context.Context, link model.ShareLink) (model.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, link)
+	ret0, _ := ret[0].(model.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockShareLinkRepositoryMockRecorder) Create(ctx, link interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockShareLinkRepository)(nil).Create), ctx, link)
+}
+
+// FindByID mocks base method.
+func (m *MockShareLinkRepository) FindByID(ctx context.Context, id int) (model.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(model.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockShareLinkRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockShareLinkRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByTokenHash mocks base method.
+func (m *MockShareLinkRepository) FindByTokenHash(ctx context.Context, tokenHash string) (model.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTokenHash", ctx, tokenHash)
+	ret0, _ := ret[0].(model.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByTokenHash indicates an expected call of FindByTokenHash.
+func (mr *MockShareLinkRepositoryMockRecorder) FindByTokenHash(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTokenHash", reflect.TypeOf((*MockShareLinkRepository)(nil).FindByTokenHash), ctx, tokenHash)
+}
+
+// FindByUserID mocks base method.
+func (m *MockShareLinkRepository) FindByUserID(ctx context.Context, userID string) ([]model.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID)
+	ret0, _ := ret[0].([]model.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockShareLinkRepositoryMockRecorder) FindByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockShareLinkRepository)(nil).FindByUserID), ctx, userID)
+}
+
+// IncrementViewCount mocks base method.
+func (m *MockShareLinkRepository) IncrementViewCount(ctx context.Context, id int, viewedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementViewCount", ctx, id, viewedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementViewCount indicates an expected call of IncrementViewCount.
+func (mr *MockShareLinkRepositoryMockRecorder) IncrementViewCount(ctx, id, viewedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementViewCount", reflect.TypeOf((*MockShareLinkRepository)(nil).IncrementViewCount), ctx, id, viewedAt)
+}
+
+// Revoke mocks base method.
+func (m *MockShareLinkRepository) Revoke(ctx context.Context, id int, revokedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id, revokedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockShareLinkRepositoryMockRecorder) Revoke(ctx, id, revokedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockShareLinkRepository)(nil).Revoke), ctx, id, revokedAt)
+}
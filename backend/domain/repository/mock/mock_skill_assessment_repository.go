@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: skill_assessment_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	model "stackies/backend/domain/model"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSkillAssessmentRepository is a mock of SkillAssessmentRepository interface.
+type MockSkillAssessmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSkillAssessmentRepositoryMockRecorder
+}
+
+// MockSkillAssessmentRepositoryMockRecorder is the mock recorder for MockSkillAssessmentRepository.
+type MockSkillAssessmentRepositoryMockRecorder struct {
+	mock *MockSkillAssessmentRepository
+}
+
+// NewMockSkillAssessmentRepository creates a new mock instance.
+func NewMockSkillAssessmentRepository(ctrl *gomock.Controller) *MockSkillAssessmentRepository {
+	mock := &MockSkillAssessmentRepository{ctrl: ctrl}
+	mock.recorder = &MockSkillAssessmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSkillAssessmentRepository) EXPECT() *MockSkillAssessmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByUserID mocks base method.
+func (m *MockSkillAssessmentRepository) FindByUserID(ctx context.Context, userID string) ([]model.SkillAssessment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID)
+	ret0, _ := ret[0].([]model.SkillAssessment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockSkillAssessmentRepositoryMockRecorder) FindByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockSkillAssessmentRepository)(nil).FindByUserID), ctx, userID)
+}
+
+// FindEndorsementsByUserID mocks base method.
+func (m *MockSkillAssessmentRepository) FindEndorsementsByUserID(ctx context.Context, userID string) ([]model.SkillEndorsement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEndorsementsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]model.SkillEndorsement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEndorsementsByUserID indicates an expected call of FindEndorsementsByUserID.
+func (mr *MockSkillAssessmentRepositoryMockRecorder) FindEndorsementsByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEndorsementsByUserID", reflect.TypeOf((*MockSkillAssessmentRepository)(nil).FindEndorsementsByUserID), ctx, userID)
+}
+
+// Save mocks base method.
+func (m *MockSkillAssessmentRepository) Save(ctx context.Context, assessment model.SkillAssessment) (model.SkillAssessment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, assessment)
+	ret0, _ := ret[0].(model.SkillAssessment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockSkillAssessmentRepositoryMockRecorder) Save(ctx, assessment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockSkillAssessmentRepository)(nil).Save), ctx, assessment)
+}
+
+// SaveEndorsement mocks base method.
+func (m *MockSkillAssessmentRepository) SaveEndorsement(ctx context.Context, endorsement model.SkillEndorsement) (model.SkillEndorsement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveEndorsement", ctx, endorsement)
+	ret0, _ := ret[0].(model.SkillEndorsement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveEndorsement indicates an expected call of SaveEndorsement.
+func (mr *MockSkillAssessmentRepositoryMockRecorder) SaveEndorsement(ctx, endorsement interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveEndorsement", reflect.TypeOf((*MockSkillAssessmentRepository)(nil).SaveEndorsement), ctx, endorsement)
+}
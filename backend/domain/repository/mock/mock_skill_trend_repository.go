@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: skill_trend_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	model "stackies/backend/domain/model"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSkillTrendRepository is a mock of SkillTrendRepository interface.
+type MockSkillTrendRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSkillTrendRepositoryMockRecorder
+}
+
+// MockSkillTrendRepositoryMockRecorder is the mock recorder for MockSkillTrendRepository.
+type MockSkillTrendRepositoryMockRecorder struct {
+	mock *MockSkillTrendRepository
+}
+
+// NewMockSkillTrendRepository creates a new mock instance.
+func NewMockSkillTrendRepository(ctrl *gomock.Controller) *MockSkillTrendRepository {
+	mock := &MockSkillTrendRepository{ctrl: ctrl}
+	mock.recorder = &MockSkillTrendRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSkillTrendRepository) EXPECT() *MockSkillTrendRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindQuarterlyUsage mocks base method.
+func (m *MockSkillTrendRepository) FindQuarterlyUsage(ctx context.Context, organizationID int, from, to model.Quarter) ([]model.SkillQuarterUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindQuarterlyUsage", ctx, organizationID, from, to)
+	ret0, _ := ret[0].([]model.SkillQuarterUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindQuarterlyUsage indicates an expected call of FindQuarterlyUsage.
+func (mr *MockSkillTrendRepositoryMockRecorder) FindQuarterlyUsage(ctx, organizationID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindQuarterlyUsage", reflect.TypeOf((*MockSkillTrendRepository)(nil).FindQuarterlyUsage), ctx, organizationID, from, to)
+}
+
+// FindSoleHolders mocks base method.
+func (m *MockSkillTrendRepository) FindSoleHolders(ctx context.Context, organizationID int) ([]model.SkillHolder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSoleHolders", ctx, organizationID)
+	ret0, _ := ret[0].([]model.SkillHolder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSoleHolders indicates an expected call of FindSoleHolders.
+func (mr *MockSkillTrendRepositoryMockRecorder) FindSoleHolders(ctx, organizationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSoleHolders", reflect.TypeOf((*MockSkillTrendRepository)(nil).FindSoleHolders), ctx, organizationID)
+}
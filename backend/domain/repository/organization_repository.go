@@ -0,0 +1,31 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/mock_$GOFILE -package=mock
+package repository
+
+import (
+	"context"
+	"stackies/backend/domain/model"
+)
+
+type OrganizationRepository interface {
+	FindByID(ctx context.Context, id int) (model.Organization, error)
+	Create(ctx context.Context, organization model.Organization) (model.Organization, error)
+	// FindTeamByID はチームを返します。存在しない場合は ErrNotFound を返します
+	FindTeamByID(ctx context.Context, id int) (model.Team, error)
+	// FindTeamsByOrganizationID は組織のチームを作成順に返します
+	FindTeamsByOrganizationID(ctx context.Context, organizationID int) ([]model.Team, error)
+	CreateTeam(ctx context.Context, team model.Team) (model.Team, error)
+	// FindMembersByTeamID はチームに所属するユーザーを所属した順に返します
+	FindMembersByTeamID(ctx context.Context, teamID int) ([]model.TeamMember, error)
+	// FindMembersByOrganizationID は組織のいずれかのチームに所属するユーザーを返します
+	// 複数のチームに所属するユーザーはチームごとに返します
+	FindMembersByOrganizationID(ctx context.Context, organizationID int) ([]model.TeamMember, error)
+	// FindMembershipsByUserID はユーザーが所属するチームを返します
+	FindMembershipsByUserID(ctx context.Context, userID string) ([]model.TeamMember, error)
+	// FindMembershipPeriodsByUserID はユーザーがチームに所属していた期間を、外れたチームも含めて古い順に返します
+	FindMembershipPeriodsByUserID(ctx context.Context, userID string) ([]model.TeamMembershipPeriod, error)
+	// SaveMember はユーザーをチームに所属させます。所属済みの場合は役割を更新します
+	// 所属した場合と役割を変更した場合は、所属期間の履歴を区切ります
+	SaveMember(ctx context.Context, member model.TeamMember) (model.TeamMember, error)
+	// DeleteMember はユーザーをチームから外し、所属期間の履歴を終了します。所属していない場合は ErrNotFound を返します
+	DeleteMember(ctx context.Context, teamID int, userID string) error
+}
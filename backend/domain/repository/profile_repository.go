@@ -0,0 +1,16 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/mock_$GOFILE -package=mock
+package repository
+
+import (
+	"context"
+	"stackies/backend/domain/model"
+)
+
+type ProfileRepository interface {
+	// FindByUserID はプロフィールを資格・学歴とともに返します。未登録の場合は ErrNotFound を返します
+	FindByUserID(ctx context.Context, userID string) (model.Profile, error)
+	// FindByUserIDs は複数のユーザーのプロフィールをまとめて返します。未登録のユーザーのプロフィールは含みません
+	FindByUserIDs(ctx context.Context, userIDs []string) ([]model.Profile, error)
+	// Save はプロフィールを登録または更新します。資格・学歴は渡した内容で置き換えます
+	Save(ctx context.Context, profile model.Profile) (model.Profile, error)
+}
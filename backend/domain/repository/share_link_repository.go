@@ -0,0 +1,21 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/mock_$GOFILE -package=mock
+package repository
+
+import (
+	"context"
+	"stackies/backend/domain/model"
+	"time"
+)
+
+type ShareLinkRepository interface {
+	// FindByUserID はユーザーの共有リンクを作成日時の新しい順に返します（無効にしたものを含みます）
+	FindByUserID(ctx context.Context, userID string) ([]model.ShareLink, error)
+	FindByID(ctx context.Context, id int) (model.ShareLink, error)
+	// FindByTokenHash はトークンのハッシュ値で共有リンクを返します。存在しない場合は ErrNotFound を返します
+	FindByTokenHash(ctx context.Context, tokenHash string) (model.ShareLink, error)
+	Create(ctx context.Context, link model.ShareLink) (model.ShareLink, error)
+	// Revoke は共有リンクを無効にします。無効にした日時が既にある場合は変更しません
+	Revoke(ctx context.Context, id int, revokedAt time.Time) error
+	// IncrementViewCount は閲覧数を1つ増やし、最後に閲覧された日時を更新します
+	IncrementViewCount(ctx context.Context, id int, viewedAt time.Time) error
+}
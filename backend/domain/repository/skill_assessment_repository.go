@@ -0,0 +1,18 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/mock_$GOFILE -package=mock
+package repository
+
+import (
+	"context"
+	"stackies/backend/domain/model"
+)
+
+type SkillAssessmentRepository interface {
+	// FindByUserID はユーザーの自己評価を評価した順に返します
+	FindByUserID(ctx context.Context, userID string) ([]model.SkillAssessment, error)
+	// Save は自己評価を登録します。同じスキル（大文字・小文字は区別しない）を評価済みの場合は上書きします
+	Save(ctx context.Context, assessment model.SkillAssessment) (model.SkillAssessment, error)
+	// FindEndorsementsByUserID はユーザーが受けた推薦を新しい順に返します
+	FindEndorsementsByUserID(ctx context.Context, userID string) ([]model.SkillEndorsement, error)
+	// SaveEndorsement は推薦を登録します。同じユーザーが同じスキルを推薦済みの場合は上書きします
+	SaveEndorsement(ctx context.Context, endorsement model.SkillEndorsement) (model.SkillEndorsement, error)
+}
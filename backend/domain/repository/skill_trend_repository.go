@@ -0,0 +1,17 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/mock_$GOFILE -package=mock
+package repository
+
+import (
+	"context"
+	"stackies/backend/domain/model"
+)
+
+// SkillTrendRepository は組織のいずれかのチームに所属するユーザーの業務経歴を、データベースで集計します
+// スキルは区分と名前（大文字・小文字は区別しない）が同じものをまとめます
+type SkillTrendRepository interface {
+	// FindQuarterlyUsage は from から to までの四半期ごと・スキルごとの人月を、四半期の古い順に返します
+	// 参画中の業務経歴は to の四半期の最後の月まで使用したものとして数えます
+	FindQuarterlyUsage(ctx context.Context, organizationID int, from, to model.Quarter) ([]model.SkillQuarterUsage, error)
+	// FindSoleHolders は経験者が1人だけのスキルを返します
+	FindSoleHolders(ctx context.Context, organizationID int) ([]model.SkillHolder, error)
+}
@@ -0,0 +1,17 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/mock_$GOFILE -package=mock
+package repository
+
+import (
+	"context"
+	"stackies/backend/domain/model"
+)
+
+type TenantRepository interface {
+	// FindByID はテナントを返します。存在しない場合は ErrNotFound を返します
+	// リクエストのテナントを決める前に呼び出すため、コンテキストのテナントに関わらず読み取ります
+	FindByID(ctx context.Context, id string) (model.Tenant, error)
+	// FindByShareTokenHash は共有リンクのトークンのハッシュ値から、共有リンクを作成したテナントを返します
+	// 認証なしで共有リンクを開くリクエストのテナントを決めるため、コンテキストのテナントに関わらず読み取ります
+	// 一致する共有リンクがない場合は ErrNotFound を返します
+	FindByShareTokenHash(ctx context.Context, tokenHash string) (model.Tenant, error)
+}
@@ -0,0 +1,13 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/mock_$GOFILE -package=mock
+package repository
+
+import "context"
+
+// TransactionManager は複数のリポジトリ操作を1つのトランザクションで実行します
+type TransactionManager interface {
+	// Do は fn を1つのトランザクションで実行します
+	// fn に渡したコンテキストを使ったリポジトリ操作は同じトランザクションで実行され、
+	// fn がエラーを返した場合（panic を含む）はロールバックします
+	// トランザクション内で呼び出した場合はセーブポイントを使って入れ子にします
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,80 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// slowQueryThreshold を超えたクエリは警告として出力します
+const slowQueryThreshold = 200 * time.Millisecond
+
+// gormLogger は GORM のログを slog に流すアダプターです
+type gormLogger struct {
+	logger *slog.Logger
+	level  gormlogger.LogLevel
+}
+
+// NewGormLogger は slog を使う GORM のロガーを作成します
+// 実行したSQLは Debug レベルで出力します
+func NewGormLogger(logger *slog.Logger) gormlogger.Interface {
+	return &gormLogger{
+		logger: logger.With(slog.String("component", "gorm")),
+		level:  gormlogger.Info,
+	}
+}
+
+// LogMode implements logger.Interface.
+func (l *gormLogger) LogMode(level gormlogger.LogLevel) gormlogger.Interface {
+	return &gormLogger{logger: l.logger, level: level}
+}
+
+// Info implements logger.Interface.
+func (l *gormLogger) Info(ctx context.Context, msg string, args ...interface{}) {
+	if l.level >= gormlogger.Info {
+		l.logger.InfoContext(ctx, fmt.Sprintf(msg, args...))
+	}
+}
+
+// Warn implements logger.Interface.
+func (l *gormLogger) Warn(ctx context.Context, msg string, args ...interface{}) {
+	if l.level >= gormlogger.Warn {
+		l.logger.WarnContext(ctx, fmt.Sprintf(msg, args...))
+	}
+}
+
+// Error implements logger.Interface.
+func (l *gormLogger) Error(ctx context.Context, msg string, args ...interface{}) {
+	if l.level >= gormlogger.Error {
+		l.logger.ErrorContext(ctx, fmt.Sprintf(msg, args...))
+	}
+}
+
+// Trace implements logger.Interface.
+func (l *gormLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+	if l.level <= gormlogger.Silent {
+		return
+	}
+
+	elapsed := time.Since(begin)
+	sql, rows := fc()
+	attrs := []any{
+		slog.String("sql", sql),
+		slog.Int64("rows", rows),
+		slog.Float64("elapsed_ms", float64(elapsed.Nanoseconds())/1e6),
+	}
+
+	switch {
+	case err != nil && !errors.Is(err, gorm.ErrRecordNotFound) && l.level >= gormlogger.Error:
+		l.logger.ErrorContext(ctx, "query failed", append(attrs, slog.Any("error", err))...)
+	case elapsed > slowQueryThreshold && l.level >= gormlogger.Warn:
+		l.logger.WarnContext(ctx, "slow query", attrs...)
+	case l.level >= gormlogger.Info:
+		l.logger.DebugContext(ctx, "query", attrs...)
+	}
+}
@@ -0,0 +1,62 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type attrsKey struct{}
+
+// ContextWithAttrs はコンテキストにログ出力時に付与する属性を追加します
+// リクエストIDやユーザーIDなど、リクエスト単位の情報を下位の層まで引き回すために使います
+func ContextWithAttrs(ctx context.Context, attrs ...slog.Attr) context.Context {
+	current := AttrsFromContext(ctx)
+	merged := make([]slog.Attr, 0, len(current)+len(attrs))
+	merged = append(merged, current...)
+	merged = append(merged, attrs...)
+	return context.WithValue(ctx, attrsKey{}, merged)
+}
+
+// AttrsFromContext はコンテキストに設定された属性を取得します
+func AttrsFromContext(ctx context.Context) []slog.Attr {
+	if ctx == nil {
+		return nil
+	}
+	attrs, _ := ctx.Value(attrsKey{}).([]slog.Attr)
+	return attrs
+}
+
+// contextHandler はコンテキストの属性とトレースIDをログレコードに付与する slog.Handler です
+type contextHandler struct {
+	slog.Handler
+}
+
+// NewContextHandler は base をラップし、*Context 系のメソッドで渡されたコンテキストから
+// リクエスト単位の属性とトレースIDを付与するハンドラーを作成します
+func NewContextHandler(base slog.Handler) slog.Handler {
+	return &contextHandler{Handler: base}
+}
+
+// Handle implements slog.Handler.
+func (h *contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	record.AddAttrs(AttrsFromContext(ctx)...)
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		record.AddAttrs(
+			slog.String("trace_id", spanContext.TraceID().String()),
+			slog.String("span_id", spanContext.SpanID().String()),
+		)
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+// WithAttrs implements slog.Handler.
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+// WithGroup implements slog.Handler.
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	entity "stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"stackies/backend/infra/repository/model"
+
+	"gorm.io/gorm"
+)
+
+type auditLogRepository struct {
+	db *gorm.DB
+}
+
+// Create implements repository.AuditLogRepository.
+func (a *auditLogRepository) Create(ctx context.Context, auditLog entity.AuditLog) error {
+	m := model.AuditLog{
+		ActorID:    auditLog.ActorID,
+		RequestID:  auditLog.RequestID,
+		Action:     auditLog.Action,
+		EntityType: auditLog.EntityType,
+		EntityID:   auditLog.EntityID,
+		Before:     model.JSON(auditLog.Before),
+		After:      model.JSON(auditLog.After),
+		CreatedAt:  auditLog.CreatedAt,
+	}
+	if err := conn(ctx, a.db).Create(&m).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// Find implements repository.AuditLogRepository.
+func (a *auditLogRepository) Find(ctx context.Context, filter repository.AuditLogFilter) ([]entity.AuditLog, error) {
+	query := conn(ctx, a.db).Order("created_at DESC, id DESC")
+	if filter.ActorID != "" {
+		query = query.Where("actor_id = ?", filter.ActorID)
+	}
+	if filter.EntityType != "" {
+		query = query.Where("entity_type = ?", filter.EntityType)
+	}
+	if filter.EntityID != "" {
+		query = query.Where("entity_id = ?", filter.EntityID)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if !filter.From.IsZero() {
+		query = query.Where("created_at >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		query = query.Where("created_at < ?", filter.To)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	var auditLogs []model.AuditLog
+	if err := query.Find(&auditLogs).Error; err != nil {
+		return nil, err
+	}
+
+	entities := make([]entity.AuditLog, len(auditLogs))
+	for i, auditLog := range auditLogs {
+		entities[i] = entity.AuditLog{
+			ID:         auditLog.ID,
+			ActorID:    auditLog.ActorID,
+			RequestID:  auditLog.RequestID,
+			Action:     auditLog.Action,
+			EntityType: auditLog.EntityType,
+			EntityID:   auditLog.EntityID,
+			Before:     json.RawMessage(auditLog.Before),
+			After:      json.RawMessage(auditLog.After),
+			CreatedAt:  auditLog.CreatedAt,
+		}
+	}
+	return entities, nil
+}
+
+func NewAuditLogRepository(db *gorm.DB) repository.AuditLogRepository {
+	return &auditLogRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,254 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	entity "stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"stackies/backend/infra/repository/model"
+
+	"gorm.io/gorm"
+)
+
+type careerGoalRepository struct {
+	db *gorm.DB
+}
+
+// FindByUserIDs implements repository.CareerGoalRepository.
+func (c *careerGoalRepository) FindByUserIDs(ctx context.Context, userIDs []string) ([]entity.CareerGoal, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	var goals []model.CareerGoal
+	err := preloadActivities(conn(ctx, c.db)).
+		Where("user_id IN ?", userIDs).
+		Order("user_id, target_month, id").
+		Find(&goals).Error
+	if err != nil {
+		return nil, err
+	}
+	entities := make([]entity.CareerGoal, len(goals))
+	for i, goal := range goals {
+		if entities[i], err = toCareerGoalEntity(goal); err != nil {
+			return nil, err
+		}
+	}
+	return entities, nil
+}
+
+// FindByID implements repository.CareerGoalRepository.
+func (c *careerGoalRepository) FindByID(ctx context.Context, id int) (entity.CareerGoal, error) {
+	var goal model.CareerGoal
+	if err := preloadActivities(conn(ctx, c.db)).First(&goal, id).Error; err != nil {
+		return entity.CareerGoal{}, convertError(err)
+	}
+	return toCareerGoalEntity(goal)
+}
+
+// Create implements repository.CareerGoalRepository.
+func (c *careerGoalRepository) Create(ctx context.Context, goal entity.CareerGoal) (entity.CareerGoal, error) {
+	m := toCareerGoalModel(goal)
+	if err := conn(ctx, c.db).Omit("Activities").Create(&m).Error; err != nil {
+		return entity.CareerGoal{}, err
+	}
+	return toCareerGoalEntity(m)
+}
+
+// Update implements repository.CareerGoalRepository.
+func (c *careerGoalRepository) Update(ctx context.Context, goal entity.CareerGoal) (entity.CareerGoal, error) {
+	m := toCareerGoalModel(goal)
+	result := conn(ctx, c.db).Model(&model.CareerGoal{}).
+		Where("id = ?", goal.ID).
+		Updates(map[string]interface{}{
+			"kind":           m.Kind,
+			"title":          m.Title,
+			"skill_category": m.SkillCategory,
+			"skill_name":     m.SkillName,
+			"target_level":   m.TargetLevel,
+			"target_month":   m.TargetMonth,
+			"status":         m.Status,
+			"note":           m.Note,
+		})
+	if result.Error != nil {
+		return entity.CareerGoal{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.CareerGoal{}, repository.ErrNotFound
+	}
+	return c.FindByID(ctx, goal.ID)
+}
+
+// Delete implements repository.CareerGoalRepository.
+// 学習活動は外部キーの ON DELETE CASCADE で削除します
+func (c *careerGoalRepository) Delete(ctx context.Context, id int) error {
+	result := conn(ctx, c.db).Delete(&model.CareerGoal{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+// CreateActivity implements repository.CareerGoalRepository.
+func (c *careerGoalRepository) CreateActivity(ctx context.Context, activity entity.LearningActivity) (entity.LearningActivity, error) {
+	m := toLearningActivityModel(activity)
+	if err := conn(ctx, c.db).Create(&m).Error; err != nil {
+		return entity.LearningActivity{}, err
+	}
+	return toLearningActivityEntity(m)
+}
+
+// UpdateActivity implements repository.CareerGoalRepository.
+func (c *careerGoalRepository) UpdateActivity(ctx context.Context, activity entity.LearningActivity) (entity.LearningActivity, error) {
+	db := conn(ctx, c.db)
+	m := toLearningActivityModel(activity)
+	result := db.Model(&model.LearningActivity{}).
+		Where("id = ? AND goal_id = ?", activity.ID, activity.GoalID).
+		Updates(map[string]interface{}{
+			"kind":      m.Kind,
+			"title":     m.Title,
+			"url":       m.URL,
+			"status":    m.Status,
+			"progress":  m.Progress,
+			"due_month": m.DueMonth,
+		})
+	if result.Error != nil {
+		return entity.LearningActivity{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.LearningActivity{}, repository.ErrNotFound
+	}
+	var updated model.LearningActivity
+	if err := db.First(&updated, activity.ID).Error; err != nil {
+		return entity.LearningActivity{}, convertError(err)
+	}
+	return toLearningActivityEntity(updated)
+}
+
+// DeleteActivity implements repository.CareerGoalRepository.
+func (c *careerGoalRepository) DeleteActivity(ctx context.Context, goalID, activityID int) error {
+	result := conn(ctx, c.db).Where("goal_id = ?", goalID).Delete(&model.LearningActivity{}, activityID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+func NewCareerGoalRepository(db *gorm.DB) repository.CareerGoalRepository {
+	return &careerGoalRepository{
+		db: db,
+	}
+}
+
+func preloadActivities(db *gorm.DB) *gorm.DB {
+	return db.Preload("Activities", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id")
+	})
+}
+
+func toCareerGoalModel(goal entity.CareerGoal) model.CareerGoal {
+	m := model.CareerGoal{
+		ID:          goal.ID,
+		UserID:      goal.UserID,
+		Kind:        string(goal.Kind),
+		Title:       goal.Title,
+		TargetMonth: goal.TargetMonth,
+		Status:      string(goal.Status),
+		Note:        goal.Note,
+	}
+	if goal.Skill != nil {
+		category, name := string(goal.Skill.Category), goal.Skill.Name
+		m.SkillCategory, m.SkillName = &category, &name
+	}
+	if goal.TargetLevel != 0 {
+		level := int(goal.TargetLevel)
+		m.TargetLevel = &level
+	}
+	return m
+}
+
+// toCareerGoalEntity は GORM のモデルを目標のエンティティに変換します
+func toCareerGoalEntity(m model.CareerGoal) (entity.CareerGoal, error) {
+	kind, err := entity.ParseCareerGoalKind(m.Kind)
+	if err != nil {
+		return entity.CareerGoal{}, fmt.Errorf("career goal %d: %w", m.ID, err)
+	}
+	status, err := entity.ParseCareerGoalStatus(m.Status)
+	if err != nil {
+		return entity.CareerGoal{}, fmt.Errorf("career goal %d: %w", m.ID, err)
+	}
+	goal := entity.CareerGoal{
+		ID:          m.ID,
+		UserID:      m.UserID,
+		Kind:        kind,
+		Title:       m.Title,
+		TargetMonth: m.TargetMonth,
+		Status:      status,
+		Note:        m.Note,
+		Activities:  make([]entity.LearningActivity, len(m.Activities)),
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+	if m.SkillCategory != nil && m.SkillName != nil {
+		skill, err := entity.NewSkill(*m.SkillCategory, *m.SkillName)
+		if err != nil {
+			return entity.CareerGoal{}, fmt.Errorf("career goal %d: %w", m.ID, err)
+		}
+		goal.Skill = &skill
+	}
+	if m.TargetLevel != nil {
+		level, err := entity.NewProficiencyLevel(*m.TargetLevel)
+		if err != nil {
+			return entity.CareerGoal{}, fmt.Errorf("career goal %d: %w", m.ID, err)
+		}
+		goal.TargetLevel = level
+	}
+	for i, activity := range m.Activities {
+		if goal.Activities[i], err = toLearningActivityEntity(activity); err != nil {
+			return entity.CareerGoal{}, err
+		}
+	}
+	return goal, nil
+}
+
+func toLearningActivityModel(activity entity.LearningActivity) model.LearningActivity {
+	return model.LearningActivity{
+		ID:       activity.ID,
+		GoalID:   activity.GoalID,
+		Kind:     string(activity.Kind),
+		Title:    activity.Title,
+		URL:      activity.URL,
+		Status:   string(activity.Status),
+		Progress: activity.Progress,
+		DueMonth: activity.DueMonth,
+	}
+}
+
+// toLearningActivityEntity は GORM のモデルを学習活動のエンティティに変換します
+func toLearningActivityEntity(m model.LearningActivity) (entity.LearningActivity, error) {
+	kind, err := entity.ParseLearningActivityKind(m.Kind)
+	if err != nil {
+		return entity.LearningActivity{}, fmt.Errorf("learning activity %d: %w", m.ID, err)
+	}
+	status, err := entity.ParseLearningActivityStatus(m.Status)
+	if err != nil {
+		return entity.LearningActivity{}, fmt.Errorf("learning activity %d: %w", m.ID, err)
+	}
+	return entity.LearningActivity{
+		ID:        m.ID,
+		GoalID:    m.GoalID,
+		Kind:      kind,
+		Title:     m.Title,
+		URL:       m.URL,
+		Status:    status,
+		Progress:  m.Progress,
+		DueMonth:  m.DueMonth,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}, nil
+}
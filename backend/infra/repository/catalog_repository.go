@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	entity "stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"stackies/backend/infra/repository/model"
+
+	"gorm.io/gorm"
+)
+
+type catalogRepository struct {
+	db *gorm.DB
+}
+
+// GetAll implements repository.CatalogRepository.
+// テナント独自の項目、全テナント共通の項目の順に、それぞれ言語・ツールの順・登録順で返します
+func (c *catalogRepository) GetAll(ctx context.Context) ([]entity.CatalogItem, error) {
+	tenant, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return nil, repository.ErrTenantRequired
+	}
+	db := conn(ctx, c.db)
+
+	// テナントのプラグインが全テナント共通の項目（tenant_id が NULL）も合わせて読み取る
+	var languages []model.Language
+	if err := db.Order("id").Find(&languages).Error; err != nil {
+		return nil, err
+	}
+	var tools []model.Tool
+	if err := db.Order("id").Find(&tools).Error; err != nil {
+		return nil, err
+	}
+
+	var tenantItems, globalItems []entity.CatalogItem
+	add := func(category entity.SkillCategory, id int, tenantID *string, name string, aliases model.JSON) error {
+		item, err := toCatalogItem(category, id, tenantID, name, aliases)
+		if err != nil {
+			return err
+		}
+		if item.Global {
+			globalItems = append(globalItems, item)
+		} else {
+			tenantItems = append(tenantItems, item)
+		}
+		return nil
+	}
+	for _, l := range languages {
+		if err := add(entity.SkillCategoryLanguage, l.ID, l.TenantID, l.Name, l.Aliases); err != nil {
+			return nil, err
+		}
+	}
+	for _, t := range tools {
+		if err := add(entity.SkillCategoryTool, t.ID, t.TenantID, t.Name, t.Aliases); err != nil {
+			return nil, err
+		}
+	}
+	if !tenant.InheritGlobalCatalog {
+		globalItems = nil
+	}
+	return entity.MergeCatalogItems(tenantItems, globalItems), nil
+}
+
+// Create implements repository.CatalogRepository.
+func (c *catalogRepository) Create(ctx context.Context, item entity.CatalogItem) (entity.CatalogItem, error) {
+	aliases := item.Aliases
+	if aliases == nil {
+		aliases = []string{}
+	}
+	aliasesJSON, err := json.Marshal(aliases)
+	if err != nil {
+		return entity.CatalogItem{}, fmt.Errorf("failed to marshal aliases: %w", err)
+	}
+
+	db := conn(ctx, c.db)
+	switch item.Category {
+	case entity.SkillCategoryLanguage:
+		m := model.Language{Name: item.Name, Aliases: model.JSON(aliasesJSON)}
+		if err := db.Create(&m).Error; err != nil {
+			return entity.CatalogItem{}, err
+		}
+		return toCatalogItem(item.Category, m.ID, m.TenantID, m.Name, m.Aliases)
+	case entity.SkillCategoryTool:
+		m := model.Tool{Name: item.Name, Aliases: model.JSON(aliasesJSON)}
+		if err := db.Create(&m).Error; err != nil {
+			return entity.CatalogItem{}, err
+		}
+		return toCatalogItem(item.Category, m.ID, m.TenantID, m.Name, m.Aliases)
+	default:
+		return entity.CatalogItem{}, fmt.Errorf("unknown skill category %q", item.Category)
+	}
+}
+
+func NewCatalogRepository(db *gorm.DB) repository.CatalogRepository {
+	return &catalogRepository{
+		db: db,
+	}
+}
+
+func toCatalogItem(category entity.SkillCategory, id int, tenantID *string, name string, aliasesJSON model.JSON) (entity.CatalogItem, error) {
+	var aliases []string
+	if len(aliasesJSON) > 0 {
+		if err := json.Unmarshal(aliasesJSON, &aliases); err != nil {
+			return entity.CatalogItem{}, fmt.Errorf("%s %d: failed to unmarshal aliases: %w", category, id, err)
+		}
+	}
+	return entity.CatalogItem{ID: id, Category: category, Name: name, Aliases: aliases, Global: tenantID == nil}, nil
+}
@@ -1,8 +1,14 @@
 package repository
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	entity "stackies/backend/domain/model"
 	"stackies/backend/domain/repository"
 	"stackies/backend/infra/repository/model"
+	"strings"
 
 	"gorm.io/gorm"
 )
@@ -12,24 +18,291 @@ type experienceRepository struct {
 }
 
 // GetAll implements repository.ExperienceRepository.
-func (e *experienceRepository) GetAll() ([]model.Experience, error) {
+func (e *experienceRepository) GetAll(ctx context.Context) ([]entity.Experience, error) {
 	var experiences []model.Experience
-	if err := e.db.Find(&experiences).Error; err != nil {
+	if err := withSkills(conn(ctx, e.db)).Find(&experiences).Error; err != nil {
 		return nil, err
 	}
-	return experiences, nil
+	return toExperienceEntities(experiences)
+}
+
+// FindByID implements repository.ExperienceRepository.
+func (e *experienceRepository) FindByID(ctx context.Context, id int) (entity.Experience, error) {
+	var experience model.Experience
+	if err := withSkills(conn(ctx, e.db)).First(&experience, id).Error; err != nil {
+		return entity.Experience{}, convertError(err)
+	}
+	return toExperienceEntity(experience)
+}
+
+// FindByUserID implements repository.ExperienceRepository.
+func (e *experienceRepository) FindByUserID(ctx context.Context, userID string) ([]entity.Experience, error) {
+	var experiences []model.Experience
+	if err := withSkills(conn(ctx, e.db)).Where("user_id = ?", userID).Order("id").Find(&experiences).Error; err != nil {
+		return nil, err
+	}
+	return toExperienceEntities(experiences)
+}
+
+// FindByUserIDs implements repository.ExperienceRepository.
+func (e *experienceRepository) FindByUserIDs(ctx context.Context, userIDs []string) ([]entity.Experience, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	var experiences []model.Experience
+	if err := withSkills(conn(ctx, e.db)).Where("user_id IN ?", userIDs).Order("user_id, id").Find(&experiences).Error; err != nil {
+		return nil, err
+	}
+	return toExperienceEntities(experiences)
+}
+
+// FindByUserSkills implements repository.ExperienceRepository.
+// テナントの条件は Model の experience_skills にだけ付くため、結合する experiences はテナントが同じことを条件にします
+func (e *experienceRepository) FindByUserSkills(ctx context.Context, skills []entity.Skill) ([]entity.Experience, error) {
+	if len(skills) == 0 {
+		return nil, nil
+	}
+	keys := make([][]interface{}, len(skills))
+	for i, skill := range skills {
+		keys[i] = []interface{}{string(skill.Category), strings.ToLower(skill.Name)}
+	}
+
+	db := conn(ctx, e.db)
+	holders := db.Model(&model.ExperienceSkill{}).
+		Select("experiences.user_id").
+		Joins("JOIN experiences ON experiences.id = experience_skills.experience_id AND experiences.tenant_id = experience_skills.tenant_id AND experiences.deleted_at IS NULL").
+		Where("(experience_skills.category, lower(experience_skills.name)) IN ?", keys)
+	var experiences []model.Experience
+	if err := withSkills(db).Where("user_id IN (?)", holders).Order("user_id, id").Find(&experiences).Error; err != nil {
+		return nil, err
+	}
+	return toExperienceEntities(experiences)
 }
 
 // Create implements repository.ExperienceRepository.
-func (e *experienceRepository) Create(experience model.Experience) error {
-	if err := e.db.Create(&experience).Error; err != nil {
-		return err
+// スキルも合わせて登録します
+func (e *experienceRepository) Create(ctx context.Context, experience entity.Experience) (entity.Experience, error) {
+	m, err := toExperienceModel(experience)
+	if err != nil {
+		return entity.Experience{}, err
+	}
+	if err := conn(ctx, e.db).Create(&m).Error; err != nil {
+		return entity.Experience{}, err
+	}
+	return toExperienceEntity(m)
+}
+
+// Update implements repository.ExperienceRepository.
+// スキルは全て削除してから登録し直します
+func (e *experienceRepository) Update(ctx context.Context, experience entity.Experience) (entity.Experience, error) {
+	m, err := toExperienceModel(experience)
+	if err != nil {
+		return entity.Experience{}, err
+	}
+	err = conn(ctx, e.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Experience{}).
+			Where("id = ? AND version = ?", experience.ID, experience.Version).
+			Updates(map[string]interface{}{
+				"title":        m.Title,
+				"client_name":  m.ClientName,
+				"client_alias": m.ClientAlias,
+				"industry":     m.Industry,
+				"start_month":  m.StartMonth,
+				"end_month":    m.EndMonth,
+				"team_size":    m.TeamSize,
+				"phases":       m.Phases,
+				"version":      gorm.Expr("version + 1"),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return missOrConflict(tx, experience.ID)
+		}
+		if err := tx.Where("experience_id = ?", experience.ID).Delete(&model.ExperienceSkill{}).Error; err != nil {
+			return err
+		}
+		if len(m.Skills) > 0 {
+			return tx.Create(&m.Skills).Error
+		}
+		return nil
+	})
+	if err != nil {
+		return entity.Experience{}, err
+	}
+	return e.FindByID(ctx, experience.ID)
+}
+
+// Delete implements repository.ExperienceRepository.
+func (e *experienceRepository) Delete(ctx context.Context, id int, version int) error {
+	result := conn(ctx, e.db).Where("version = ?", version).Delete(&model.Experience{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return missOrConflict(conn(ctx, e.db), id)
 	}
 	return nil
 }
 
+// missOrConflict は更新件数が0件だった理由が、レコードが存在しないためか
+// バージョンが一致しないためかを判定してエラーを返します
+// トランザクション内で呼び出す場合は、同じトランザクションで読み取るよう tx を渡します
+func missOrConflict(db *gorm.DB, id int) error {
+	var experience model.Experience
+	if err := db.Select("id").First(&experience, id).Error; err != nil {
+		return convertError(err)
+	}
+	return repository.ErrConflict
+}
+
+// Restore implements repository.ExperienceRepository.
+func (e *experienceRepository) Restore(ctx context.Context, id int, userID string) (entity.Experience, error) {
+	result := conn(ctx, e.db).Unscoped().Model(&model.Experience{}).
+		Where("id = ? AND user_id = ? AND deleted_at IS NOT NULL", id, userID).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		return entity.Experience{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.Experience{}, repository.ErrNotFound
+	}
+	return e.FindByID(ctx, id)
+}
+
 func NewExperienceRepository(db *gorm.DB) repository.ExperienceRepository {
 	return &experienceRepository{
 		db: db,
 	}
 }
+
+// withSkills はスキルを登録順に読み込みます
+func withSkills(db *gorm.DB) *gorm.DB {
+	return db.Preload("Skills", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position")
+	})
+}
+
+// convertError は GORM のエラーをリポジトリのエラーに変換します
+func convertError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return repository.ErrNotFound
+	}
+	return err
+}
+
+// toExperienceModel は業務経歴のエンティティを GORM のモデルに変換します
+func toExperienceModel(experience entity.Experience) (model.Experience, error) {
+	phases := experience.Phases
+	if phases == nil {
+		phases = []entity.Phase{}
+	}
+	phasesJSON, err := json.Marshal(phases)
+	if err != nil {
+		return model.Experience{}, fmt.Errorf("failed to marshal phases: %w", err)
+	}
+	m := model.Experience{
+		ID:          experience.ID,
+		UserID:      experience.UserID,
+		Title:       experience.Title.String(),
+		ClientName:  experience.Client.Name(),
+		ClientAlias: experience.Client.Alias(),
+		Industry:    experience.Industry.String(),
+		Phases:      model.JSON(phasesJSON),
+		Version:     experience.Version,
+		CreatedAt:   experience.CreatedAt,
+		UpdatedAt:   experience.UpdatedAt,
+	}
+	if !experience.Period.IsZero() {
+		start := experience.Period.Start()
+		m.StartMonth = &start
+		if end, ok := experience.Period.End(); ok {
+			m.EndMonth = &end
+		}
+	}
+	if !experience.TeamSize.IsZero() {
+		teamSize := experience.TeamSize.Int()
+		m.TeamSize = &teamSize
+	}
+	for i, skill := range experience.Skills {
+		m.Skills = append(m.Skills, model.ExperienceSkill{
+			ExperienceID: experience.ID,
+			Position:     i,
+			Category:     string(skill.Category),
+			Name:         skill.Name,
+		})
+	}
+	return m, nil
+}
+
+func toExperienceEntities(experiences []model.Experience) ([]entity.Experience, error) {
+	entities := make([]entity.Experience, len(experiences))
+	for i, experience := range experiences {
+		var err error
+		if entities[i], err = toExperienceEntity(experience); err != nil {
+			return nil, err
+		}
+	}
+	return entities, nil
+}
+
+// toExperienceEntity は GORM のモデルを業務経歴のエンティティに変換します
+// 保存済みの値が値オブジェクトの制約を満たさない場合はエラーを返します
+func toExperienceEntity(m model.Experience) (entity.Experience, error) {
+	title, err := entity.NewExperienceTitle(m.Title)
+	if err != nil {
+		return entity.Experience{}, fmt.Errorf("experience %d: %w", m.ID, err)
+	}
+	client, err := entity.NewClient(m.ClientName, m.ClientAlias)
+	if err != nil {
+		return entity.Experience{}, fmt.Errorf("experience %d: %w", m.ID, err)
+	}
+	industry, err := entity.NewIndustry(m.Industry)
+	if err != nil {
+		return entity.Experience{}, fmt.Errorf("experience %d: %w", m.ID, err)
+	}
+	var period entity.Period
+	if m.StartMonth != nil {
+		if period, err = entity.NewPeriod(*m.StartMonth, m.EndMonth); err != nil {
+			return entity.Experience{}, fmt.Errorf("experience %d: %w", m.ID, err)
+		}
+	}
+	var teamSize entity.TeamSize
+	if m.TeamSize != nil {
+		if teamSize, err = entity.NewTeamSize(*m.TeamSize); err != nil {
+			return entity.Experience{}, fmt.Errorf("experience %d: %w", m.ID, err)
+		}
+	}
+	var skills []entity.Skill
+	for _, s := range m.Skills {
+		skill, err := entity.NewSkill(s.Category, s.Name)
+		if err != nil {
+			return entity.Experience{}, fmt.Errorf("experience %d: %w", m.ID, err)
+		}
+		skills = append(skills, skill)
+	}
+	var phases []entity.Phase
+	if len(m.Phases) > 0 {
+		var values []string
+		if err := json.Unmarshal(m.Phases, &values); err != nil {
+			return entity.Experience{}, fmt.Errorf("experience %d: failed to unmarshal phases: %w", m.ID, err)
+		}
+		if phases, err = entity.NewPhases(values); err != nil {
+			return entity.Experience{}, fmt.Errorf("experience %d: %w", m.ID, err)
+		}
+	}
+	return entity.Experience{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		Title:     title,
+		Client:    client,
+		Industry:  industry,
+		Period:    period,
+		TeamSize:  teamSize,
+		Skills:    skills,
+		Phases:    phases,
+		Version:   m.Version,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}, nil
+}
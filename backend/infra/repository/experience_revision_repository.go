@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	entity "stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"stackies/backend/infra/repository/model"
+
+	"gorm.io/gorm"
+)
+
+type experienceRevisionRepository struct {
+	db *gorm.DB
+}
+
+// Create implements repository.ExperienceRevisionRepository.
+func (e *experienceRevisionRepository) Create(ctx context.Context, revision entity.ExperienceRevision) (entity.ExperienceRevision, error) {
+	db := conn(ctx, e.db)
+
+	var latest int
+	if err := db.Model(&model.ExperienceRevision{}).
+		Where("experience_id = ?", revision.ExperienceID).
+		Select("COALESCE(MAX(revision), 0)").
+		Scan(&latest).Error; err != nil {
+		return entity.ExperienceRevision{}, err
+	}
+
+	// 同時更新で番号が重複した場合は (experience_id, revision) の一意制約でエラーになる
+	m := toExperienceRevisionModel(revision)
+	m.Revision = latest + 1
+	if err := db.Create(&m).Error; err != nil {
+		return entity.ExperienceRevision{}, err
+	}
+	return toExperienceRevisionEntity(m), nil
+}
+
+// FindByExperienceID implements repository.ExperienceRevisionRepository.
+func (e *experienceRevisionRepository) FindByExperienceID(ctx context.Context, experienceID int) ([]entity.ExperienceRevision, error) {
+	var revisions []model.ExperienceRevision
+	if err := conn(ctx, e.db).
+		Where("experience_id = ?", experienceID).
+		Order("revision").
+		Find(&revisions).Error; err != nil {
+		return nil, err
+	}
+
+	entities := make([]entity.ExperienceRevision, len(revisions))
+	for i, revision := range revisions {
+		entities[i] = toExperienceRevisionEntity(revision)
+	}
+	return entities, nil
+}
+
+// FindByRevision implements repository.ExperienceRevisionRepository.
+func (e *experienceRevisionRepository) FindByRevision(ctx context.Context, experienceID int, revision int) (entity.ExperienceRevision, error) {
+	var experienceRevision model.ExperienceRevision
+	if err := conn(ctx, e.db).
+		Where("experience_id = ? AND revision = ?", experienceID, revision).
+		First(&experienceRevision).Error; err != nil {
+		return entity.ExperienceRevision{}, convertError(err)
+	}
+	return toExperienceRevisionEntity(experienceRevision), nil
+}
+
+func NewExperienceRevisionRepository(db *gorm.DB) repository.ExperienceRevisionRepository {
+	return &experienceRevisionRepository{
+		db: db,
+	}
+}
+
+func toExperienceRevisionModel(revision entity.ExperienceRevision) model.ExperienceRevision {
+	return model.ExperienceRevision{
+		ExperienceID: revision.ExperienceID,
+		Revision:     revision.Revision,
+		Snapshot:     model.JSON(revision.Snapshot),
+		ActorID:      revision.ActorID,
+		CreatedAt:    revision.CreatedAt,
+	}
+}
+
+func toExperienceRevisionEntity(m model.ExperienceRevision) entity.ExperienceRevision {
+	return entity.ExperienceRevision{
+		ExperienceID: m.ExperienceID,
+		Revision:     m.Revision,
+		Snapshot:     json.RawMessage(m.Snapshot),
+		ActorID:      m.ActorID,
+		CreatedAt:    m.CreatedAt,
+	}
+}
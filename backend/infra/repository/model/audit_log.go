@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+type AuditLog struct {
+	ID         int       `gorm:"primaryKey"`
+	TenantID   string    `gorm:"not null"`
+	ActorID    string    `gorm:"not null"`
+	RequestID  string    `gorm:"not null"`
+	Action     string    `gorm:"not null"`
+	EntityType string    `gorm:"not null"`
+	EntityID   string    `gorm:"not null"`
+	Before     JSON      `gorm:"type:jsonb"`
+	After      JSON      `gorm:"type:jsonb"`
+	CreatedAt  time.Time `gorm:"not null"`
+}
+
+func (a *AuditLog) TableName() string {
+	return "audit_logs"
+}
@@ -0,0 +1,46 @@
+package model
+
+import "time"
+
+// CareerGoal はキャリアの目標です（SkillCategory・SkillName・TargetLevel は種類が skill の場合だけ設定します）
+type CareerGoal struct {
+	ID            int    `gorm:"primaryKey"`
+	TenantID      string `gorm:"not null"`
+	UserID        string `gorm:"not null"`
+	Kind          string `gorm:"not null"`
+	Title         string `gorm:"not null"`
+	SkillCategory *string
+	SkillName     *string
+	TargetLevel   *int
+	// TargetMonth は月初の日付です
+	TargetMonth time.Time `gorm:"type:date;not null"`
+	Status      string    `gorm:"not null"`
+	Note        string    `gorm:"not null"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Activities  []LearningActivity `gorm:"foreignKey:GoalID"`
+}
+
+func (c *CareerGoal) TableName() string {
+	return "career_goals"
+}
+
+// LearningActivity は目標のための学習活動です
+type LearningActivity struct {
+	ID       int    `gorm:"primaryKey"`
+	TenantID string `gorm:"not null"`
+	GoalID   int    `gorm:"not null"`
+	Kind     string `gorm:"not null"`
+	Title    string `gorm:"not null"`
+	URL      string `gorm:"column:url;not null"`
+	Status   string `gorm:"not null"`
+	Progress int    `gorm:"not null"`
+	// DueMonth は月初の日付です（NULL は未設定）
+	DueMonth  *time.Time `gorm:"type:date"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (l *LearningActivity) TableName() string {
+	return "learning_activities"
+}
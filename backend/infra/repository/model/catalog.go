@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+// Language はカタログの言語です
+type Language struct {
+	ID       int     `gorm:"primaryKey"`
+	TenantID *string // 全テナント共通の項目は NULL
+	Name     string  `gorm:"not null"`
+	IconURL  string  `gorm:"column:icon_url;not null"`
+	// Aliases は表記ゆれの文字列の配列の JSON です
+	Aliases   JSON `gorm:"type:jsonb;not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (l *Language) TableName() string {
+	return "languages"
+}
+
+// Tool はカタログのツール（フレームワーク・ミドルウェア・クラウドなど）です
+type Tool struct {
+	ID       int     `gorm:"primaryKey"`
+	TenantID *string // 全テナント共通の項目は NULL
+	Name     string  `gorm:"not null"`
+	IconURL  string  `gorm:"column:icon_url;not null"`
+	// Aliases は表記ゆれの文字列の配列の JSON です
+	Aliases   JSON `gorm:"type:jsonb;not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (t *Tool) TableName() string {
+	return "tools"
+}
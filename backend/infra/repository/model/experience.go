@@ -1,10 +1,47 @@
 package model
 
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
 type Experience struct {
-	ID    int    `gorm:"primaryKey"`
-	Title string `gorm:"not null unique"`
+	ID       int    `gorm:"primaryKey"`
+	TenantID string `gorm:"not null"`
+	UserID   string `gorm:"not null"`
+	Title    string `gorm:"not null"`
+	// ClientName は社外秘の顧客名、ClientAlias は公開用の名前です（未設定は空文字）
+	ClientName  string `gorm:"not null"`
+	ClientAlias string `gorm:"not null"`
+	Industry    string `gorm:"not null"` // 顧客の業種です（未設定は空文字）
+	Version     int    `gorm:"not null;default:1"`
+	// StartMonth / EndMonth は月初の日付です（EndMonth が NULL の場合は参画中）
+	StartMonth *time.Time `gorm:"type:date"`
+	EndMonth   *time.Time `gorm:"type:date"`
+	TeamSize   *int
+	// Phases は担当した工程の文字列の配列の JSON です
+	Phases    JSON `gorm:"type:jsonb;not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+	Skills    []ExperienceSkill
 }
 
 func (e *Experience) TableName() string {
 	return "experiences"
 }
+
+// ExperienceSkill は業務経歴で使用したスキルです（Position は登録順）
+type ExperienceSkill struct {
+	ID           int    `gorm:"primaryKey"`
+	TenantID     string `gorm:"not null"`
+	ExperienceID int    `gorm:"not null"`
+	Position     int    `gorm:"not null"`
+	Category     string `gorm:"not null"`
+	Name         string `gorm:"not null"`
+}
+
+func (e *ExperienceSkill) TableName() string {
+	return "experience_skills"
+}
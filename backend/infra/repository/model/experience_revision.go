@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// ExperienceRevision は業務経歴の各リビジョンのスナップショットです
+type ExperienceRevision struct {
+	ID           int       `gorm:"primaryKey"`
+	TenantID     string    `gorm:"not null"`
+	ExperienceID int       `gorm:"not null"`
+	Revision     int       `gorm:"not null"`
+	Snapshot     JSON      `gorm:"type:jsonb;not null"`
+	ActorID      string    `gorm:"not null"`
+	CreatedAt    time.Time `gorm:"not null"`
+}
+
+func (e *ExperienceRevision) TableName() string {
+	return "experience_revisions"
+}
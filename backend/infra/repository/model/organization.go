@@ -0,0 +1,54 @@
+package model
+
+import "time"
+
+type Organization struct {
+	ID        int    `gorm:"primaryKey"`
+	TenantID  string `gorm:"not null"`
+	Name      string `gorm:"not null"`
+	CreatedAt time.Time
+}
+
+func (o *Organization) TableName() string {
+	return "organizations"
+}
+
+type Team struct {
+	ID             int    `gorm:"primaryKey"`
+	TenantID       string `gorm:"not null"`
+	OrganizationID int    `gorm:"not null"`
+	Name           string `gorm:"not null"`
+	CreatedAt      time.Time
+}
+
+func (t *Team) TableName() string {
+	return "teams"
+}
+
+// TeamMember はチームへの所属です（チームとユーザーの組で一意）
+type TeamMember struct {
+	TeamID    int    `gorm:"primaryKey;autoIncrement:false"`
+	TenantID  string `gorm:"not null"`
+	UserID    string `gorm:"primaryKey"`
+	Role      string `gorm:"not null"`
+	CreatedAt time.Time
+}
+
+func (t *TeamMember) TableName() string {
+	return "team_members"
+}
+
+// TeamMemberHistory はチームに同じ役割で所属していた期間です（EndedAt が nil は現在の役割）
+type TeamMemberHistory struct {
+	ID        int    `gorm:"primaryKey"`
+	TenantID  string `gorm:"not null"`
+	TeamID    int    `gorm:"not null"`
+	UserID    string `gorm:"not null"`
+	Role      string `gorm:"not null"`
+	StartedAt time.Time
+	EndedAt   *time.Time
+}
+
+func (t *TeamMemberHistory) TableName() string {
+	return "team_member_histories"
+}
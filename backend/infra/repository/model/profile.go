@@ -0,0 +1,55 @@
+package model
+
+import "time"
+
+type Profile struct {
+	// プロフィールはテナントとユーザーの組で一意です
+	TenantID       string `gorm:"primaryKey"`
+	UserID         string `gorm:"primaryKey"`
+	DisplayName    string `gorm:"not null"`
+	Initials       string `gorm:"not null"`
+	Headline       string `gorm:"not null"`
+	SelfPR         string `gorm:"column:self_pr;not null"`
+	NearestStation string `gorm:"not null"`
+	WorkStyle      string `gorm:"not null"`
+	// PreferredLocations は文字列の配列の JSON です
+	PreferredLocations JSON `gorm:"type:jsonb;not null"`
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+func (p *Profile) TableName() string {
+	return "profiles"
+}
+
+// ProfileCertification は資格です（Position は表示順）
+type ProfileCertification struct {
+	ID            int        `gorm:"primaryKey"`
+	TenantID      string     `gorm:"not null"`
+	UserID        string     `gorm:"not null"`
+	Position      int        `gorm:"not null"`
+	Name          string     `gorm:"not null"`
+	Issuer        string     `gorm:"not null"`
+	AcquiredMonth *time.Time `gorm:"type:date"`
+}
+
+func (p *ProfileCertification) TableName() string {
+	return "profile_certifications"
+}
+
+// ProfileEducation は学歴です（Position は表示順）
+type ProfileEducation struct {
+	ID         int        `gorm:"primaryKey"`
+	TenantID   string     `gorm:"not null"`
+	UserID     string     `gorm:"not null"`
+	Position   int        `gorm:"not null"`
+	SchoolName string     `gorm:"not null"`
+	Faculty    string     `gorm:"not null"`
+	Degree     string     `gorm:"not null"`
+	StartMonth *time.Time `gorm:"type:date"`
+	EndMonth   *time.Time `gorm:"type:date"`
+}
+
+func (p *ProfileEducation) TableName() string {
+	return "profile_educations"
+}
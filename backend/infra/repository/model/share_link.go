@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+type ShareLink struct {
+	ID           int    `gorm:"primaryKey"`
+	TenantID     string `gorm:"not null"`
+	UserID       string `gorm:"not null"`
+	Label        string `gorm:"not null"`
+	TokenHash    string `gorm:"not null"`
+	PasswordHash string `gorm:"not null"`
+	// Sections は公開する項目の文字列の配列の JSON です
+	Sections        JSON      `gorm:"type:jsonb;not null"`
+	HideClientNames bool      `gorm:"not null"`
+	HidePeriod      bool      `gorm:"not null"`
+	Anonymize       bool      `gorm:"not null"`
+	ExpiresAt       time.Time `gorm:"not null"`
+	RevokedAt       *time.Time
+	ViewCount       int `gorm:"not null"`
+	LastViewedAt    *time.Time
+	CreatedAt       time.Time
+}
+
+func (s *ShareLink) TableName() string {
+	return "share_links"
+}
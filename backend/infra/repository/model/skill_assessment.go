@@ -0,0 +1,39 @@
+package model
+
+import "time"
+
+// SkillAssessment はスキルの自己評価です（NameKey は大文字・小文字を区別せずに一意にするための小文字の名前）
+type SkillAssessment struct {
+	ID        int    `gorm:"primaryKey"`
+	TenantID  string `gorm:"not null"`
+	UserID    string `gorm:"not null"`
+	Category  string `gorm:"not null"`
+	Name      string `gorm:"not null"`
+	NameKey   string `gorm:"not null"`
+	Level     int    `gorm:"not null"`
+	Comment   string `gorm:"not null"`
+	UpdatedAt time.Time
+}
+
+func (s *SkillAssessment) TableName() string {
+	return "skill_assessments"
+}
+
+// SkillEndorsement はスキルの推薦です
+type SkillEndorsement struct {
+	ID         int    `gorm:"primaryKey"`
+	TenantID   string `gorm:"not null"`
+	UserID     string `gorm:"not null"`
+	EndorserID string `gorm:"not null"`
+	Category   string `gorm:"not null"`
+	Name       string `gorm:"not null"`
+	NameKey    string `gorm:"not null"`
+	Level      int    `gorm:"not null"`
+	Comment    string `gorm:"not null"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (s *SkillEndorsement) TableName() string {
+	return "skill_endorsements"
+}
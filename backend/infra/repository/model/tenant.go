@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// Tenant はテナントです。テナントごとのデータではないため tenant_id を持ちません
+type Tenant struct {
+	ID                   string `gorm:"primaryKey"`
+	Name                 string `gorm:"not null"`
+	InheritGlobalCatalog bool   `gorm:"not null"`
+	CreatedAt            time.Time
+}
+
+func (t *Tenant) TableName() string {
+	return "tenants"
+}
@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	entity "stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"stackies/backend/infra/repository/model"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type organizationRepository struct {
+	db *gorm.DB
+}
+
+// FindByID implements repository.OrganizationRepository.
+func (o *organizationRepository) FindByID(ctx context.Context, id int) (entity.Organization, error) {
+	var organization model.Organization
+	if err := conn(ctx, o.db).First(&organization, id).Error; err != nil {
+		return entity.Organization{}, convertError(err)
+	}
+	return toOrganizationEntity(organization), nil
+}
+
+// Create implements repository.OrganizationRepository.
+func (o *organizationRepository) Create(ctx context.Context, organization entity.Organization) (entity.Organization, error) {
+	m := model.Organization{Name: organization.Name}
+	if err := conn(ctx, o.db).Create(&m).Error; err != nil {
+		return entity.Organization{}, err
+	}
+	return toOrganizationEntity(m), nil
+}
+
+// FindTeamByID implements repository.OrganizationRepository.
+func (o *organizationRepository) FindTeamByID(ctx context.Context, id int) (entity.Team, error) {
+	var team model.Team
+	if err := conn(ctx, o.db).First(&team, id).Error; err != nil {
+		return entity.Team{}, convertError(err)
+	}
+	return toTeamEntity(team), nil
+}
+
+// FindTeamsByOrganizationID implements repository.OrganizationRepository.
+func (o *organizationRepository) FindTeamsByOrganizationID(ctx context.Context, organizationID int) ([]entity.Team, error) {
+	var teams []model.Team
+	if err := conn(ctx, o.db).Where("organization_id = ?", organizationID).Order("id").Find(&teams).Error; err != nil {
+		return nil, err
+	}
+	entities := make([]entity.Team, len(teams))
+	for i, team := range teams {
+		entities[i] = toTeamEntity(team)
+	}
+	return entities, nil
+}
+
+// CreateTeam implements repository.OrganizationRepository.
+func (o *organizationRepository) CreateTeam(ctx context.Context, team entity.Team) (entity.Team, error) {
+	m := model.Team{OrganizationID: team.OrganizationID, Name: team.Name}
+	if err := conn(ctx, o.db).Create(&m).Error; err != nil {
+		return entity.Team{}, err
+	}
+	return toTeamEntity(m), nil
+}
+
+// FindMembersByTeamID implements repository.OrganizationRepository.
+func (o *organizationRepository) FindMembersByTeamID(ctx context.Context, teamID int) ([]entity.TeamMember, error) {
+	var members []model.TeamMember
+	if err := conn(ctx, o.db).Where("team_id = ?", teamID).Order("created_at, user_id").Find(&members).Error; err != nil {
+		return nil, err
+	}
+	return toTeamMemberEntities(members), nil
+}
+
+// FindMembersByOrganizationID implements repository.OrganizationRepository.
+func (o *organizationRepository) FindMembersByOrganizationID(ctx context.Context, organizationID int) ([]entity.TeamMember, error) {
+	var members []model.TeamMember
+	err := conn(ctx, o.db).
+		Joins("JOIN teams ON teams.id = team_members.team_id").
+		Where("teams.organization_id = ?", organizationID).
+		Order("team_members.team_id, team_members.created_at, team_members.user_id").
+		Find(&members).Error
+	if err != nil {
+		return nil, err
+	}
+	return toTeamMemberEntities(members), nil
+}
+
+// FindMembershipsByUserID implements repository.OrganizationRepository.
+func (o *organizationRepository) FindMembershipsByUserID(ctx context.Context, userID string) ([]entity.TeamMember, error) {
+	var members []model.TeamMember
+	if err := conn(ctx, o.db).Where("user_id = ?", userID).Order("team_id").Find(&members).Error; err != nil {
+		return nil, err
+	}
+	return toTeamMemberEntities(members), nil
+}
+
+// FindMembershipPeriodsByUserID implements repository.OrganizationRepository.
+func (o *organizationRepository) FindMembershipPeriodsByUserID(ctx context.Context, userID string) ([]entity.TeamMembershipPeriod, error) {
+	var histories []model.TeamMemberHistory
+	if err := conn(ctx, o.db).Where("user_id = ?", userID).Order("started_at, id").Find(&histories).Error; err != nil {
+		return nil, err
+	}
+	entities := make([]entity.TeamMembershipPeriod, len(histories))
+	for i, h := range histories {
+		entities[i] = entity.TeamMembershipPeriod{
+			TeamID:    h.TeamID,
+			UserID:    h.UserID,
+			Role:      entity.TeamRole(h.Role),
+			StartedAt: h.StartedAt,
+			EndedAt:   h.EndedAt,
+		}
+	}
+	return entities, nil
+}
+
+// SaveMember implements repository.OrganizationRepository.
+func (o *organizationRepository) SaveMember(ctx context.Context, member entity.TeamMember) (entity.TeamMember, error) {
+	m := model.TeamMember{TeamID: member.TeamID, UserID: member.UserID, Role: string(member.Role)}
+	var saved model.TeamMember
+	err := conn(ctx, o.db).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "team_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"role"}),
+		}).Create(&m).Error
+		if err != nil {
+			return err
+		}
+		// 所属済みの場合は所属した日時を返すため読み直す
+		if err := tx.Where("team_id = ? AND user_id = ?", member.TeamID, member.UserID).First(&saved).Error; err != nil {
+			return convertError(err)
+		}
+		return startMemberHistory(tx, saved, time.Now())
+	})
+	if err != nil {
+		return entity.TeamMember{}, err
+	}
+	return toTeamMemberEntity(saved), nil
+}
+
+// DeleteMember implements repository.OrganizationRepository.
+func (o *organizationRepository) DeleteMember(ctx context.Context, teamID int, userID string) error {
+	return conn(ctx, o.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("team_id = ? AND user_id = ?", teamID, userID).Delete(&model.TeamMember{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return repository.ErrNotFound
+		}
+		return endMemberHistory(tx, teamID, userID, time.Now())
+	})
+}
+
+// startMemberHistory は所属期間の履歴を現在の役割で始めます
+// 同じ役割で所属中の場合は何もせず、役割が変わった場合は以前の役割の期間を終了します
+func startMemberHistory(tx *gorm.DB, member model.TeamMember, now time.Time) error {
+	var current model.TeamMemberHistory
+	err := tx.Where("team_id = ? AND user_id = ? AND ended_at IS NULL", member.TeamID, member.UserID).First(&current).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if err == nil {
+		if current.Role == member.Role {
+			return nil
+		}
+		if err := endMemberHistory(tx, member.TeamID, member.UserID, now); err != nil {
+			return err
+		}
+	}
+	return tx.Create(&model.TeamMemberHistory{
+		TeamID:    member.TeamID,
+		UserID:    member.UserID,
+		Role:      member.Role,
+		StartedAt: now,
+	}).Error
+}
+
+// endMemberHistory は所属中の期間を終了します
+func endMemberHistory(tx *gorm.DB, teamID int, userID string, now time.Time) error {
+	return tx.Model(&model.TeamMemberHistory{}).
+		Where("team_id = ? AND user_id = ? AND ended_at IS NULL", teamID, userID).
+		Update("ended_at", now).Error
+}
+
+func NewOrganizationRepository(db *gorm.DB) repository.OrganizationRepository {
+	return &organizationRepository{
+		db: db,
+	}
+}
+
+func toOrganizationEntity(m model.Organization) entity.Organization {
+	return entity.Organization{ID: m.ID, Name: m.Name, CreatedAt: m.CreatedAt}
+}
+
+func toTeamEntity(m model.Team) entity.Team {
+	return entity.Team{ID: m.ID, OrganizationID: m.OrganizationID, Name: m.Name, CreatedAt: m.CreatedAt}
+}
+
+func toTeamMemberEntity(m model.TeamMember) entity.TeamMember {
+	return entity.TeamMember{TeamID: m.TeamID, UserID: m.UserID, Role: entity.TeamRole(m.Role), CreatedAt: m.CreatedAt}
+}
+
+func toTeamMemberEntities(members []model.TeamMember) []entity.TeamMember {
+	entities := make([]entity.TeamMember, len(members))
+	for i, m := range members {
+		entities[i] = toTeamMemberEntity(m)
+	}
+	return entities
+}
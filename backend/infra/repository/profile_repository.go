@@ -0,0 +1,230 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	entity "stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"stackies/backend/infra/repository/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type profileRepository struct {
+	db *gorm.DB
+}
+
+// FindByUserID implements repository.ProfileRepository.
+func (p *profileRepository) FindByUserID(ctx context.Context, userID string) (entity.Profile, error) {
+	db := conn(ctx, p.db)
+
+	var profile model.Profile
+	if err := db.Where("user_id = ?", userID).First(&profile).Error; err != nil {
+		return entity.Profile{}, convertError(err)
+	}
+	var certifications []model.ProfileCertification
+	if err := db.Where("user_id = ?", userID).Order("position").Find(&certifications).Error; err != nil {
+		return entity.Profile{}, err
+	}
+	var educations []model.ProfileEducation
+	if err := db.Where("user_id = ?", userID).Order("position").Find(&educations).Error; err != nil {
+		return entity.Profile{}, err
+	}
+	return toProfileEntity(profile, certifications, educations)
+}
+
+// FindByUserIDs implements repository.ProfileRepository.
+// 資格・学歴もユーザーごとに振り分けて返します
+func (p *profileRepository) FindByUserIDs(ctx context.Context, userIDs []string) ([]entity.Profile, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	db := conn(ctx, p.db)
+
+	var profiles []model.Profile
+	if err := db.Where("user_id IN ?", userIDs).Order("user_id").Find(&profiles).Error; err != nil {
+		return nil, err
+	}
+	var certifications []model.ProfileCertification
+	if err := db.Where("user_id IN ?", userIDs).Order("user_id, position").Find(&certifications).Error; err != nil {
+		return nil, err
+	}
+	var educations []model.ProfileEducation
+	if err := db.Where("user_id IN ?", userIDs).Order("user_id, position").Find(&educations).Error; err != nil {
+		return nil, err
+	}
+	certificationsByUser := make(map[string][]model.ProfileCertification)
+	for _, c := range certifications {
+		certificationsByUser[c.UserID] = append(certificationsByUser[c.UserID], c)
+	}
+	educationsByUser := make(map[string][]model.ProfileEducation)
+	for _, e := range educations {
+		educationsByUser[e.UserID] = append(educationsByUser[e.UserID], e)
+	}
+
+	entities := make([]entity.Profile, len(profiles))
+	for i, profile := range profiles {
+		var err error
+		if entities[i], err = toProfileEntity(profile, certificationsByUser[profile.UserID], educationsByUser[profile.UserID]); err != nil {
+			return nil, err
+		}
+	}
+	return entities, nil
+}
+
+// Save implements repository.ProfileRepository.
+func (p *profileRepository) Save(ctx context.Context, profile entity.Profile) (entity.Profile, error) {
+	m, certifications, educations, err := toProfileModel(profile)
+	if err != nil {
+		return entity.Profile{}, err
+	}
+
+	// 資格・学歴は全て削除してから登録し直す
+	err = conn(ctx, p.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "tenant_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"display_name", "initials", "headline", "self_pr", "nearest_station", "work_style", "preferred_locations", "updated_at",
+			}),
+		}).Create(&m).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", profile.UserID).Delete(&model.ProfileCertification{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", profile.UserID).Delete(&model.ProfileEducation{}).Error; err != nil {
+			return err
+		}
+		if len(certifications) > 0 {
+			if err := tx.Create(&certifications).Error; err != nil {
+				return err
+			}
+		}
+		if len(educations) > 0 {
+			if err := tx.Create(&educations).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return entity.Profile{}, err
+	}
+	return p.FindByUserID(ctx, profile.UserID)
+}
+
+func NewProfileRepository(db *gorm.DB) repository.ProfileRepository {
+	return &profileRepository{
+		db: db,
+	}
+}
+
+// toProfileModel はプロフィールの集約を GORM のモデルに変換します
+func toProfileModel(profile entity.Profile) (model.Profile, []model.ProfileCertification, []model.ProfileEducation, error) {
+	locations := profile.PreferredLocations
+	if locations == nil {
+		locations = []string{}
+	}
+	preferredLocations, err := json.Marshal(locations)
+	if err != nil {
+		return model.Profile{}, nil, nil, fmt.Errorf("failed to marshal preferred locations: %w", err)
+	}
+
+	m := model.Profile{
+		UserID:             profile.UserID,
+		DisplayName:        profile.DisplayName,
+		Initials:           profile.Initials,
+		Headline:           profile.Headline,
+		SelfPR:             profile.SelfPR,
+		NearestStation:     profile.NearestStation,
+		WorkStyle:          string(profile.WorkStyle),
+		PreferredLocations: model.JSON(preferredLocations),
+		CreatedAt:          profile.CreatedAt,
+		UpdatedAt:          profile.UpdatedAt,
+	}
+
+	certifications := make([]model.ProfileCertification, len(profile.Certifications))
+	for i, c := range profile.Certifications {
+		certifications[i] = model.ProfileCertification{
+			UserID:   profile.UserID,
+			Position: i,
+			Name:     c.Name,
+			Issuer:   c.Issuer,
+		}
+		if !c.AcquiredMonth.IsZero() {
+			acquiredMonth := c.AcquiredMonth
+			certifications[i].AcquiredMonth = &acquiredMonth
+		}
+	}
+
+	educations := make([]model.ProfileEducation, len(profile.Educations))
+	for i, e := range profile.Educations {
+		educations[i] = model.ProfileEducation{
+			UserID:     profile.UserID,
+			Position:   i,
+			SchoolName: e.SchoolName,
+			Faculty:    e.Faculty,
+			Degree:     e.Degree,
+		}
+		if !e.Period.IsZero() {
+			start := e.Period.Start()
+			educations[i].StartMonth = &start
+			if end, ok := e.Period.End(); ok {
+				educations[i].EndMonth = &end
+			}
+		}
+	}
+	return m, certifications, educations, nil
+}
+
+// toProfileEntity は GORM のモデルをプロフィールの集約に変換します
+func toProfileEntity(m model.Profile, certifications []model.ProfileCertification, educations []model.ProfileEducation) (entity.Profile, error) {
+	workStyle, err := entity.ParseWorkStyle(m.WorkStyle)
+	if err != nil {
+		return entity.Profile{}, fmt.Errorf("profile %s: %w", m.UserID, err)
+	}
+	var preferredLocations []string
+	if len(m.PreferredLocations) > 0 {
+		if err := json.Unmarshal(m.PreferredLocations, &preferredLocations); err != nil {
+			return entity.Profile{}, fmt.Errorf("profile %s: failed to unmarshal preferred locations: %w", m.UserID, err)
+		}
+	}
+
+	profile := entity.Profile{
+		UserID:             m.UserID,
+		DisplayName:        m.DisplayName,
+		Initials:           m.Initials,
+		Headline:           m.Headline,
+		SelfPR:             m.SelfPR,
+		NearestStation:     m.NearestStation,
+		WorkStyle:          workStyle,
+		PreferredLocations: preferredLocations,
+		Certifications:     make([]entity.Certification, len(certifications)),
+		Educations:         make([]entity.Education, len(educations)),
+		CreatedAt:          m.CreatedAt,
+		UpdatedAt:          m.UpdatedAt,
+	}
+	for i, c := range certifications {
+		profile.Certifications[i] = entity.Certification{Name: c.Name, Issuer: c.Issuer}
+		if c.AcquiredMonth != nil {
+			profile.Certifications[i].AcquiredMonth = *c.AcquiredMonth
+		}
+	}
+	for i, e := range educations {
+		var period entity.Period
+		if e.StartMonth != nil {
+			if period, err = entity.NewPeriod(*e.StartMonth, e.EndMonth); err != nil {
+				return entity.Profile{}, fmt.Errorf("profile %s: %w", m.UserID, err)
+			}
+		}
+		profile.Educations[i] = entity.Education{
+			SchoolName: e.SchoolName,
+			Faculty:    e.Faculty,
+			Degree:     e.Degree,
+			Period:     period,
+		}
+	}
+	return profile, nil
+}
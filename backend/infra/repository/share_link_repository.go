@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	entity "stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"stackies/backend/infra/repository/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type shareLinkRepository struct {
+	db *gorm.DB
+}
+
+// FindByUserID implements repository.ShareLinkRepository.
+func (s *shareLinkRepository) FindByUserID(ctx context.Context, userID string) ([]entity.ShareLink, error) {
+	var links []model.ShareLink
+	if err := conn(ctx, s.db).Where("user_id = ?", userID).Order("created_at DESC, id DESC").Find(&links).Error; err != nil {
+		return nil, err
+	}
+	entities := make([]entity.ShareLink, len(links))
+	for i, link := range links {
+		var err error
+		if entities[i], err = toShareLinkEntity(link); err != nil {
+			return nil, err
+		}
+	}
+	return entities, nil
+}
+
+// FindByID implements repository.ShareLinkRepository.
+func (s *shareLinkRepository) FindByID(ctx context.Context, id int) (entity.ShareLink, error) {
+	var link model.ShareLink
+	if err := conn(ctx, s.db).First(&link, id).Error; err != nil {
+		return entity.ShareLink{}, convertError(err)
+	}
+	return toShareLinkEntity(link)
+}
+
+// FindByTokenHash implements repository.ShareLinkRepository.
+func (s *shareLinkRepository) FindByTokenHash(ctx context.Context, tokenHash string) (entity.ShareLink, error) {
+	var link model.ShareLink
+	if err := conn(ctx, s.db).Where("token_hash = ?", tokenHash).First(&link).Error; err != nil {
+		return entity.ShareLink{}, convertError(err)
+	}
+	return toShareLinkEntity(link)
+}
+
+// Create implements repository.ShareLinkRepository.
+func (s *shareLinkRepository) Create(ctx context.Context, link entity.ShareLink) (entity.ShareLink, error) {
+	m, err := toShareLinkModel(link)
+	if err != nil {
+		return entity.ShareLink{}, err
+	}
+	if err := conn(ctx, s.db).Create(&m).Error; err != nil {
+		return entity.ShareLink{}, err
+	}
+	return toShareLinkEntity(m)
+}
+
+// Revoke implements repository.ShareLinkRepository.
+func (s *shareLinkRepository) Revoke(ctx context.Context, id int, revokedAt time.Time) error {
+	result := conn(ctx, s.db).Model(&model.ShareLink{}).
+		Where("id = ?", id).
+		Update("revoked_at", gorm.Expr("COALESCE(revoked_at, ?)", revokedAt))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+// IncrementViewCount implements repository.ShareLinkRepository.
+// 同時に閲覧されても数え漏れがないよう、現在の値に加算します
+func (s *shareLinkRepository) IncrementViewCount(ctx context.Context, id int, viewedAt time.Time) error {
+	result := conn(ctx, s.db).Model(&model.ShareLink{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"view_count":     gorm.Expr("view_count + 1"),
+			"last_viewed_at": viewedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+func NewShareLinkRepository(db *gorm.DB) repository.ShareLinkRepository {
+	return &shareLinkRepository{
+		db: db,
+	}
+}
+
+// toShareLinkModel は共有リンクのエンティティを GORM のモデルに変換します
+func toShareLinkModel(link entity.ShareLink) (model.ShareLink, error) {
+	sections := link.Sections
+	if sections == nil {
+		sections = []entity.ShareSection{}
+	}
+	sectionsJSON, err := json.Marshal(sections)
+	if err != nil {
+		return model.ShareLink{}, fmt.Errorf("failed to marshal share sections: %w", err)
+	}
+	m := model.ShareLink{
+		ID:              link.ID,
+		UserID:          link.UserID,
+		Label:           link.Label,
+		TokenHash:       link.TokenHash,
+		PasswordHash:    link.PasswordHash,
+		Sections:        model.JSON(sectionsJSON),
+		HideClientNames: link.HideClientNames,
+		HidePeriod:      link.HidePeriod,
+		Anonymize:       link.Anonymize,
+		ExpiresAt:       link.ExpiresAt,
+		ViewCount:       link.ViewCount,
+		CreatedAt:       link.CreatedAt,
+	}
+	if !link.RevokedAt.IsZero() {
+		revokedAt := link.RevokedAt
+		m.RevokedAt = &revokedAt
+	}
+	if !link.LastViewedAt.IsZero() {
+		lastViewedAt := link.LastViewedAt
+		m.LastViewedAt = &lastViewedAt
+	}
+	return m, nil
+}
+
+// toShareLinkEntity は GORM のモデルを共有リンクのエンティティに変換します
+func toShareLinkEntity(m model.ShareLink) (entity.ShareLink, error) {
+	var values []string
+	if len(m.Sections) > 0 {
+		if err := json.Unmarshal(m.Sections, &values); err != nil {
+			return entity.ShareLink{}, fmt.Errorf("share link %d: failed to unmarshal sections: %w", m.ID, err)
+		}
+	}
+	var sections []entity.ShareSection
+	for _, value := range values {
+		sections = append(sections, entity.ShareSection(value))
+	}
+	link := entity.ShareLink{
+		ID:           m.ID,
+		UserID:       m.UserID,
+		Label:        m.Label,
+		TokenHash:    m.TokenHash,
+		PasswordHash: m.PasswordHash,
+		ShareVisibility: entity.ShareVisibility{
+			Sections:        sections,
+			HideClientNames: m.HideClientNames,
+			HidePeriod:      m.HidePeriod,
+			Anonymize:       m.Anonymize,
+		},
+		ExpiresAt: m.ExpiresAt,
+		ViewCount: m.ViewCount,
+		CreatedAt: m.CreatedAt,
+	}
+	if m.RevokedAt != nil {
+		link.RevokedAt = *m.RevokedAt
+	}
+	if m.LastViewedAt != nil {
+		link.LastViewedAt = *m.LastViewedAt
+	}
+	return link, nil
+}
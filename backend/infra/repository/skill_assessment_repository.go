@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	entity "stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"stackies/backend/infra/repository/model"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type skillAssessmentRepository struct {
+	db *gorm.DB
+}
+
+// FindByUserID implements repository.SkillAssessmentRepository.
+func (s *skillAssessmentRepository) FindByUserID(ctx context.Context, userID string) ([]entity.SkillAssessment, error) {
+	var assessments []model.SkillAssessment
+	if err := conn(ctx, s.db).Where("user_id = ?", userID).Order("id").Find(&assessments).Error; err != nil {
+		return nil, err
+	}
+	entities := make([]entity.SkillAssessment, len(assessments))
+	for i, a := range assessments {
+		var err error
+		if entities[i], err = toSkillAssessmentEntity(a); err != nil {
+			return nil, err
+		}
+	}
+	return entities, nil
+}
+
+// Save implements repository.SkillAssessmentRepository.
+func (s *skillAssessmentRepository) Save(ctx context.Context, assessment entity.SkillAssessment) (entity.SkillAssessment, error) {
+	m := model.SkillAssessment{
+		UserID:   assessment.UserID,
+		Category: string(assessment.Skill.Category),
+		Name:     assessment.Skill.Name,
+		NameKey:  strings.ToLower(assessment.Skill.Name),
+		Level:    int(assessment.Level),
+		Comment:  assessment.Comment,
+	}
+	err := conn(ctx, s.db).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "user_id"}, {Name: "category"}, {Name: "name_key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name", "level", "comment", "updated_at"}),
+	}).Create(&m).Error
+	if err != nil {
+		return entity.SkillAssessment{}, err
+	}
+	// 評価済みの場合は既存の行を更新しているため読み直す
+	var saved model.SkillAssessment
+	err = conn(ctx, s.db).
+		Where("user_id = ? AND category = ? AND name_key = ?", m.UserID, m.Category, m.NameKey).
+		First(&saved).Error
+	if err != nil {
+		return entity.SkillAssessment{}, convertError(err)
+	}
+	return toSkillAssessmentEntity(saved)
+}
+
+// FindEndorsementsByUserID implements repository.SkillAssessmentRepository.
+func (s *skillAssessmentRepository) FindEndorsementsByUserID(ctx context.Context, userID string) ([]entity.SkillEndorsement, error) {
+	var endorsements []model.SkillEndorsement
+	if err := conn(ctx, s.db).Where("user_id = ?", userID).Order("updated_at DESC, id DESC").Find(&endorsements).Error; err != nil {
+		return nil, err
+	}
+	entities := make([]entity.SkillEndorsement, len(endorsements))
+	for i, e := range endorsements {
+		var err error
+		if entities[i], err = toSkillEndorsementEntity(e); err != nil {
+			return nil, err
+		}
+	}
+	return entities, nil
+}
+
+// SaveEndorsement implements repository.SkillAssessmentRepository.
+func (s *skillAssessmentRepository) SaveEndorsement(ctx context.Context, endorsement entity.SkillEndorsement) (entity.SkillEndorsement, error) {
+	m := model.SkillEndorsement{
+		UserID:     endorsement.UserID,
+		EndorserID: endorsement.EndorserID,
+		Category:   string(endorsement.Skill.Category),
+		Name:       endorsement.Skill.Name,
+		NameKey:    strings.ToLower(endorsement.Skill.Name),
+		Level:      int(endorsement.Level),
+		Comment:    endorsement.Comment,
+	}
+	err := conn(ctx, s.db).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "user_id"}, {Name: "endorser_id"}, {Name: "category"}, {Name: "name_key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name", "level", "comment", "updated_at"}),
+	}).Create(&m).Error
+	if err != nil {
+		return entity.SkillEndorsement{}, err
+	}
+	// 推薦済みの場合は ID と推薦した日時を返すため読み直す
+	var saved model.SkillEndorsement
+	err = conn(ctx, s.db).
+		Where("user_id = ? AND endorser_id = ? AND category = ? AND name_key = ?", m.UserID, m.EndorserID, m.Category, m.NameKey).
+		First(&saved).Error
+	if err != nil {
+		return entity.SkillEndorsement{}, convertError(err)
+	}
+	return toSkillEndorsementEntity(saved)
+}
+
+func NewSkillAssessmentRepository(db *gorm.DB) repository.SkillAssessmentRepository {
+	return &skillAssessmentRepository{
+		db: db,
+	}
+}
+
+// toSkillAssessmentEntity は GORM のモデルを自己評価のエンティティに変換します
+func toSkillAssessmentEntity(m model.SkillAssessment) (entity.SkillAssessment, error) {
+	skill, err := entity.NewSkill(m.Category, m.Name)
+	if err != nil {
+		return entity.SkillAssessment{}, fmt.Errorf("skill assessment %d: %w", m.ID, err)
+	}
+	level, err := entity.NewProficiencyLevel(m.Level)
+	if err != nil {
+		return entity.SkillAssessment{}, fmt.Errorf("skill assessment %d: %w", m.ID, err)
+	}
+	return entity.SkillAssessment{
+		UserID:    m.UserID,
+		Skill:     skill,
+		Level:     level,
+		Comment:   m.Comment,
+		UpdatedAt: m.UpdatedAt,
+	}, nil
+}
+
+// toSkillEndorsementEntity は GORM のモデルを推薦のエンティティに変換します
+func toSkillEndorsementEntity(m model.SkillEndorsement) (entity.SkillEndorsement, error) {
+	skill, err := entity.NewSkill(m.Category, m.Name)
+	if err != nil {
+		return entity.SkillEndorsement{}, fmt.Errorf("skill endorsement %d: %w", m.ID, err)
+	}
+	level, err := entity.NewProficiencyLevel(m.Level)
+	if err != nil {
+		return entity.SkillEndorsement{}, fmt.Errorf("skill endorsement %d: %w", m.ID, err)
+	}
+	return entity.SkillEndorsement{
+		ID:         m.ID,
+		UserID:     m.UserID,
+		EndorserID: m.EndorserID,
+		Skill:      skill,
+		Level:      level,
+		Comment:    m.Comment,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}, nil
+}
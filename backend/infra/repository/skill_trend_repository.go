@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	entity "stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"stackies/backend/infra/repository/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// skillTrendRepository は業務経歴を読み込まずに SQL で集計します
+// テナントの条件は Model の experience_skills にだけ付くため、結合する表はテナントが同じことを条件にします
+type skillTrendRepository struct {
+	db *gorm.DB
+}
+
+type skillQuarterUsageRow struct {
+	Quarter        time.Time
+	Category       string
+	Name           string
+	EngineerMonths int
+}
+
+type skillHolderRow struct {
+	Category string
+	Name     string
+	UserID   string
+}
+
+// FindQuarterlyUsage implements repository.SkillTrendRepository.
+func (s *skillTrendRepository) FindQuarterlyUsage(ctx context.Context, organizationID int, from, to entity.Quarter) ([]entity.SkillQuarterUsage, error) {
+	db := conn(ctx, s.db)
+	var rows []skillQuarterUsageRow
+	// 業務経歴の期間を集計する期間内の月に展開し、同じユーザーの同じ月は1人月と数える
+	err := s.organizationSkills(db, organizationID).
+		Joins("CROSS JOIN LATERAL generate_series(GREATEST(experiences.start_month, ?::date), LEAST(COALESCE(experiences.end_month, ?::date), ?::date), interval '1 month') AS months(month)",
+			from.Start(), to.LastMonth(), to.LastMonth()).
+		Select("date_trunc('quarter', months.month)::date AS quarter, experience_skills.category, MIN(experience_skills.name) AS name, " +
+			"COUNT(DISTINCT (experiences.user_id, months.month)) AS engineer_months").
+		Group("quarter, experience_skills.category, lower(experience_skills.name)").
+		Order("quarter, experience_skills.category, lower(experience_skills.name)").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	usages := make([]entity.SkillQuarterUsage, len(rows))
+	for i, row := range rows {
+		usages[i] = entity.SkillQuarterUsage{
+			Skill:          entity.Skill{Category: entity.SkillCategory(row.Category), Name: row.Name},
+			Quarter:        entity.QuarterOf(row.Quarter),
+			EngineerMonths: row.EngineerMonths,
+		}
+	}
+	return usages, nil
+}
+
+// FindSoleHolders implements repository.SkillTrendRepository.
+func (s *skillTrendRepository) FindSoleHolders(ctx context.Context, organizationID int) ([]entity.SkillHolder, error) {
+	db := conn(ctx, s.db)
+	var rows []skillHolderRow
+	err := s.organizationSkills(db, organizationID).
+		Select("experience_skills.category, MIN(experience_skills.name) AS name, MIN(experiences.user_id) AS user_id").
+		Group("experience_skills.category, lower(experience_skills.name)").
+		Having("COUNT(DISTINCT experiences.user_id) = 1").
+		Order("experience_skills.category, lower(experience_skills.name)").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	holders := make([]entity.SkillHolder, len(rows))
+	for i, row := range rows {
+		holders[i] = entity.SkillHolder{
+			Skill:  entity.Skill{Category: entity.SkillCategory(row.Category), Name: row.Name},
+			UserID: row.UserID,
+		}
+	}
+	return holders, nil
+}
+
+// organizationSkills は組織のいずれかのチームに所属するユーザーの、削除されていない業務経歴のスキルを絞り込みます
+// 参画期間のない業務経歴は、GREATEST が NULL を無視して集計期間の全ての月に使用したことになるため含めません
+func (s *skillTrendRepository) organizationSkills(db *gorm.DB, organizationID int) *gorm.DB {
+	members := db.Model(&model.TeamMember{}).
+		Select("team_members.user_id").
+		Joins("JOIN teams ON teams.id = team_members.team_id").
+		Where("teams.organization_id = ?", organizationID)
+	return db.Model(&model.ExperienceSkill{}).
+		Joins("JOIN experiences ON experiences.id = experience_skills.experience_id AND experiences.tenant_id = experience_skills.tenant_id").
+		Where("experiences.deleted_at IS NULL").
+		Where("experiences.start_month IS NOT NULL").
+		Where("experiences.user_id IN (?)", members)
+}
+
+func NewSkillTrendRepository(db *gorm.DB) repository.SkillTrendRepository {
+	return &skillTrendRepository{
+		db: db,
+	}
+}
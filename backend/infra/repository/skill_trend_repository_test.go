@@ -0,0 +1,102 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	entity "stackies/backend/domain/model"
+	"stackies/backend/infra/repository"
+	"stackies/backend/infra/repository/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// seedSkillTrends は組織1のチームに所属する user-1〜user-3 と、所属しない user-4 の業務経歴を登録し、組織の ID を返します
+//
+//   - user-1: Go（2025-01〜2025-06）、Go・AWS（2025-02〜2025-03、Go は前の業務経歴と同じ月）
+//   - user-2: Go（2025-03〜参画中）、Rust（2025-01〜2025-03、削除済み）
+//   - user-3: Java（2024-01〜2024-03、集計期間より前）、Java・COBOL（参画期間なし）
+//   - user-4: Go・Kotlin（2025-01〜2025-03、組織に所属しない）
+func seedSkillTrends(t *testing.T, db *gorm.DB) int {
+	t.Helper()
+	db = db.WithContext(defaultTenantContext())
+
+	organization := model.Organization{Name: "開発本部"}
+	require.NoError(t, db.Create(&organization).Error)
+	team := model.Team{OrganizationID: organization.ID, Name: "決済チーム"}
+	require.NoError(t, db.Create(&team).Error)
+	for _, userID := range []string{"user-1", "user-2", "user-3"} {
+		require.NoError(t, db.Create(&model.TeamMember{TeamID: team.ID, UserID: userID, Role: "member"}).Error)
+	}
+
+	month := func(year int, m time.Month) *time.Time {
+		v := time.Date(year, m, 1, 0, 0, 0, 0, time.UTC)
+		return &v
+	}
+	experience := func(userID string, start, end *time.Time, skills ...string) *model.Experience {
+		e := &model.Experience{UserID: userID, Title: "案件", StartMonth: start, EndMonth: end, Phases: model.JSON("[]")}
+		for i, name := range skills {
+			category := string(entity.SkillCategoryLanguage)
+			if name == "AWS" {
+				category = string(entity.SkillCategoryTool)
+			}
+			e.Skills = append(e.Skills, model.ExperienceSkill{Position: i, Category: category, Name: name})
+		}
+		require.NoError(t, db.Create(e).Error)
+		return e
+	}
+
+	experience("user-1", month(2025, time.January), month(2025, time.June), "Go")
+	experience("user-1", month(2025, time.February), month(2025, time.March), "Go", "AWS")
+	experience("user-2", month(2025, time.March), nil, "Go")
+	deleted := experience("user-2", month(2025, time.January), month(2025, time.March), "Rust")
+	require.NoError(t, db.Delete(deleted).Error)
+	experience("user-3", month(2024, time.January), month(2024, time.March), "Java")
+	experience("user-3", nil, nil, "Java", "COBOL")
+	experience("user-4", month(2025, time.January), month(2025, time.March), "Go", "Kotlin")
+	return organization.ID
+}
+
+func TestSkillTrendRepository_FindQuarterlyUsage(t *testing.T) {
+	db := openTestDB(t)
+	organizationID := seedSkillTrends(t, db)
+	repo := repository.NewSkillTrendRepository(db)
+
+	from := entity.Quarter{Year: 2025, Number: 1}
+	to := entity.Quarter{Year: 2025, Number: 2}
+	got, err := repo.FindQuarterlyUsage(defaultTenantContext(), organizationID, from, to)
+	require.NoError(t, err)
+
+	goSkill := entity.Skill{Category: entity.SkillCategoryLanguage, Name: "Go"}
+	awsSkill := entity.Skill{Category: entity.SkillCategoryTool, Name: "AWS"}
+	// 同じユーザーの同じ月は1人月と数え、参画中の業務経歴は to の四半期の最後の月まで数えること
+	// 参画期間のない業務経歴・削除した業務経歴・組織外のユーザーの業務経歴は数えないこと
+	assert.Equal(t, []entity.SkillQuarterUsage{
+		{Skill: goSkill, Quarter: from, EngineerMonths: 4},
+		{Skill: awsSkill, Quarter: from, EngineerMonths: 2},
+		{Skill: goSkill, Quarter: to, EngineerMonths: 6},
+	}, got)
+
+	// 前の四半期から増えた Go は growing、使われなくなった AWS は declining と判定されること
+	trends := entity.AnalyzeSkillTrends(got, to, 1)
+	require.Len(t, trends, 2)
+	assert.Equal(t, entity.SkillTrend{Skill: goSkill, Recent: 6, Previous: 4, Direction: entity.SkillTrendGrowing}, trends[0])
+	assert.Equal(t, entity.SkillTrend{Skill: awsSkill, Recent: 0, Previous: 2, Direction: entity.SkillTrendDeclining}, trends[1])
+}
+
+func TestSkillTrendRepository_FindSoleHolders(t *testing.T) {
+	db := openTestDB(t)
+	organizationID := seedSkillTrends(t, db)
+	repo := repository.NewSkillTrendRepository(db)
+
+	got, err := repo.FindSoleHolders(defaultTenantContext(), organizationID)
+	require.NoError(t, err)
+
+	// 経験者が2人の Go と、参画期間のない業務経歴にしかない COBOL は含めないこと
+	assert.Equal(t, []entity.SkillHolder{
+		{Skill: entity.Skill{Category: entity.SkillCategoryLanguage, Name: "Java"}, UserID: "user-3"},
+		{Skill: entity.Skill{Category: entity.SkillCategoryTool, Name: "AWS"}, UserID: "user-1"},
+	}, got)
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"errors"
+	"reflect"
+	entity "stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+const (
+	// tenantField はテナントごとのデータのモデルが持つフィールドです（列は tenant_id）
+	tenantField  = "TenantID"
+	tenantColumn = "tenant_id"
+)
+
+// tenantPlugin はテナントごとのデータの読み書きをコンテキストのテナントに限定する GORM のプラグインです
+// TenantID フィールドを持つモデルの読み取り・更新・削除には tenantScope で条件を付け、登録時には TenantID を設定します
+// リポジトリで条件を書き忘れても他のテナントのデータは読み書きできず、コンテキストにテナントがない場合は ErrTenantRequired になります
+// Raw・Exec の SQL には条件を付けられないため、テナントごとのデータには使いません
+type tenantPlugin struct{}
+
+// NewTenantPlugin は GORM 用のテナント分離のプラグインを作成します
+func NewTenantPlugin() gorm.Plugin {
+	return &tenantPlugin{}
+}
+
+// Name implements gorm.Plugin.
+func (p *tenantPlugin) Name() string {
+	return "tenant"
+}
+
+// Initialize implements gorm.Plugin.
+func (p *tenantPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	registers := []error{
+		cb.Create().Before("gorm:create").Register("tenant:assign", p.assign),
+		cb.Query().Before("gorm:query").Register("tenant:scope_query", p.scope(true)),
+		cb.Row().Before("gorm:row").Register("tenant:scope_row", p.scope(true)),
+		cb.Update().Before("gorm:update").Register("tenant:scope_update", p.scope(false)),
+		cb.Delete().Before("gorm:delete").Register("tenant:scope_delete", p.scope(false)),
+	}
+	return errors.Join(registers...)
+}
+
+// scope は読み取り・更新・削除をコンテキストのテナントのデータに限定します
+// TenantID が NULL を許す（ポインタの）モデルは、読み取りに限り全テナント共通のデータも含めます
+func (p *tenantPlugin) scope(readable bool) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		field, tenant, ok := p.resolve(db)
+		if !ok {
+			return
+		}
+		includeGlobal := readable && field.FieldType.Kind() == reflect.Ptr
+		tenantScope(tenant.ID, includeGlobal)(db)
+	}
+}
+
+// assign は登録するレコードの TenantID をコンテキストのテナントで上書きします
+func (p *tenantPlugin) assign(db *gorm.DB) {
+	field, tenant, ok := p.resolve(db)
+	if !ok {
+		return
+	}
+	var value interface{} = tenant.ID
+	if field.FieldType.Kind() == reflect.Ptr {
+		value = &tenant.ID
+	}
+
+	ctx := db.Statement.Context
+	switch rv := db.Statement.ReflectValue; rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			if err := field.Set(ctx, reflect.Indirect(rv.Index(i)), value); err != nil {
+				db.AddError(err)
+				return
+			}
+		}
+	case reflect.Struct:
+		if err := field.Set(ctx, rv, value); err != nil {
+			db.AddError(err)
+		}
+	}
+}
+
+// resolve はモデルがテナントごとのデータの場合に、TenantID フィールドとコンテキストのテナントを返します
+func (p *tenantPlugin) resolve(db *gorm.DB) (*schema.Field, entity.Tenant, bool) {
+	if db.Error != nil || db.Statement.Schema == nil {
+		return nil, entity.Tenant{}, false
+	}
+	field := db.Statement.Schema.LookUpField(tenantField)
+	if field == nil {
+		return nil, entity.Tenant{}, false
+	}
+	tenant, ok := entity.TenantFromContext(db.Statement.Context)
+	if !ok {
+		db.AddError(repository.ErrTenantRequired)
+		return nil, entity.Tenant{}, false
+	}
+	return field, tenant, true
+}
+
+// tenantScope はテナントのデータに絞り込む GORM のスコープです
+// includeGlobal の場合は全テナント共通のデータ（tenant_id が NULL）も含めます
+// 結合したテーブルと区別するため、列はクエリの対象のテーブルで修飾します
+func tenantScope(tenantID string, includeGlobal bool) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		column := clause.Column{Table: clause.CurrentTable, Name: tenantColumn}
+		if includeGlobal {
+			return db.Where(clause.Or(clause.Eq{Column: column, Value: tenantID}, clause.Eq{Column: column, Value: nil}))
+		}
+		return db.Where(clause.Eq{Column: column, Value: tenantID})
+	}
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	entity "stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"stackies/backend/infra/repository/model"
+
+	"gorm.io/gorm"
+)
+
+type tenantRepository struct {
+	db *gorm.DB
+}
+
+// FindByID implements repository.TenantRepository.
+func (t *tenantRepository) FindByID(ctx context.Context, id string) (entity.Tenant, error) {
+	var tenant model.Tenant
+	if err := conn(ctx, t.db).Where("id = ?", id).First(&tenant).Error; err != nil {
+		return entity.Tenant{}, convertError(err)
+	}
+	return toTenantEntity(tenant), nil
+}
+
+// FindByShareTokenHash implements repository.TenantRepository.
+// tenants はテナントごとのデータではないため、結合した share_links もテナントのプラグインで絞り込まれません
+// token_hash は全テナントで一意です
+func (t *tenantRepository) FindByShareTokenHash(ctx context.Context, tokenHash string) (entity.Tenant, error) {
+	var tenant model.Tenant
+	err := conn(ctx, t.db).
+		Joins("JOIN share_links ON share_links.tenant_id = tenants.id").
+		Where("share_links.token_hash = ?", tokenHash).
+		First(&tenant).Error
+	if err != nil {
+		return entity.Tenant{}, convertError(err)
+	}
+	return toTenantEntity(tenant), nil
+}
+
+func NewTenantRepository(db *gorm.DB) repository.TenantRepository {
+	return &tenantRepository{
+		db: db,
+	}
+}
+
+func toTenantEntity(tenant model.Tenant) entity.Tenant {
+	return entity.Tenant{
+		ID:                   tenant.ID,
+		Name:                 tenant.Name,
+		InheritGlobalCatalog: tenant.InheritGlobalCatalog,
+		CreatedAt:            tenant.CreatedAt,
+	}
+}
@@ -0,0 +1,77 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	entity "stackies/backend/domain/model"
+	"stackies/backend/infra/repository"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// migrationsDir はテスト用のスキーマに適用するマイグレーションのディレクトリです
+const migrationsDir = "../../migrations"
+
+// openTestDB は TEST_DATABASE_DSN の PostgreSQL に空のスキーマを作成し、マイグレーションを適用して接続します
+// TEST_DATABASE_DSN は config.ConnectDB と同じ key=value 形式です（例: host=localhost user=postgres password=postgres dbname=stackies_test sslmode=disable）
+// 未設定の場合はテストをスキップします。スキーマはテストの終了時に削除します
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN is not set")
+	}
+
+	admin, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	schema := fmt.Sprintf("test_%d", time.Now().UnixNano())
+	require.NoError(t, admin.Exec("CREATE SCHEMA "+schema).Error)
+	t.Cleanup(func() {
+		admin.Exec("DROP SCHEMA " + schema + " CASCADE")
+		if sqlDB, err := admin.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+
+	db, err := gorm.Open(postgres.Open(dsn+" search_path="+schema), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+	applyMigrations(t, db)
+	require.NoError(t, db.Use(repository.NewTenantPlugin()))
+	return db
+}
+
+// applyMigrations は sql-migrate のマイグレーションの Up を名前の順に適用します
+func applyMigrations(t *testing.T, db *gorm.DB) {
+	t.Helper()
+	files, err := filepath.Glob(filepath.Join(migrationsDir, "*.sql"))
+	require.NoError(t, err)
+	sort.Strings(files)
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		require.NoError(t, err)
+		up, _, _ := strings.Cut(string(content), "-- +migrate Down")
+		up = strings.Replace(up, "-- +migrate Up", "", 1)
+		// 引数のない Exec は単純問い合わせプロトコルで送るため、複数の文をまとめて実行できる
+		require.NoError(t, db.Exec(up).Error, file)
+	}
+}
+
+// defaultTenantContext はマイグレーションで作成される既定のテナントのコンテキストです
+func defaultTenantContext() context.Context {
+	return entity.ContextWithTenant(context.Background(), entity.Tenant{ID: entity.DefaultTenantID})
+}
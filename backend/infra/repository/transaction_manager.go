@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"stackies/backend/domain/repository"
+
+	"gorm.io/gorm"
+)
+
+// txKey はトランザクションをコンテキストに格納するためのキーです
+type txKey struct{}
+
+type transactionManager struct {
+	db *gorm.DB
+}
+
+// Do implements repository.TransactionManager.
+func (t *transactionManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	// 既にトランザクション内の場合は、GORM がセーブポイントで入れ子にする
+	return conn(ctx, t.db).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+func NewTransactionManager(db *gorm.DB) repository.TransactionManager {
+	return &transactionManager{
+		db: db,
+	}
+}
+
+// conn はコンテキストにトランザクションがあればそれを、なければ db を返します
+// リポジトリは e.db を直接使わず、必ずこの関数を通して接続を取得します
+func conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
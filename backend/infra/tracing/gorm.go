@@ -0,0 +1,95 @@
+package tracing
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const (
+	gormTracerName = "stackies/backend/infra/tracing/gorm"
+	gormSpanKey    = "tracing:span"
+)
+
+// gormPlugin は GORM の各クエリにスパンを張るプラグインです
+// スパンの親はリポジトリで接続に渡したコンテキスト（トランザクション内を含む）になります
+type gormPlugin struct {
+	tracer trace.Tracer
+}
+
+// NewGormPlugin は GORM 用のトレースプラグインを作成します
+func NewGormPlugin() gorm.Plugin {
+	return &gormPlugin{tracer: otel.Tracer(gormTracerName)}
+}
+
+// Name implements gorm.Plugin.
+func (p *gormPlugin) Name() string {
+	return "tracing"
+}
+
+// Initialize implements gorm.Plugin.
+func (p *gormPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	registers := []error{
+		cb.Create().Before("gorm:create").Register("tracing:before_create", p.before("create")),
+		cb.Create().After("gorm:create").Register("tracing:after_create", p.after),
+		cb.Query().Before("gorm:query").Register("tracing:before_query", p.before("query")),
+		cb.Query().After("gorm:query").Register("tracing:after_query", p.after),
+		cb.Update().Before("gorm:update").Register("tracing:before_update", p.before("update")),
+		cb.Update().After("gorm:update").Register("tracing:after_update", p.after),
+		cb.Delete().Before("gorm:delete").Register("tracing:before_delete", p.before("delete")),
+		cb.Delete().After("gorm:delete").Register("tracing:after_delete", p.after),
+		cb.Row().Before("gorm:row").Register("tracing:before_row", p.before("row")),
+		cb.Row().After("gorm:row").Register("tracing:after_row", p.after),
+		cb.Raw().Before("gorm:raw").Register("tracing:before_raw", p.before("raw")),
+		cb.Raw().After("gorm:raw").Register("tracing:after_raw", p.after),
+	}
+	return errors.Join(registers...)
+}
+
+func (p *gormPlugin) before(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		if db.Statement == nil || db.Statement.Context == nil {
+			return
+		}
+		ctx, span := p.tracer.Start(db.Statement.Context, "gorm."+operation,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				semconv.DBSystemNamePostgreSQL,
+				semconv.DBOperationName(operation),
+			),
+		)
+		db.Statement.Context = ctx
+		db.InstanceSet(gormSpanKey, span)
+	}
+}
+
+func (p *gormPlugin) after(db *gorm.DB) {
+	v, ok := db.InstanceGet(gormSpanKey)
+	if !ok {
+		return
+	}
+	span, ok := v.(trace.Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	if db.Statement.Table != "" {
+		span.SetAttributes(semconv.DBCollectionName(db.Statement.Table))
+	}
+	if sql := db.Statement.SQL.String(); sql != "" {
+		// バインド値は個人情報を含み得るため、プレースホルダのままの SQL を記録する
+		span.SetAttributes(semconv.DBQueryText(sql))
+	}
+	span.SetAttributes(semconv.DBResponseReturnedRows(int(db.RowsAffected)))
+
+	if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+		span.RecordError(db.Error)
+		span.SetStatus(codes.Error, db.Error.Error())
+	}
+}
@@ -2,24 +2,29 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 	"time"
 
+	appmiddleware "stackies/backend/application"
 	"stackies/backend/config"
+	"stackies/backend/infra/logging"
 	"stackies/backend/infra/repository"
 	"stackies/backend/presenter"
 	"stackies/backend/usecase"
 
 	"github.com/MicahParks/keyfunc"
 	"github.com/coreos/go-oidc"
-	"github.com/davecgh/go-spew/spew"
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -50,38 +55,121 @@ const (
 
 var jwks *keyfunc.JWKS
 
+// tenantClaimMiddleware は JWT のクレームからテナントを決めるミドルウェアです（main で設定し、JWTMiddleware から呼び出す）
+var tenantClaimMiddleware echo.MiddlewareFunc
+
 func main() {
+	// ロガーの初期化
+	logger := config.NewLogger(config.NewLoggerConfig(), os.Stdout)
+	slog.SetDefault(logger)
+
 	var err error
 	jwks, err = keyfunc.Get(jwksURL, keyfunc.Options{
 		RefreshInterval: time.Hour,
 	})
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "JWKs取得失敗: %v\n", err)
+		logger.Error("JWKs取得失敗", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// トレースの初期化
+	telemetryConfig := config.NewTelemetryConfig()
+	tracerProvider, err := config.InitTracer(ctx, telemetryConfig)
+	if err != nil {
+		logger.Error("トレース初期化失敗", slog.Any("error", err))
 		os.Exit(1)
 	}
 
 	// Echoインスタンスの作成
 	e := echo.New()
+	e.HideBanner = true
+	e.HTTPErrorHandler = presenter.NewHTTPErrorHandler(logger)
 
 	// ミドルウェアの設定
-	e.Use(middleware.Logger())
+	e.Use(appmiddleware.RequestID())
+	e.Use(appmiddleware.Tracing(telemetryConfig.ServiceName))
+	e.Use(appmiddleware.RequestLogger(logger))
 	e.Use(middleware.Recover())
-	e.Use(middleware.CORS())
-	e.Use(middleware.BodyDump(func(c echo.Context, req []byte, res []byte) {
-		fmt.Println("Request Body:", string(req))
-		fmt.Println("Response Body:", string(res))
+	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
+		// フロントエンドからトレースIDと楽観的排他制御のバージョンを参照できるようにする
+		ExposeHeaders: []string{appmiddleware.HeaderXTraceID, echo.HeaderXRequestID, presenter.HeaderETag},
 	}))
+	// リクエスト・レスポンスボディのログ出力（機密情報はマスクする）
+	if bodyDumpConfig := config.NewBodyDumpConfig(); bodyDumpConfig.Enabled {
+		maskPaths := make([]appmiddleware.MaskPath, len(bodyDumpConfig.MaskPaths))
+		for i, p := range bodyDumpConfig.MaskPaths {
+			maskPaths[i] = appmiddleware.MaskPath{Path: p.Path, KeepLast: p.KeepLast}
+		}
+		e.Use(appmiddleware.BodyDumpWithConfig(appmiddleware.BodyDumpConfig{
+			MaxBodySize: bodyDumpConfig.MaxBodySize,
+			ExcludeURLs: bodyDumpConfig.ExcludeURLs,
+			MaskFields:  bodyDumpConfig.MaskFields,
+			MaskPaths:   maskPaths,
+			MaskHeaders: bodyDumpConfig.MaskHeaders,
+			Logger:      logger,
+		}))
+	}
 
 	// データベース接続の初期化
 	dbConfig := config.NewDBConfig()
-	db, err := config.ConnectDB(dbConfig)
+	db, err := config.ConnectDB(dbConfig, logger)
 	if err != nil {
-		e.Logger.Fatal(err)
+		logger.Error("データベース接続失敗", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	// テナントの解決（データベースのテナントを参照するため接続後に設定する）
+	tenantConfig := config.NewTenantConfig()
+	tenantMiddlewareConfig := appmiddleware.TenantConfig{
+		BaseDomain:      tenantConfig.BaseDomain,
+		DefaultTenantID: tenantConfig.DefaultTenantID,
+		Claim:           tenantConfig.Claim,
 	}
+	tenantUsecase := usecase.NewTenantUsecase(repository.NewTenantRepository(db), logger)
+	e.Use(appmiddleware.Tenant(tenantMiddlewareConfig, tenantUsecase))
+	tenantClaimMiddleware = appmiddleware.TenantClaim(tenantMiddlewareConfig, tenantUsecase)
 
 	experienceRepository := repository.NewExperienceRepository(db)
-	experienceUsecase := usecase.NewExperienceUsecase(experienceRepository)
+	experienceRevisionRepository := repository.NewExperienceRevisionRepository(db)
+	catalogRepository := repository.NewCatalogRepository(db)
+	auditLogRepository := repository.NewAuditLogRepository(db)
+	transactionManager := repository.NewTransactionManager(db)
+	experienceUsecase := usecase.NewExperienceUsecase(experienceRepository, experienceRevisionRepository, catalogRepository, auditLogRepository, transactionManager, logger)
 	experienceHandler := presenter.NewExperienceHandler(experienceUsecase)
+	auditUsecase := usecase.NewAuditUsecase(auditLogRepository, logger)
+	auditHandler := presenter.NewAuditHandler(auditUsecase)
+	profileRepository := repository.NewProfileRepository(db)
+	skillAssessmentRepository := repository.NewSkillAssessmentRepository(db)
+	profileUsecase := usecase.NewProfileUsecase(profileRepository, auditLogRepository, transactionManager, logger)
+	profileHandler := presenter.NewProfileHandler(profileUsecase)
+	// PDF に埋め込むフォントがない場合も起動はし、PDF の出力だけを無効にする
+	resumeFont, err := os.ReadFile(config.NewResumeConfig().FontPath)
+	if err != nil {
+		logger.Warn("業務経歴書のフォント読み込み失敗（PDF出力は無効）", slog.Any("error", err))
+	}
+	resumeUsecase := usecase.NewResumeUsecase(profileRepository, experienceRepository, skillAssessmentRepository, logger)
+	resumeHandler := presenter.NewResumeHandler(resumeUsecase, resumeFont)
+	shareLinkRepository := repository.NewShareLinkRepository(db)
+	shareLinkUsecase := usecase.NewShareLinkUsecase(shareLinkRepository, profileRepository, experienceRepository, skillAssessmentRepository, auditLogRepository, transactionManager, logger)
+	shareLinkHandler := presenter.NewShareLinkHandler(shareLinkUsecase, resumeFont)
+	organizationRepository := repository.NewOrganizationRepository(db)
+	organizationUsecase := usecase.NewOrganizationUsecase(organizationRepository, profileRepository, experienceRepository, skillAssessmentRepository, auditLogRepository, transactionManager, logger)
+	organizationHandler := presenter.NewOrganizationHandler(organizationUsecase)
+	catalogUsecase := usecase.NewCatalogUsecase(catalogRepository, auditLogRepository, transactionManager, logger)
+	catalogHandler := presenter.NewCatalogHandler(catalogUsecase)
+	staffingUsecase := usecase.NewStaffingUsecase(experienceRepository, profileRepository, logger)
+	staffingHandler := presenter.NewStaffingHandler(staffingUsecase)
+	skillUsecase := usecase.NewSkillUsecase(skillAssessmentRepository, experienceRepository, organizationRepository, profileRepository, auditLogRepository, transactionManager, logger)
+	skillHandler := presenter.NewSkillHandler(skillUsecase)
+	timelineUsecase := usecase.NewTimelineUsecase(experienceRepository, profileRepository, organizationRepository, logger)
+	timelineHandler := presenter.NewTimelineHandler(timelineUsecase)
+	skillTrendUsecase := usecase.NewSkillTrendUsecase(repository.NewSkillTrendRepository(db), organizationRepository, profileRepository, logger)
+	skillTrendHandler := presenter.NewSkillTrendHandler(skillTrendUsecase)
+	careerGoalUsecase := usecase.NewCareerGoalUsecase(repository.NewCareerGoalRepository(db), skillAssessmentRepository, organizationRepository, profileRepository, auditLogRepository, transactionManager, logger)
+	careerGoalHandler := presenter.NewCareerGoalHandler(careerGoalUsecase)
 
 	// ルーティング
 	e.GET("/", func(c echo.Context) error {
@@ -104,12 +192,6 @@ func main() {
 			return c.String(http.StatusBadRequest, "codeがありません")
 		}
 
-		spew.Dump(clientID)
-		spew.Dump(clientSecret)
-		spew.Dump(redirectURL)
-		spew.Dump(issuerURL)
-		spew.Dump(tokenURL)
-
 		// トークンエンドポイントにPOST
 		data := url.Values{}
 		data.Set("grant_type", "authorization_code")
@@ -153,9 +235,95 @@ func main() {
 	e.POST("/experiences", experienceHandler.Create, JWTMiddleware)
 	// http://localhost:28080/experiences
 	e.GET("/experiences", experienceHandler.GetAll, JWTMiddleware)
+	e.GET("/experiences/:id", experienceHandler.Get, JWTMiddleware)
+	e.PUT("/experiences/:id", experienceHandler.Update, JWTMiddleware)
+	e.DELETE("/experiences/:id", experienceHandler.Delete, JWTMiddleware)
+	e.POST("/experiences/:id/restore", experienceHandler.Restore, JWTMiddleware)
+	e.GET("/experiences/:id/revisions", experienceHandler.ListRevisions, JWTMiddleware)
+	e.GET("/experiences/:id/revisions/diff", experienceHandler.DiffRevisions, JWTMiddleware)
+	e.POST("/experiences/:id/revisions/:revision/restore", experienceHandler.RestoreRevision, JWTMiddleware)
+	e.POST("/experiences/import", experienceHandler.Import, JWTMiddleware)
+	e.POST("/experiences/import/json-resume", experienceHandler.ImportJSONResume, JWTMiddleware)
+
+	// ログイン中のユーザー自身のプロフィール
+	e.GET("/me/profile", profileHandler.Get, JWTMiddleware)
+	e.PUT("/me/profile", profileHandler.Put, JWTMiddleware)
+	e.GET("/me/resume.pdf", resumeHandler.GetPDF, JWTMiddleware)
+	e.GET("/me/resume.xlsx", resumeHandler.GetXLSX, JWTMiddleware)
+	e.POST("/me/resume.xlsx", resumeHandler.PostXLSX, JWTMiddleware)
+	e.GET("/me/resume.md", resumeHandler.GetMarkdown, JWTMiddleware)
+	e.GET("/me/resume.json", resumeHandler.GetJSONResume, JWTMiddleware)
+	e.POST("/me/share-links", shareLinkHandler.Create, JWTMiddleware)
+	e.GET("/me/share-links", shareLinkHandler.List, JWTMiddleware)
+	e.DELETE("/me/share-links/:id", shareLinkHandler.Revoke, JWTMiddleware)
+	e.GET("/me/teams", organizationHandler.ListMyTeams, JWTMiddleware)
+	e.GET("/me/skills", skillHandler.ListMySkills, JWTMiddleware)
+	e.PUT("/me/skill-levels", skillHandler.SetLevel, JWTMiddleware)
+	e.GET("/me/timeline", timelineHandler.Get, JWTMiddleware)
+
+	// キャリアの目標と学習計画
+	e.GET("/me/goals", careerGoalHandler.List, JWTMiddleware)
+	e.POST("/me/goals", careerGoalHandler.Create, JWTMiddleware)
+	e.PUT("/me/goals/:id", careerGoalHandler.Update, JWTMiddleware)
+	e.DELETE("/me/goals/:id", careerGoalHandler.Delete, JWTMiddleware)
+	e.POST("/me/goals/:id/activities", careerGoalHandler.AddActivity, JWTMiddleware)
+	e.PUT("/me/goals/:id/activities/:activity_id", careerGoalHandler.UpdateActivity, JWTMiddleware)
+	e.DELETE("/me/goals/:id/activities/:activity_id", careerGoalHandler.DeleteActivity, JWTMiddleware)
+
+	// スキルの推薦（同じ組織のユーザーのみ）
+	e.POST("/users/:user_id/endorsements", skillHandler.Endorse, JWTMiddleware)
+	e.GET("/users/:user_id/endorsements", skillHandler.ListEndorsements, JWTMiddleware)
+
+	// 組織・チーム（メンバーの業務経歴・スキルはマネージャーだけが閲覧できる）
+	e.GET("/teams/:id/members", organizationHandler.ListTeamMembers, JWTMiddleware)
+	e.GET("/teams/:id/members/:user_id/resume", organizationHandler.GetMemberResume, JWTMiddleware)
+	e.GET("/teams/:id/goals", careerGoalHandler.ListTeamGoals, JWTMiddleware)
+	e.GET("/orgs/:id/skills", organizationHandler.GetOrganizationSkills, JWTMiddleware)
+	e.GET("/orgs/:id/skill-trends", skillTrendHandler.GetOrganizationTrends, JWTMiddleware)
+	e.POST("/skill-matrix", organizationHandler.AnalyzeSkillGaps, JWTMiddleware)
+
+	// 案件の候補者の検索（営業担当・管理者のみ）
+	e.POST("/staffing/search", staffingHandler.Search, JWTMiddleware, StaffingMiddleware)
+
+	// 言語・ツールのカタログ（全テナント共通の項目とテナント独自の項目）
+	e.GET("/catalog", catalogHandler.List, JWTMiddleware)
+
+	// 共有リンクの閲覧（認証なし）。パスワードの総当たりを防ぐため IP アドレスごとに回数を制限する
+	// サブドメインのない共有リンクは、リンクを作成したテナントのデータを読み取る
+	share := e.Group("/share", middleware.RateLimiter(middleware.NewRateLimiterMemoryStoreWithConfig(
+		middleware.RateLimiterMemoryStoreConfig{Rate: 1, Burst: 10, ExpiresIn: 3 * time.Minute},
+	)), appmiddleware.ShareLinkTenant(tenantMiddlewareConfig, tenantUsecase))
+	share.GET("/:token", shareLinkHandler.View)
+	share.GET("/:token/resume.pdf", shareLinkHandler.ViewPDF)
+
+	// 管理者用エンドポイント
+	admin := e.Group("/admin", JWTMiddleware, AdminMiddleware)
+	admin.GET("/audit", auditHandler.Search)
+	admin.POST("/orgs", organizationHandler.CreateOrganization)
+	admin.POST("/orgs/:id/teams", organizationHandler.CreateTeam)
+	admin.PUT("/teams/:id/members/:user_id", organizationHandler.SaveTeamMember)
+	admin.DELETE("/teams/:id/members/:user_id", organizationHandler.RemoveTeamMember)
+	admin.POST("/catalog", catalogHandler.Create)
 
 	// サーバーの起動
-	e.Logger.Fatal(e.Start(":8080"))
+	go func() {
+		logger.Info("サーバー起動", slog.String("addr", ":8080"))
+		if err := e.Start(":8080"); err != nil && err != http.ErrServerClosed {
+			logger.Error("サーバー起動失敗", slog.Any("error", err))
+			stop()
+		}
+	}()
+
+	// シグナルを受けたら処理中のリクエストとスパンを送り切ってから終了する
+	<-ctx.Done()
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := e.Shutdown(shutdownCtx); err != nil {
+		logger.Error("サーバー停止失敗", slog.Any("error", err))
+	}
+	if err := tracerProvider.Shutdown(shutdownCtx); err != nil {
+		logger.Error("トレース送信失敗", slog.Any("error", err))
+	}
 }
 
 // JWT認証ミドルウェア
@@ -182,9 +350,73 @@ func JWTMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 		// if claims["aud"] != "your-client-id" { ... }
 
 		// claimsをコンテキストにセット
-		c.Set("claims", claims)
-		spew.Dump(claims)
+		c.Set(appmiddleware.ContextKeyClaims, claims)
+		if sub, ok := claims["sub"].(string); ok {
+			c.Set(appmiddleware.ContextKeyUserID, sub)
+			// 以降のログと監査ログにユーザーIDを出力する
+			ctx := logging.ContextWithAttrs(c.Request().Context(), slog.String("user_id", sub))
+			ctx = usecase.ContextWithUserID(ctx, sub)
+			c.SetRequest(c.Request().WithContext(ctx))
+		}
+
+		// 認証済みのユーザーのテナントはクレームで決める
+		return tenantClaimMiddleware(next)(c)
+	}
+}
 
-		return next(c)
+// 管理者権限チェックミドルウェア（JWTMiddleware の後に使う）
+// Cognito のグループ（cognito:groups）に管理者グループが含まれているかを確認する
+func AdminMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	adminGroup := os.Getenv("ADMIN_GROUP")
+	if adminGroup == "" {
+		adminGroup = "admin"
+	}
+
+	return func(c echo.Context) error {
+		claims, ok := c.Get(appmiddleware.ContextKeyClaims).(jwt.MapClaims)
+		if !ok {
+			return echo.NewHTTPError(http.StatusUnauthorized, "クレーム取得失敗")
+		}
+
+		if hasGroup(claims, adminGroup) {
+			return next(c)
+		}
+		return echo.NewHTTPError(http.StatusForbidden, "管理者権限がありません")
+	}
+}
+
+// 営業担当の権限チェックミドルウェア（JWTMiddleware の後に使う）
+// Cognito のグループに営業担当のグループ（STAFFING_GROUP）か管理者グループが含まれているかを確認する
+func StaffingMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	staffingGroup := os.Getenv("STAFFING_GROUP")
+	if staffingGroup == "" {
+		staffingGroup = "sales"
+	}
+	adminGroup := os.Getenv("ADMIN_GROUP")
+	if adminGroup == "" {
+		adminGroup = "admin"
+	}
+
+	return func(c echo.Context) error {
+		claims, ok := c.Get(appmiddleware.ContextKeyClaims).(jwt.MapClaims)
+		if !ok {
+			return echo.NewHTTPError(http.StatusUnauthorized, "クレーム取得失敗")
+		}
+
+		if hasGroup(claims, staffingGroup) || hasGroup(claims, adminGroup) {
+			return next(c)
+		}
+		return echo.NewHTTPError(http.StatusForbidden, "営業担当の権限がありません")
+	}
+}
+
+// hasGroup は Cognito のグループ（cognito:groups）に group が含まれているかを返す
+func hasGroup(claims jwt.MapClaims, group string) bool {
+	groups, _ := claims["cognito:groups"].([]interface{})
+	for _, g := range groups {
+		if g == group {
+			return true
+		}
 	}
+	return false
 }
@@ -0,0 +1,19 @@
+-- +migrate Up
+CREATE TABLE audit_logs (
+  id SERIAL PRIMARY KEY,
+  actor_id VARCHAR(255) NOT NULL,
+  request_id VARCHAR(128) NOT NULL,
+  action VARCHAR(32) NOT NULL,
+  entity_type VARCHAR(64) NOT NULL,
+  entity_id VARCHAR(64) NOT NULL,
+  before JSONB,
+  after JSONB,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_audit_logs_entity ON audit_logs (entity_type, entity_id, created_at);
+
+CREATE INDEX idx_audit_logs_actor ON audit_logs (actor_id, created_at);
+
+-- +migrate Down
+DROP TABLE audit_logs;
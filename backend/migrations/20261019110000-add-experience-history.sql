@@ -0,0 +1,38 @@
+-- +migrate Up
+ALTER TABLE experiences
+  ADD COLUMN created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  ADD COLUMN updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX idx_experiences_deleted_at ON experiences (deleted_at);
+
+CREATE TABLE experience_revisions (
+  id SERIAL PRIMARY KEY,
+  experience_id INTEGER NOT NULL REFERENCES experiences (id),
+  revision INTEGER NOT NULL,
+  snapshot JSONB NOT NULL,
+  actor_id VARCHAR(255) NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE (experience_id, revision)
+);
+
+-- 既存の業務経歴を最初のリビジョンとして登録
+INSERT INTO
+  experience_revisions (experience_id, revision, snapshot, actor_id)
+SELECT
+  id,
+  1,
+  jsonb_build_object('id', id, 'title', title),
+  ''
+FROM
+  experiences;
+
+-- +migrate Down
+DROP TABLE experience_revisions;
+
+DROP INDEX idx_experiences_deleted_at;
+
+ALTER TABLE experiences
+  DROP COLUMN created_at,
+  DROP COLUMN updated_at,
+  DROP COLUMN deleted_at;
@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE experiences
+  ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+-- +migrate Down
+ALTER TABLE experiences
+  DROP COLUMN version;
@@ -0,0 +1,11 @@
+-- +migrate Up
+ALTER TABLE experiences
+  ADD COLUMN start_month DATE,
+  ADD COLUMN end_month DATE,
+  ADD COLUMN team_size INTEGER;
+
+-- +migrate Down
+ALTER TABLE experiences
+  DROP COLUMN start_month,
+  DROP COLUMN end_month,
+  DROP COLUMN team_size;
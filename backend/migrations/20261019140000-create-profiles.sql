@@ -0,0 +1,43 @@
+-- +migrate Up
+CREATE TABLE profiles (
+  user_id VARCHAR(255) PRIMARY KEY,
+  display_name VARCHAR(100) NOT NULL DEFAULT '',
+  headline VARCHAR(200) NOT NULL DEFAULT '',
+  self_pr TEXT NOT NULL DEFAULT '',
+  nearest_station VARCHAR(100) NOT NULL DEFAULT '',
+  work_style VARCHAR(20) NOT NULL DEFAULT '',
+  preferred_locations JSONB NOT NULL DEFAULT '[]',
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE profile_certifications (
+  id SERIAL PRIMARY KEY,
+  user_id VARCHAR(255) NOT NULL REFERENCES profiles (user_id) ON DELETE CASCADE,
+  position INTEGER NOT NULL,
+  name VARCHAR(200) NOT NULL,
+  issuer VARCHAR(200) NOT NULL DEFAULT '',
+  acquired_month DATE
+);
+
+CREATE INDEX idx_profile_certifications_user_id ON profile_certifications (user_id, position);
+
+CREATE TABLE profile_educations (
+  id SERIAL PRIMARY KEY,
+  user_id VARCHAR(255) NOT NULL REFERENCES profiles (user_id) ON DELETE CASCADE,
+  position INTEGER NOT NULL,
+  school_name VARCHAR(200) NOT NULL,
+  faculty VARCHAR(200) NOT NULL DEFAULT '',
+  degree VARCHAR(100) NOT NULL DEFAULT '',
+  start_month DATE,
+  end_month DATE
+);
+
+CREATE INDEX idx_profile_educations_user_id ON profile_educations (user_id, position);
+
+-- +migrate Down
+DROP TABLE profile_educations;
+
+DROP TABLE profile_certifications;
+
+DROP TABLE profiles;
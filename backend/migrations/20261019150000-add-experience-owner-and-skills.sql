@@ -0,0 +1,23 @@
+-- +migrate Up
+ALTER TABLE experiences
+  ADD COLUMN user_id VARCHAR(255) NOT NULL DEFAULT '';
+
+CREATE INDEX idx_experiences_user_id ON experiences (user_id);
+
+CREATE TABLE experience_skills (
+  id SERIAL PRIMARY KEY,
+  experience_id INTEGER NOT NULL REFERENCES experiences (id) ON DELETE CASCADE,
+  position INTEGER NOT NULL,
+  category VARCHAR(20) NOT NULL,
+  name VARCHAR(100) NOT NULL
+);
+
+CREATE INDEX idx_experience_skills_experience_id ON experience_skills (experience_id, position);
+
+-- +migrate Down
+DROP TABLE experience_skills;
+
+DROP INDEX idx_experiences_user_id;
+
+ALTER TABLE experiences
+  DROP COLUMN user_id;
@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE experiences
+  ADD COLUMN phases JSONB NOT NULL DEFAULT '[]';
+
+-- +migrate Down
+ALTER TABLE experiences
+  DROP COLUMN phases;
@@ -0,0 +1,89 @@
+-- +migrate Up
+CREATE TABLE languages (
+  id SERIAL PRIMARY KEY,
+  name VARCHAR(100) NOT NULL UNIQUE,
+  icon_url VARCHAR(255) NOT NULL DEFAULT '',
+  aliases JSONB NOT NULL DEFAULT '[]',
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE tools (
+  id SERIAL PRIMARY KEY,
+  name VARCHAR(100) NOT NULL UNIQUE,
+  icon_url VARCHAR(255) NOT NULL DEFAULT '',
+  aliases JSONB NOT NULL DEFAULT '[]',
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO languages (name, aliases) VALUES
+  ('Go', '["golang"]'),
+  ('Java', '[]'),
+  ('Kotlin', '[]'),
+  ('Scala', '[]'),
+  ('Python', '["python3"]'),
+  ('Ruby', '[]'),
+  ('PHP', '[]'),
+  ('JavaScript', '["JS", "ECMAScript"]'),
+  ('TypeScript', '["TS"]'),
+  ('C', '[]'),
+  ('C++', '["cpp"]'),
+  ('C#', '["csharp"]'),
+  ('Rust', '[]'),
+  ('Swift', '[]'),
+  ('Objective-C', '["ObjC"]'),
+  ('Dart', '[]'),
+  ('Perl', '[]'),
+  ('COBOL', '[]'),
+  ('VB.NET', '["Visual Basic .NET"]'),
+  ('SQL', '[]'),
+  ('Shell', '["bash", "shell script", "シェルスクリプト"]'),
+  ('HTML', '["HTML5"]'),
+  ('CSS', '["CSS3", "Sass", "SCSS"]');
+
+INSERT INTO tools (name, aliases) VALUES
+  ('AWS', '["Amazon Web Services"]'),
+  ('Google Cloud', '["GCP", "Google Cloud Platform"]'),
+  ('Azure', '["Microsoft Azure"]'),
+  ('Docker', '[]'),
+  ('Kubernetes', '["k8s"]'),
+  ('Terraform', '[]'),
+  ('GitHub Actions', '[]'),
+  ('Jenkins', '[]'),
+  ('Git', '[]'),
+  ('Linux', '[]'),
+  ('MySQL', '[]'),
+  ('PostgreSQL', '["Postgres"]'),
+  ('Oracle Database', '["Oracle"]'),
+  ('SQL Server', '["MSSQL", "Microsoft SQL Server"]'),
+  ('Redis', '[]'),
+  ('MongoDB', '[]'),
+  ('Elasticsearch', '[]'),
+  ('Kafka', '["Apache Kafka"]'),
+  ('Nginx', '[]'),
+  ('Spring Boot', '["Spring"]'),
+  ('Ruby on Rails', '["Rails", "RoR"]'),
+  ('Laravel', '[]'),
+  ('Django', '[]'),
+  ('FastAPI', '[]'),
+  ('Echo', '[]'),
+  ('Gin', '[]'),
+  ('.NET', '["dotnet", ".NET Framework", ".NET Core"]'),
+  ('Node.js', '["node"]'),
+  ('Express', '["Express.js"]'),
+  ('React', '["React.js", "ReactJS"]'),
+  ('Next.js', '[]'),
+  ('Vue.js', '["Vue", "VueJS"]'),
+  ('Nuxt.js', '["Nuxt"]'),
+  ('Angular', '[]'),
+  ('Flutter', '[]'),
+  ('React Native', '[]'),
+  ('GraphQL', '[]'),
+  ('OpenTelemetry', '[]'),
+  ('Datadog', '[]');
+
+-- +migrate Down
+DROP TABLE tools;
+
+DROP TABLE languages;
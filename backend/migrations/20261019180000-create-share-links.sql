@@ -0,0 +1,22 @@
+-- +migrate Up
+CREATE TABLE share_links (
+  id SERIAL PRIMARY KEY,
+  user_id VARCHAR(255) NOT NULL,
+  label VARCHAR(100) NOT NULL DEFAULT '',
+  -- トークンそのものは保存せず SHA-256 のハッシュ値（16進数）で照合する
+  token_hash CHAR(64) NOT NULL UNIQUE,
+  password_hash VARCHAR(255) NOT NULL DEFAULT '',
+  sections JSONB NOT NULL DEFAULT '[]',
+  hide_client_names BOOLEAN NOT NULL DEFAULT FALSE,
+  hide_period BOOLEAN NOT NULL DEFAULT FALSE,
+  expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  revoked_at TIMESTAMP WITH TIME ZONE,
+  view_count INTEGER NOT NULL DEFAULT 0,
+  last_viewed_at TIMESTAMP WITH TIME ZONE,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_share_links_user_id ON share_links (user_id, created_at);
+
+-- +migrate Down
+DROP TABLE share_links;
@@ -0,0 +1,21 @@
+-- +migrate Up
+ALTER TABLE experiences
+  ADD COLUMN client_name VARCHAR(100) NOT NULL DEFAULT '',
+  ADD COLUMN client_alias VARCHAR(100) NOT NULL DEFAULT '';
+
+ALTER TABLE profiles
+  ADD COLUMN initials VARCHAR(10) NOT NULL DEFAULT '';
+
+ALTER TABLE share_links
+  ADD COLUMN anonymize BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +migrate Down
+ALTER TABLE share_links
+  DROP COLUMN anonymize;
+
+ALTER TABLE profiles
+  DROP COLUMN initials;
+
+ALTER TABLE experiences
+  DROP COLUMN client_alias,
+  DROP COLUMN client_name;
@@ -0,0 +1,31 @@
+-- +migrate Up
+CREATE TABLE organizations (
+  id SERIAL PRIMARY KEY,
+  name VARCHAR(100) NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE teams (
+  id SERIAL PRIMARY KEY,
+  organization_id INTEGER NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+  name VARCHAR(100) NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_teams_organization_id ON teams (organization_id);
+
+-- ユーザー（JWT の sub）は複数のチームに所属できる。manager はチームのメンバーの業務経歴を閲覧できる
+CREATE TABLE team_members (
+  team_id INTEGER NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+  user_id VARCHAR(255) NOT NULL,
+  role VARCHAR(20) NOT NULL DEFAULT 'member',
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (team_id, user_id)
+);
+
+CREATE INDEX idx_team_members_user_id ON team_members (user_id);
+
+-- +migrate Down
+DROP TABLE team_members;
+DROP TABLE teams;
+DROP TABLE organizations;
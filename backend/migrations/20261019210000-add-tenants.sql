@@ -0,0 +1,212 @@
+-- +migrate Up
+CREATE TABLE tenants (
+  -- サブドメインにも使う識別子（英小文字・数字・ハイフン）
+  id VARCHAR(63) PRIMARY KEY,
+  name VARCHAR(100) NOT NULL,
+  inherit_global_catalog BOOLEAN NOT NULL DEFAULT TRUE,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 既存のデータは全て既定のテナントのものとする
+INSERT INTO tenants (id, name) VALUES ('default', 'default');
+
+-- 登録時は必ずテナントを指定させるため、既存の行を埋めた後に既定値を外す
+ALTER TABLE experiences
+  ADD COLUMN tenant_id VARCHAR(63) NOT NULL DEFAULT 'default' REFERENCES tenants (id);
+
+ALTER TABLE experiences
+  ALTER COLUMN tenant_id DROP DEFAULT;
+
+ALTER TABLE experience_skills
+  ADD COLUMN tenant_id VARCHAR(63) NOT NULL DEFAULT 'default' REFERENCES tenants (id);
+
+ALTER TABLE experience_skills
+  ALTER COLUMN tenant_id DROP DEFAULT;
+
+ALTER TABLE experience_revisions
+  ADD COLUMN tenant_id VARCHAR(63) NOT NULL DEFAULT 'default' REFERENCES tenants (id);
+
+ALTER TABLE experience_revisions
+  ALTER COLUMN tenant_id DROP DEFAULT;
+
+ALTER TABLE audit_logs
+  ADD COLUMN tenant_id VARCHAR(63) NOT NULL DEFAULT 'default' REFERENCES tenants (id);
+
+ALTER TABLE audit_logs
+  ALTER COLUMN tenant_id DROP DEFAULT;
+
+ALTER TABLE profiles
+  ADD COLUMN tenant_id VARCHAR(63) NOT NULL DEFAULT 'default' REFERENCES tenants (id);
+
+ALTER TABLE profiles
+  ALTER COLUMN tenant_id DROP DEFAULT;
+
+ALTER TABLE profile_certifications
+  ADD COLUMN tenant_id VARCHAR(63) NOT NULL DEFAULT 'default' REFERENCES tenants (id);
+
+ALTER TABLE profile_certifications
+  ALTER COLUMN tenant_id DROP DEFAULT;
+
+ALTER TABLE profile_educations
+  ADD COLUMN tenant_id VARCHAR(63) NOT NULL DEFAULT 'default' REFERENCES tenants (id);
+
+ALTER TABLE profile_educations
+  ALTER COLUMN tenant_id DROP DEFAULT;
+
+ALTER TABLE share_links
+  ADD COLUMN tenant_id VARCHAR(63) NOT NULL DEFAULT 'default' REFERENCES tenants (id);
+
+ALTER TABLE share_links
+  ALTER COLUMN tenant_id DROP DEFAULT;
+
+ALTER TABLE organizations
+  ADD COLUMN tenant_id VARCHAR(63) NOT NULL DEFAULT 'default' REFERENCES tenants (id);
+
+ALTER TABLE organizations
+  ALTER COLUMN tenant_id DROP DEFAULT;
+
+ALTER TABLE teams
+  ADD COLUMN tenant_id VARCHAR(63) NOT NULL DEFAULT 'default' REFERENCES tenants (id);
+
+ALTER TABLE teams
+  ALTER COLUMN tenant_id DROP DEFAULT;
+
+ALTER TABLE team_members
+  ADD COLUMN tenant_id VARCHAR(63) NOT NULL DEFAULT 'default' REFERENCES tenants (id);
+
+ALTER TABLE team_members
+  ALTER COLUMN tenant_id DROP DEFAULT;
+
+DROP INDEX idx_experiences_user_id;
+
+CREATE INDEX idx_experiences_tenant_id_user_id ON experiences (tenant_id, user_id);
+
+CREATE INDEX idx_audit_logs_tenant_id ON audit_logs (tenant_id, created_at);
+
+CREATE INDEX idx_share_links_tenant_id ON share_links (tenant_id);
+
+CREATE INDEX idx_organizations_tenant_id ON organizations (tenant_id);
+
+CREATE INDEX idx_team_members_tenant_id_user_id ON team_members (tenant_id, user_id);
+
+-- 同じユーザーが別のテナントのプロフィールを上書きしないよう、プロフィールはテナントとユーザーの組で一意にする
+ALTER TABLE profile_certifications
+  DROP CONSTRAINT profile_certifications_user_id_fkey;
+
+ALTER TABLE profile_educations
+  DROP CONSTRAINT profile_educations_user_id_fkey;
+
+ALTER TABLE profiles
+  DROP CONSTRAINT profiles_pkey;
+
+ALTER TABLE profiles
+  ADD PRIMARY KEY (tenant_id, user_id);
+
+ALTER TABLE profile_certifications
+  ADD CONSTRAINT profile_certifications_tenant_id_user_id_fkey FOREIGN KEY (tenant_id, user_id) REFERENCES profiles (tenant_id, user_id) ON DELETE CASCADE;
+
+ALTER TABLE profile_educations
+  ADD CONSTRAINT profile_educations_tenant_id_user_id_fkey FOREIGN KEY (tenant_id, user_id) REFERENCES profiles (tenant_id, user_id) ON DELETE CASCADE;
+
+-- カタログの既存の項目は全テナント共通（tenant_id が NULL）とし、名前は共通の項目・テナントの項目のそれぞれで一意にする
+ALTER TABLE languages
+  ADD COLUMN tenant_id VARCHAR(63) REFERENCES tenants (id),
+  DROP CONSTRAINT languages_name_key;
+
+CREATE UNIQUE INDEX uq_languages_global_name ON languages (name) WHERE tenant_id IS NULL;
+
+CREATE UNIQUE INDEX uq_languages_tenant_id_name ON languages (tenant_id, name) WHERE tenant_id IS NOT NULL;
+
+ALTER TABLE tools
+  ADD COLUMN tenant_id VARCHAR(63) REFERENCES tenants (id),
+  DROP CONSTRAINT tools_name_key;
+
+CREATE UNIQUE INDEX uq_tools_global_name ON tools (name) WHERE tenant_id IS NULL;
+
+CREATE UNIQUE INDEX uq_tools_tenant_id_name ON tools (tenant_id, name) WHERE tenant_id IS NOT NULL;
+
+-- +migrate Down
+DELETE FROM tools WHERE tenant_id IS NOT NULL;
+
+DROP INDEX uq_tools_tenant_id_name;
+
+DROP INDEX uq_tools_global_name;
+
+ALTER TABLE tools
+  DROP COLUMN tenant_id,
+  ADD CONSTRAINT tools_name_key UNIQUE (name);
+
+DELETE FROM languages WHERE tenant_id IS NOT NULL;
+
+DROP INDEX uq_languages_tenant_id_name;
+
+DROP INDEX uq_languages_global_name;
+
+ALTER TABLE languages
+  DROP COLUMN tenant_id,
+  ADD CONSTRAINT languages_name_key UNIQUE (name);
+
+ALTER TABLE profile_educations
+  DROP CONSTRAINT profile_educations_tenant_id_user_id_fkey;
+
+ALTER TABLE profile_certifications
+  DROP CONSTRAINT profile_certifications_tenant_id_user_id_fkey;
+
+ALTER TABLE profiles
+  DROP CONSTRAINT profiles_pkey;
+
+ALTER TABLE profiles
+  ADD PRIMARY KEY (user_id);
+
+ALTER TABLE profile_certifications
+  ADD CONSTRAINT profile_certifications_user_id_fkey FOREIGN KEY (user_id) REFERENCES profiles (user_id) ON DELETE CASCADE;
+
+ALTER TABLE profile_educations
+  ADD CONSTRAINT profile_educations_user_id_fkey FOREIGN KEY (user_id) REFERENCES profiles (user_id) ON DELETE CASCADE;
+
+DROP INDEX idx_team_members_tenant_id_user_id;
+
+DROP INDEX idx_organizations_tenant_id;
+
+DROP INDEX idx_share_links_tenant_id;
+
+DROP INDEX idx_audit_logs_tenant_id;
+
+DROP INDEX idx_experiences_tenant_id_user_id;
+
+CREATE INDEX idx_experiences_user_id ON experiences (user_id);
+
+ALTER TABLE team_members
+  DROP COLUMN tenant_id;
+
+ALTER TABLE teams
+  DROP COLUMN tenant_id;
+
+ALTER TABLE organizations
+  DROP COLUMN tenant_id;
+
+ALTER TABLE share_links
+  DROP COLUMN tenant_id;
+
+ALTER TABLE profile_educations
+  DROP COLUMN tenant_id;
+
+ALTER TABLE profile_certifications
+  DROP COLUMN tenant_id;
+
+ALTER TABLE profiles
+  DROP COLUMN tenant_id;
+
+ALTER TABLE audit_logs
+  DROP COLUMN tenant_id;
+
+ALTER TABLE experience_revisions
+  DROP COLUMN tenant_id;
+
+ALTER TABLE experience_skills
+  DROP COLUMN tenant_id;
+
+ALTER TABLE experiences
+  DROP COLUMN tenant_id;
+
+DROP TABLE tenants;
@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE experiences
+  ADD COLUMN industry VARCHAR(50) NOT NULL DEFAULT '';
+
+-- +migrate Down
+ALTER TABLE experiences
+  DROP COLUMN industry;
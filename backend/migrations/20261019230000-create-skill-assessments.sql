@@ -0,0 +1,37 @@
+-- +migrate Up
+-- 自己評価はユーザーとスキルの組で1つ。スキル名の大文字・小文字は区別しない（name_key は小文字にした名前）
+CREATE TABLE skill_assessments (
+  id SERIAL PRIMARY KEY,
+  tenant_id VARCHAR(63) NOT NULL REFERENCES tenants (id),
+  user_id VARCHAR(255) NOT NULL,
+  category VARCHAR(20) NOT NULL,
+  name VARCHAR(100) NOT NULL,
+  name_key VARCHAR(100) NOT NULL,
+  level SMALLINT NOT NULL CHECK (level BETWEEN 1 AND 5),
+  comment VARCHAR(500) NOT NULL DEFAULT '',
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE (tenant_id, user_id, category, name_key)
+);
+
+-- 推薦は推薦したユーザーとスキルの組で1つ。同じ組織のユーザーのみ推薦できる（アプリケーションで検証する）
+CREATE TABLE skill_endorsements (
+  id SERIAL PRIMARY KEY,
+  tenant_id VARCHAR(63) NOT NULL REFERENCES tenants (id),
+  user_id VARCHAR(255) NOT NULL,
+  endorser_id VARCHAR(255) NOT NULL,
+  category VARCHAR(20) NOT NULL,
+  name VARCHAR(100) NOT NULL,
+  name_key VARCHAR(100) NOT NULL,
+  level SMALLINT NOT NULL CHECK (level BETWEEN 1 AND 5),
+  comment VARCHAR(500) NOT NULL DEFAULT '',
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE (tenant_id, user_id, endorser_id, category, name_key),
+  CHECK (user_id <> endorser_id)
+);
+
+CREATE INDEX idx_skill_endorsements_tenant_id_user_id ON skill_endorsements (tenant_id, user_id);
+
+-- +migrate Down
+DROP TABLE skill_endorsements;
+DROP TABLE skill_assessments;
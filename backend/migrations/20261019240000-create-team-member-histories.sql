@@ -0,0 +1,23 @@
+-- +migrate Up
+-- チームに同じ役割で所属していた期間。所属・役割の変更・所属の解除のたびに区切る（ended_at が NULL は現在の役割）
+-- チームから外れた後もキャリアの年表に残すため、team_members とは別に保存する
+CREATE TABLE team_member_histories (
+  id SERIAL PRIMARY KEY,
+  tenant_id VARCHAR(63) NOT NULL REFERENCES tenants (id),
+  team_id INTEGER NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+  user_id VARCHAR(255) NOT NULL,
+  role VARCHAR(20) NOT NULL,
+  started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  ended_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_team_member_histories_tenant_id_user_id ON team_member_histories (tenant_id, user_id);
+
+CREATE UNIQUE INDEX uq_team_member_histories_current ON team_member_histories (team_id, user_id) WHERE ended_at IS NULL;
+
+-- 既存の所属は所属した日時から現在の役割で所属しているものとする
+INSERT INTO team_member_histories (tenant_id, team_id, user_id, role, started_at)
+SELECT tenant_id, team_id, user_id, role, created_at FROM team_members;
+
+-- +migrate Down
+DROP TABLE team_member_histories;
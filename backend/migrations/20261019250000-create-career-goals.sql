@@ -0,0 +1,41 @@
+-- +migrate Up
+-- キャリアの目標。種類が skill の場合だけ目標とするスキル（skill_category / skill_name）と習熟度を持つ
+CREATE TABLE career_goals (
+  id SERIAL PRIMARY KEY,
+  tenant_id VARCHAR(63) NOT NULL REFERENCES tenants (id),
+  user_id VARCHAR(255) NOT NULL,
+  kind VARCHAR(20) NOT NULL CHECK (kind IN ('role', 'skill')),
+  title VARCHAR(100) NOT NULL,
+  skill_category VARCHAR(20),
+  skill_name VARCHAR(100),
+  target_level SMALLINT CHECK (target_level BETWEEN 1 AND 5),
+  target_month DATE NOT NULL,
+  status VARCHAR(20) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'achieved', 'abandoned')),
+  note VARCHAR(1000) NOT NULL DEFAULT '',
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CHECK ((kind = 'skill') = (skill_name IS NOT NULL))
+);
+
+CREATE INDEX idx_career_goals_tenant_id_user_id ON career_goals (tenant_id, user_id);
+
+-- 目標のための学習活動（講座の受講、取得を目指す資格、個人開発）。目標を削除すると合わせて削除する
+CREATE TABLE learning_activities (
+  id SERIAL PRIMARY KEY,
+  tenant_id VARCHAR(63) NOT NULL REFERENCES tenants (id),
+  goal_id INTEGER NOT NULL REFERENCES career_goals (id) ON DELETE CASCADE,
+  kind VARCHAR(20) NOT NULL CHECK (kind IN ('course', 'certification', 'side_project')),
+  title VARCHAR(200) NOT NULL,
+  url VARCHAR(500) NOT NULL DEFAULT '',
+  status VARCHAR(20) NOT NULL CHECK (status IN ('planned', 'in_progress', 'completed')),
+  progress SMALLINT NOT NULL DEFAULT 0 CHECK (progress BETWEEN 0 AND 100),
+  due_month DATE,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_learning_activities_goal_id ON learning_activities (goal_id);
+
+-- +migrate Down
+DROP TABLE learning_activities;
+DROP TABLE career_goals;
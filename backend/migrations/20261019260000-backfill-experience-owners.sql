@@ -0,0 +1,31 @@
+-- +migrate Up
+-- 登録者（user_id）を記録する前の業務経歴は user_id が空のため、登録者を含め誰からも参照できない
+-- リビジョンを最初に保存したユーザーを登録者として補完する
+UPDATE
+  experiences
+SET
+  user_id = first_revisions.actor_id
+FROM
+  (
+    SELECT DISTINCT ON (experience_id)
+      experience_id,
+      actor_id
+    FROM
+      experience_revisions
+    WHERE
+      actor_id <> ''
+    ORDER BY
+      experience_id,
+      revision
+  ) AS first_revisions
+WHERE
+  experiences.id = first_revisions.experience_id
+  AND experiences.user_id = '';
+
+-- リビジョンの記録より前に登録した業務経歴（サンプルデータを含む）は登録者が分からないため補完しない
+-- 残った業務経歴は、登録者の JWT の sub を確認して手動で設定すること
+--   SELECT id, title FROM experiences WHERE user_id = '';
+--   UPDATE experiences SET user_id = '<sub>' WHERE id IN (...);
+
+-- +migrate Down
+-- 補完した登録者は元の値（空）と区別できないため戻さない
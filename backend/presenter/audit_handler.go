@@ -0,0 +1,75 @@
+package presenter
+
+import (
+	"encoding/json"
+	"net/http"
+	"stackies/backend/usecase"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type auditHandler struct {
+	auditUsecase usecase.AuditUsecase
+}
+
+type AuditLogResponse struct {
+	ID         int             `json:"id"`
+	ActorID    string          `json:"actor_id"`
+	RequestID  string          `json:"request_id"`
+	Action     string          `json:"action"`
+	EntityType string          `json:"entity_type"`
+	EntityID   string          `json:"entity_id"`
+	Before     json.RawMessage `json:"before"`
+	After      json.RawMessage `json:"after"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+func (a *AuditLogResponse) ConvertToDto(auditLog usecase.AuditLogDto) {
+	a.ID = auditLog.ID
+	a.ActorID = auditLog.ActorID
+	a.RequestID = auditLog.RequestID
+	a.Action = auditLog.Action
+	a.EntityType = auditLog.EntityType
+	a.EntityID = auditLog.EntityID
+	a.Before = auditLog.Before
+	a.After = auditLog.After
+	a.CreatedAt = auditLog.CreatedAt
+}
+
+// Search implements AuditHandler.
+// GET /admin/audit?entity_type=experience&entity_id=1&actor_id=xxx&action=update&from=2025-01-01T00:00:00Z&to=...&limit=100&offset=0
+func (a *auditHandler) Search(c echo.Context) error {
+	var query usecase.AuditLogQuery
+	if err := echo.QueryParamsBinder(c).
+		String("actor_id", &query.ActorID).
+		String("entity_type", &query.EntityType).
+		String("entity_id", &query.EntityID).
+		String("action", &query.Action).
+		Time("from", &query.From, time.RFC3339).
+		Time("to", &query.To, time.RFC3339).
+		Int("limit", &query.Limit).
+		Int("offset", &query.Offset).
+		BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+
+	auditLogs, err := a.auditUsecase.Search(c.Request().Context(), query)
+	if err != nil {
+		return errorJSON(c, http.StatusInternalServerError, err)
+	}
+
+	response := make([]AuditLogResponse, len(auditLogs))
+	for i, auditLog := range auditLogs {
+		response[i].ConvertToDto(auditLog)
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+type AuditHandler interface {
+	Search(c echo.Context) error
+}
+
+func NewAuditHandler(auditUsecase usecase.AuditUsecase) AuditHandler {
+	return &auditHandler{auditUsecase: auditUsecase}
+}
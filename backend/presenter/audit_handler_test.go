@@ -0,0 +1,100 @@
+package presenter_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"stackies/backend/presenter"
+	"stackies/backend/usecase"
+	mock_usecase "stackies/backend/usecase/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditHandler_Search(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		setupMock      func(mock *mock_usecase.MockAuditUsecase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:  "正常系: 監査ログを検索できる",
+			query: "?entity_type=experience&entity_id=1&from=2025-05-01T00:00:00Z&limit=10",
+			setupMock: func(mock *mock_usecase.MockAuditUsecase) {
+				mock.EXPECT().Search(gomock.Any(), usecase.AuditLogQuery{
+					EntityType: "experience",
+					EntityID:   "1",
+					From:       time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC),
+					Limit:      10,
+				}).Return([]usecase.AuditLogDto{
+					{
+						ID:         1,
+						ActorID:    "user-1",
+						RequestID:  "req-1",
+						Action:     "create",
+						EntityType: "experience",
+						EntityID:   "1",
+						After:      json.RawMessage(`{"id":1,"title":"Go"}`),
+						CreatedAt:  time.Date(2025, 5, 14, 12, 0, 0, 0, time.UTC),
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `[{"id":1,"actor_id":"user-1","request_id":"req-1","action":"create","entity_type":"experience",` +
+				`"entity_id":"1","before":null,"after":{"id":1,"title":"Go"},"created_at":"2025-05-14T12:00:00Z"}]`,
+		},
+		{
+			name:           "異常系: 日時の形式が不正",
+			query:          "?from=2025-05-01",
+			setupMock:      func(mock *mock_usecase.MockAuditUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "異常系: 監査ログの検索に失敗",
+			query: "",
+			setupMock: func(mock *mock_usecase.MockAuditUsecase) {
+				mock.EXPECT().Search(gomock.Any(), usecase.AuditLogQuery{}).Return(nil, errors.New("データベースエラー"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   `{"message":"データベースエラー"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Echoのインスタンスを作成
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/admin/audit"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			// モックの設定
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockAuditUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			// ハンドラーの作成
+			handler := presenter.NewAuditHandler(mockUsecase)
+
+			// テスト対象の実行
+			err := handler.Search(c)
+
+			// アサーション
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
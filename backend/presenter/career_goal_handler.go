@@ -0,0 +1,323 @@
+package presenter
+
+import (
+	"net/http"
+	"stackies/backend/usecase"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type careerGoalHandler struct {
+	careerGoalUsecase usecase.CareerGoalUsecase
+}
+
+// CareerGoalRequest はキャリアの目標の登録・更新のリクエストです
+type CareerGoalRequest struct {
+	// Kind は role（目標とする役割）または skill（習得したいスキル）です
+	Kind  string `json:"kind"`
+	Title string `json:"title"`
+	// Skill・TargetLevel は種類が skill の場合に指定します
+	Skill       *SkillRequest `json:"skill,omitempty"`
+	TargetLevel int           `json:"target_level,omitempty"`
+	// TargetMonth は達成予定月で "2027-03" の形式です
+	TargetMonth string `json:"target_month"`
+	// Status は更新の場合だけ指定できます（active / achieved / abandoned、省略した場合は変更しない）
+	Status string `json:"status,omitempty"`
+	Note   string `json:"note,omitempty"`
+}
+
+func (r *CareerGoalRequest) ConvertToInput() (usecase.CareerGoalInput, error) {
+	targetMonth, err := parseMonth(r.TargetMonth)
+	if err != nil {
+		return usecase.CareerGoalInput{}, err
+	}
+	input := usecase.CareerGoalInput{
+		Kind:        r.Kind,
+		Title:       r.Title,
+		TargetLevel: r.TargetLevel,
+		TargetMonth: targetMonth,
+		Status:      r.Status,
+		Note:        r.Note,
+	}
+	if r.Skill != nil {
+		input.SkillCategory = r.Skill.Category
+		input.SkillName = r.Skill.Name
+	}
+	return input, nil
+}
+
+// LearningActivityRequest は学習活動の登録・更新のリクエストです
+type LearningActivityRequest struct {
+	// Kind は course / certification / side_project のいずれかです
+	Kind  string `json:"kind"`
+	Title string `json:"title"`
+	URL   string `json:"url,omitempty"`
+	// Status は planned / in_progress / completed のいずれかです
+	Status   string `json:"status"`
+	Progress int    `json:"progress,omitempty"`
+	// DueMonth は完了予定月で "2026-12" の形式です
+	DueMonth string `json:"due_month,omitempty"`
+}
+
+func (r *LearningActivityRequest) ConvertToInput() (usecase.LearningActivityInput, error) {
+	dueMonth, err := parseMonth(r.DueMonth)
+	if err != nil {
+		return usecase.LearningActivityInput{}, err
+	}
+	return usecase.LearningActivityInput{
+		Kind:     r.Kind,
+		Title:    r.Title,
+		URL:      r.URL,
+		Status:   r.Status,
+		Progress: r.Progress,
+		DueMonth: dueMonth,
+	}, nil
+}
+
+// CareerGoalResponse はキャリアの目標です
+type CareerGoalResponse struct {
+	ID    int            `json:"id"`
+	Kind  string         `json:"kind"`
+	Title string         `json:"title"`
+	Skill *SkillResponse `json:"skill,omitempty"`
+	// TargetLevel・CurrentLevel は目標とする習熟度と現在の自己評価です（未設定・未評価の場合は省略）
+	TargetLevel  int                        `json:"target_level,omitempty"`
+	CurrentLevel int                        `json:"current_level,omitempty"`
+	TargetMonth  string                     `json:"target_month"`
+	Status       string                     `json:"status"`
+	Note         string                     `json:"note"`
+	Progress     int                        `json:"progress"`
+	Overdue      bool                       `json:"overdue"`
+	Activities   []LearningActivityResponse `json:"activities"`
+	CreatedAt    time.Time                  `json:"created_at"`
+	UpdatedAt    time.Time                  `json:"updated_at"`
+}
+
+type LearningActivityResponse struct {
+	ID        int       `json:"id"`
+	Kind      string    `json:"kind"`
+	Title     string    `json:"title"`
+	URL       string    `json:"url,omitempty"`
+	Status    string    `json:"status"`
+	Progress  int       `json:"progress"`
+	DueMonth  string    `json:"due_month,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// MemberCareerGoalsResponse はチームのメンバーの目標です
+type MemberCareerGoalsResponse struct {
+	Member MemberResponse       `json:"member"`
+	Goals  []CareerGoalResponse `json:"goals"`
+}
+
+func (r *CareerGoalResponse) ConvertToDto(goal usecase.CareerGoalDto) {
+	r.ID = goal.ID
+	r.Kind = goal.Kind
+	r.Title = goal.Title
+	if goal.SkillName != "" {
+		r.Skill = &SkillResponse{Category: goal.SkillCategory, Name: goal.SkillName}
+	}
+	r.TargetLevel = goal.TargetLevel
+	r.CurrentLevel = goal.CurrentLevel
+	r.TargetMonth = formatMonth(&goal.TargetMonth)
+	r.Status = goal.Status
+	r.Note = goal.Note
+	r.Progress = goal.Progress
+	r.Overdue = goal.Overdue
+	r.Activities = make([]LearningActivityResponse, len(goal.Activities))
+	for i, activity := range goal.Activities {
+		r.Activities[i] = LearningActivityResponse{
+			ID:        activity.ID,
+			Kind:      activity.Kind,
+			Title:     activity.Title,
+			URL:       activity.URL,
+			Status:    activity.Status,
+			Progress:  activity.Progress,
+			DueMonth:  formatMonth(activity.DueMonth),
+			CreatedAt: activity.CreatedAt,
+			UpdatedAt: activity.UpdatedAt,
+		}
+	}
+	r.CreatedAt = goal.CreatedAt
+	r.UpdatedAt = goal.UpdatedAt
+}
+
+func newCareerGoalResponses(goals []usecase.CareerGoalDto) []CareerGoalResponse {
+	responses := make([]CareerGoalResponse, len(goals))
+	for i, goal := range goals {
+		responses[i].ConvertToDto(goal)
+	}
+	return responses
+}
+
+// List implements CareerGoalHandler.
+func (h *careerGoalHandler) List(c echo.Context) error {
+	goals, err := h.careerGoalUsecase.ListMyGoals(c.Request().Context())
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+	return c.JSON(http.StatusOK, newCareerGoalResponses(goals))
+}
+
+// Create implements CareerGoalHandler.
+func (h *careerGoalHandler) Create(c echo.Context) error {
+	var request CareerGoalRequest
+	if err := c.Bind(&request); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	input, err := request.ConvertToInput()
+	if err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	goal, err := h.careerGoalUsecase.CreateGoal(c.Request().Context(), input)
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	var response CareerGoalResponse
+	response.ConvertToDto(goal)
+	return c.JSON(http.StatusCreated, response)
+}
+
+// Update implements CareerGoalHandler.
+func (h *careerGoalHandler) Update(c echo.Context) error {
+	var id int
+	if err := echo.PathParamsBinder(c).MustInt("id", &id).BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	var request CareerGoalRequest
+	if err := c.Bind(&request); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	input, err := request.ConvertToInput()
+	if err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	goal, err := h.careerGoalUsecase.UpdateGoal(c.Request().Context(), id, input)
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	var response CareerGoalResponse
+	response.ConvertToDto(goal)
+	return c.JSON(http.StatusOK, response)
+}
+
+// Delete implements CareerGoalHandler.
+func (h *careerGoalHandler) Delete(c echo.Context) error {
+	var id int
+	if err := echo.PathParamsBinder(c).MustInt("id", &id).BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	if err := h.careerGoalUsecase.DeleteGoal(c.Request().Context(), id); err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// AddActivity implements CareerGoalHandler.
+// 登録後の目標を返します
+func (h *careerGoalHandler) AddActivity(c echo.Context) error {
+	var id int
+	if err := echo.PathParamsBinder(c).MustInt("id", &id).BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	var request LearningActivityRequest
+	if err := c.Bind(&request); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	input, err := request.ConvertToInput()
+	if err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	goal, err := h.careerGoalUsecase.AddActivity(c.Request().Context(), id, input)
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	var response CareerGoalResponse
+	response.ConvertToDto(goal)
+	return c.JSON(http.StatusCreated, response)
+}
+
+// UpdateActivity implements CareerGoalHandler.
+// 更新後の目標を返します
+func (h *careerGoalHandler) UpdateActivity(c echo.Context) error {
+	var id, activityID int
+	if err := echo.PathParamsBinder(c).
+		MustInt("id", &id).
+		MustInt("activity_id", &activityID).
+		BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	var request LearningActivityRequest
+	if err := c.Bind(&request); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	input, err := request.ConvertToInput()
+	if err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	goal, err := h.careerGoalUsecase.UpdateActivity(c.Request().Context(), id, activityID, input)
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	var response CareerGoalResponse
+	response.ConvertToDto(goal)
+	return c.JSON(http.StatusOK, response)
+}
+
+// DeleteActivity implements CareerGoalHandler.
+func (h *careerGoalHandler) DeleteActivity(c echo.Context) error {
+	var id, activityID int
+	if err := echo.PathParamsBinder(c).
+		MustInt("id", &id).
+		MustInt("activity_id", &activityID).
+		BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	if err := h.careerGoalUsecase.DeleteActivity(c.Request().Context(), id, activityID); err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ListTeamGoals implements CareerGoalHandler.
+// チームのマネージャーだけが閲覧できます
+func (h *careerGoalHandler) ListTeamGoals(c echo.Context) error {
+	var teamID int
+	if err := echo.PathParamsBinder(c).MustInt("id", &teamID).BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	members, err := h.careerGoalUsecase.ListTeamGoals(c.Request().Context(), teamID)
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	response := make([]MemberCareerGoalsResponse, len(members))
+	for i, member := range members {
+		response[i] = MemberCareerGoalsResponse{
+			Member: MemberResponse{UserID: member.Member.UserID, DisplayName: member.Member.DisplayName},
+			Goals:  newCareerGoalResponses(member.Goals),
+		}
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+type CareerGoalHandler interface {
+	List(c echo.Context) error
+	Create(c echo.Context) error
+	Update(c echo.Context) error
+	Delete(c echo.Context) error
+	AddActivity(c echo.Context) error
+	UpdateActivity(c echo.Context) error
+	DeleteActivity(c echo.Context) error
+	ListTeamGoals(c echo.Context) error
+}
+
+func NewCareerGoalHandler(careerGoalUsecase usecase.CareerGoalUsecase) CareerGoalHandler {
+	return &careerGoalHandler{careerGoalUsecase: careerGoalUsecase}
+}
@@ -0,0 +1,482 @@
+package presenter_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"stackies/backend/presenter"
+	"stackies/backend/usecase"
+	mock_usecase "stackies/backend/usecase/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	goalCreatedAt = time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)
+	goalTarget    = time.Date(2027, time.March, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// newCareerGoalDto はテスト用の Go のスキルの目標です
+func newCareerGoalDto(activities ...usecase.LearningActivityDto) usecase.CareerGoalDto {
+	if activities == nil {
+		activities = []usecase.LearningActivityDto{}
+	}
+	return usecase.CareerGoalDto{
+		ID: 1, Kind: "skill", Title: "Go で設計できる", SkillCategory: "language", SkillName: "Go", TargetLevel: 4, CurrentLevel: 3,
+		TargetMonth: goalTarget, Status: "active", Progress: 40, Activities: activities,
+		CreatedAt: goalCreatedAt, UpdatedAt: goalCreatedAt,
+	}
+}
+
+const careerGoalJSON = `{"id":1,"kind":"skill","title":"Go で設計できる","skill":{"category":"language","name":"Go"},` +
+	`"target_level":4,"current_level":3,"target_month":"2027-03","status":"active","note":"","progress":40,"overdue":false,` +
+	`"activities":%s,"created_at":"2026-10-19T10:00:00Z","updated_at":"2026-10-19T10:00:00Z"}`
+
+func careerGoalBody(activities string) string {
+	return strings.Replace(careerGoalJSON, "%s", activities, 1)
+}
+
+func TestCareerGoalHandler_List(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(mock *mock_usecase.MockCareerGoalUsecase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "正常系: 目標を学習活動と進捗とともに返す",
+			setupMock: func(mock *mock_usecase.MockCareerGoalUsecase) {
+				due := time.Date(2026, time.December, 1, 0, 0, 0, 0, time.UTC)
+				mock.EXPECT().ListMyGoals(gomock.Any()).Return([]usecase.CareerGoalDto{newCareerGoalDto(usecase.LearningActivityDto{
+					ID: 5, Kind: "course", Title: "並行処理の講座", URL: "https://example.com/course", Status: "in_progress", Progress: 40, DueMonth: &due,
+					CreatedAt: goalCreatedAt, UpdatedAt: goalCreatedAt,
+				})}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `[` + careerGoalBody(`[{"id":5,"kind":"course","title":"並行処理の講座","url":"https://example.com/course",`+
+				`"status":"in_progress","progress":40,"due_month":"2026-12","created_at":"2026-10-19T10:00:00Z","updated_at":"2026-10-19T10:00:00Z"}]`) + `]`,
+		},
+		{
+			name: "正常系: 目標がない場合は空の配列を返す",
+			setupMock: func(mock *mock_usecase.MockCareerGoalUsecase) {
+				mock.EXPECT().ListMyGoals(gomock.Any()).Return(nil, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `[]`,
+		},
+		{
+			name: "異常系: ユーザーが設定されていない",
+			setupMock: func(mock *mock_usecase.MockCareerGoalUsecase) {
+				mock.EXPECT().ListMyGoals(gomock.Any()).Return(nil, usecase.ErrUnauthenticated)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/me/goals", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockCareerGoalUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewCareerGoalHandler(mockUsecase)
+			err := handler.List(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestCareerGoalHandler_Create(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    string
+		setupMock      func(mock *mock_usecase.MockCareerGoalUsecase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "正常系: スキルの目標を登録する",
+			requestBody: `{"kind":"skill","title":"Go で設計できる","skill":{"category":"language","name":"Go"},"target_level":4,"target_month":"2027-03"}`,
+			setupMock: func(mock *mock_usecase.MockCareerGoalUsecase) {
+				mock.EXPECT().CreateGoal(gomock.Any(), usecase.CareerGoalInput{
+					Kind: "skill", Title: "Go で設計できる", SkillCategory: "language", SkillName: "Go", TargetLevel: 4, TargetMonth: &goalTarget,
+				}).Return(newCareerGoalDto(), nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   careerGoalBody(`[]`),
+		},
+		{
+			name:        "異常系: 入力値が不正",
+			requestBody: `{"kind":"role","title":"テックリード"}`,
+			setupMock: func(mock *mock_usecase.MockCareerGoalUsecase) {
+				mock.EXPECT().CreateGoal(gomock.Any(), gomock.Any()).Return(usecase.CareerGoalDto{}, usecase.ErrInvalidInput)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "異常系: 達成予定月の形式が不正",
+			requestBody:    `{"kind":"role","title":"テックリード","target_month":"2027/03"}`,
+			setupMock:      func(mock *mock_usecase.MockCareerGoalUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/me/goals", strings.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockCareerGoalUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewCareerGoalHandler(mockUsecase)
+			err := handler.Create(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestCareerGoalHandler_Update(t *testing.T) {
+	tests := []struct {
+		name           string
+		id             string
+		requestBody    string
+		setupMock      func(mock *mock_usecase.MockCareerGoalUsecase)
+		expectedStatus int
+	}{
+		{
+			name:        "正常系: 目標を達成にする",
+			id:          "1",
+			requestBody: `{"kind":"role","title":"テックリード","target_month":"2027-03","status":"achieved"}`,
+			setupMock: func(mock *mock_usecase.MockCareerGoalUsecase) {
+				mock.EXPECT().UpdateGoal(gomock.Any(), 1, usecase.CareerGoalInput{Kind: "role", Title: "テックリード", TargetMonth: &goalTarget, Status: "achieved"}).
+					Return(newCareerGoalDto(), nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "異常系: 目標が存在しない",
+			id:          "9",
+			requestBody: `{"kind":"role","title":"テックリード","target_month":"2027-03"}`,
+			setupMock: func(mock *mock_usecase.MockCareerGoalUsecase) {
+				mock.EXPECT().UpdateGoal(gomock.Any(), 9, gomock.Any()).Return(usecase.CareerGoalDto{}, usecase.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "異常系: IDが不正",
+			id:             "abc",
+			requestBody:    `{}`,
+			setupMock:      func(mock *mock_usecase.MockCareerGoalUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/me/goals/"+tt.id, strings.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.id)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockCareerGoalUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewCareerGoalHandler(mockUsecase)
+			err := handler.Update(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestCareerGoalHandler_Delete(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(mock *mock_usecase.MockCareerGoalUsecase)
+		expectedStatus int
+	}{
+		{
+			name: "正常系: 目標を削除する",
+			setupMock: func(mock *mock_usecase.MockCareerGoalUsecase) {
+				mock.EXPECT().DeleteGoal(gomock.Any(), 1).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name: "異常系: 目標が存在しない",
+			setupMock: func(mock *mock_usecase.MockCareerGoalUsecase) {
+				mock.EXPECT().DeleteGoal(gomock.Any(), 1).Return(usecase.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/me/goals/1", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("1")
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockCareerGoalUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewCareerGoalHandler(mockUsecase)
+			err := handler.Delete(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestCareerGoalHandler_AddActivity(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    string
+		setupMock      func(mock *mock_usecase.MockCareerGoalUsecase)
+		expectedStatus int
+	}{
+		{
+			name:        "正常系: 学習活動を登録し、登録後の目標を返す",
+			requestBody: `{"kind":"certification","title":"AWS SAA","status":"in_progress","progress":30,"due_month":"2026-12"}`,
+			setupMock: func(mock *mock_usecase.MockCareerGoalUsecase) {
+				due := time.Date(2026, time.December, 1, 0, 0, 0, 0, time.UTC)
+				mock.EXPECT().AddActivity(gomock.Any(), 1, usecase.LearningActivityInput{
+					Kind: "certification", Title: "AWS SAA", Status: "in_progress", Progress: 30, DueMonth: &due,
+				}).Return(newCareerGoalDto(), nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:        "異常系: 学習活動が上限に達している",
+			requestBody: `{"kind":"course","title":"Go の講座","status":"planned"}`,
+			setupMock: func(mock *mock_usecase.MockCareerGoalUsecase) {
+				mock.EXPECT().AddActivity(gomock.Any(), 1, gomock.Any()).Return(usecase.CareerGoalDto{}, usecase.ErrInvalidInput)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "異常系: 完了予定月の形式が不正",
+			requestBody:    `{"kind":"course","title":"Go の講座","status":"planned","due_month":"12月"}`,
+			setupMock:      func(mock *mock_usecase.MockCareerGoalUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/me/goals/1/activities", strings.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("1")
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockCareerGoalUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewCareerGoalHandler(mockUsecase)
+			err := handler.AddActivity(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestCareerGoalHandler_UpdateActivity(t *testing.T) {
+	tests := []struct {
+		name           string
+		activityID     string
+		setupMock      func(mock *mock_usecase.MockCareerGoalUsecase)
+		expectedStatus int
+	}{
+		{
+			name:       "正常系: 学習活動を完了にし、更新後の目標を返す",
+			activityID: "5",
+			setupMock: func(mock *mock_usecase.MockCareerGoalUsecase) {
+				mock.EXPECT().UpdateActivity(gomock.Any(), 1, 5, usecase.LearningActivityInput{Kind: "course", Title: "並行処理の講座", Status: "completed"}).
+					Return(newCareerGoalDto(), nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:       "異常系: 目標の学習活動ではない",
+			activityID: "9",
+			setupMock: func(mock *mock_usecase.MockCareerGoalUsecase) {
+				mock.EXPECT().UpdateActivity(gomock.Any(), 1, 9, gomock.Any()).Return(usecase.CareerGoalDto{}, usecase.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "異常系: 学習活動のIDが不正",
+			activityID:     "abc",
+			setupMock:      func(mock *mock_usecase.MockCareerGoalUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			body := `{"kind":"course","title":"並行処理の講座","status":"completed"}`
+			req := httptest.NewRequest(http.MethodPut, "/me/goals/1/activities/"+tt.activityID, strings.NewReader(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id", "activity_id")
+			c.SetParamValues("1", tt.activityID)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockCareerGoalUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewCareerGoalHandler(mockUsecase)
+			err := handler.UpdateActivity(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestCareerGoalHandler_DeleteActivity(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(mock *mock_usecase.MockCareerGoalUsecase)
+		expectedStatus int
+	}{
+		{
+			name: "正常系: 学習活動を削除する",
+			setupMock: func(mock *mock_usecase.MockCareerGoalUsecase) {
+				mock.EXPECT().DeleteActivity(gomock.Any(), 1, 5).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name: "異常系: 目標が存在しない",
+			setupMock: func(mock *mock_usecase.MockCareerGoalUsecase) {
+				mock.EXPECT().DeleteActivity(gomock.Any(), 1, 5).Return(usecase.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/me/goals/1/activities/5", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id", "activity_id")
+			c.SetParamValues("1", "5")
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockCareerGoalUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewCareerGoalHandler(mockUsecase)
+			err := handler.DeleteActivity(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestCareerGoalHandler_ListTeamGoals(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(mock *mock_usecase.MockCareerGoalUsecase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "正常系: メンバーごとの目標を返す",
+			setupMock: func(mock *mock_usecase.MockCareerGoalUsecase) {
+				mock.EXPECT().ListTeamGoals(gomock.Any(), 1).Return([]usecase.MemberCareerGoalsDto{
+					{Member: usecase.MemberDto{UserID: "manager-1"}, Goals: []usecase.CareerGoalDto{}},
+					{Member: usecase.MemberDto{UserID: "user-1", DisplayName: "山田 太郎"}, Goals: []usecase.CareerGoalDto{newCareerGoalDto()}},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `[{"member":{"user_id":"manager-1","display_name":""},"goals":[]},` +
+				`{"member":{"user_id":"user-1","display_name":"山田 太郎"},"goals":[` + careerGoalBody(`[]`) + `]}]`,
+		},
+		{
+			name: "異常系: チームのマネージャーではない",
+			setupMock: func(mock *mock_usecase.MockCareerGoalUsecase) {
+				mock.EXPECT().ListTeamGoals(gomock.Any(), 1).Return(nil, usecase.ErrForbidden)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/teams/1/goals", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("1")
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockCareerGoalUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewCareerGoalHandler(mockUsecase)
+			err := handler.ListTeamGoals(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
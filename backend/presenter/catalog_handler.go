@@ -0,0 +1,80 @@
+package presenter
+
+import (
+	"net/http"
+	"stackies/backend/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type catalogHandler struct {
+	catalogUsecase usecase.CatalogUsecase
+}
+
+// CatalogItemRequest はテナント独自のカタログの項目の登録のリクエストです
+type CatalogItemRequest struct {
+	// Category は language または tool です
+	Category string   `json:"category"`
+	Name     string   `json:"name"`
+	Aliases  []string `json:"aliases"`
+}
+
+func (r *CatalogItemRequest) ConvertToInput() usecase.CatalogItemInput {
+	return usecase.CatalogItemInput{Category: r.Category, Name: r.Name, Aliases: r.Aliases}
+}
+
+type CatalogItemResponse struct {
+	ID       int      `json:"id"`
+	Category string   `json:"category"`
+	Name     string   `json:"name"`
+	Aliases  []string `json:"aliases"`
+	// Global は全テナント共通のカタログの項目であることを表します
+	Global bool `json:"global"`
+}
+
+func (r *CatalogItemResponse) ConvertToDto(item usecase.CatalogItemDto) {
+	r.ID = item.ID
+	r.Category = item.Category
+	r.Name = item.Name
+	r.Aliases = item.Aliases
+	r.Global = item.Global
+}
+
+// List implements CatalogHandler.
+func (h *catalogHandler) List(c echo.Context) error {
+	items, err := h.catalogUsecase.List(c.Request().Context())
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	response := make([]CatalogItemResponse, len(items))
+	for i, item := range items {
+		response[i].ConvertToDto(item)
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// Create implements CatalogHandler.
+func (h *catalogHandler) Create(c echo.Context) error {
+	var request CatalogItemRequest
+	if err := c.Bind(&request); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	item, err := h.catalogUsecase.Create(c.Request().Context(), request.ConvertToInput())
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	var response CatalogItemResponse
+	response.ConvertToDto(item)
+	return c.JSON(http.StatusCreated, response)
+}
+
+type CatalogHandler interface {
+	List(c echo.Context) error
+	Create(c echo.Context) error
+}
+
+func NewCatalogHandler(catalogUsecase usecase.CatalogUsecase) CatalogHandler {
+	return &catalogHandler{catalogUsecase: catalogUsecase}
+}
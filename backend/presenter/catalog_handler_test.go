@@ -0,0 +1,101 @@
+package presenter_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"stackies/backend/presenter"
+	"stackies/backend/usecase"
+	mock_usecase "stackies/backend/usecase/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalogHandler_List(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/catalog", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockUsecase := mock_usecase.NewMockCatalogUsecase(ctrl)
+	mockUsecase.EXPECT().List(gomock.Any()).Return([]usecase.CatalogItemDto{
+		{ID: 100, Category: "tool", Name: "社内フレームワーク", Aliases: []string{}},
+		{ID: 1, Category: "language", Name: "Go", Aliases: []string{"golang"}, Global: true},
+	}, nil)
+
+	handler := presenter.NewCatalogHandler(mockUsecase)
+	err := handler.List(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `[{"id":100,"category":"tool","name":"社内フレームワーク","aliases":[],"global":false},`+
+		`{"id":1,"category":"language","name":"Go","aliases":["golang"],"global":true}]`, rec.Body.String())
+}
+
+func TestCatalogHandler_Create(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    string
+		setupMock      func(mock *mock_usecase.MockCatalogUsecase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "正常系: テナント独自の項目を登録する",
+			requestBody: `{"category":"tool","name":"帳票ツール","aliases":["report-kit"]}`,
+			setupMock: func(mock *mock_usecase.MockCatalogUsecase) {
+				mock.EXPECT().Create(gomock.Any(), usecase.CatalogItemInput{Category: "tool", Name: "帳票ツール", Aliases: []string{"report-kit"}}).
+					Return(usecase.CatalogItemDto{ID: 101, Category: "tool", Name: "帳票ツール", Aliases: []string{"report-kit"}}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"id":101,"category":"tool","name":"帳票ツール","aliases":["report-kit"],"global":false}`,
+		},
+		{
+			name:        "異常系: 同じ名前の項目がある",
+			requestBody: `{"category":"tool","name":"帳票ツール"}`,
+			setupMock: func(mock *mock_usecase.MockCatalogUsecase) {
+				mock.EXPECT().Create(gomock.Any(), gomock.Any()).Return(usecase.CatalogItemDto{}, fmt.Errorf("%w: duplicated", usecase.ErrConflict))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:        "異常系: 入力値が不正",
+			requestBody: `{"category":"framework","name":"Rails"}`,
+			setupMock: func(mock *mock_usecase.MockCatalogUsecase) {
+				mock.EXPECT().Create(gomock.Any(), gomock.Any()).Return(usecase.CatalogItemDto{}, usecase.ErrInvalidInput)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/admin/catalog", strings.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockCatalogUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewCatalogHandler(mockUsecase)
+			err := handler.Create(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package presenter
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"stackies/backend/usecase"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ErrorResponse はエラー時のレスポンスです
+// TraceID を問い合わせ時に伝えてもらうことで、該当リクエストのトレースを特定できます
+type ErrorResponse struct {
+	Message string `json:"message"`
+	TraceID string `json:"trace_id,omitempty"`
+}
+
+// errorJSON はトレースIDを付与したエラーレスポンスを返します
+func errorJSON(c echo.Context, status int, err error) error {
+	return c.JSON(status, newErrorResponse(c, err.Error()))
+}
+
+// usecaseErrorJSON はユースケースのエラーに応じたステータスでエラーレスポンスを返します
+func usecaseErrorJSON(c echo.Context, err error) error {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, usecase.ErrNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, usecase.ErrInvalidInput):
+		status = http.StatusBadRequest
+	case errors.Is(err, usecase.ErrUnauthenticated), errors.Is(err, usecase.ErrSharePasswordRequired):
+		status = http.StatusUnauthorized
+	case errors.Is(err, usecase.ErrForbidden):
+		status = http.StatusForbidden
+	case errors.Is(err, usecase.ErrConflict):
+		status = http.StatusConflict
+	}
+	return errorJSON(c, status, err)
+}
+
+func newErrorResponse(c echo.Context, message string) ErrorResponse {
+	response := ErrorResponse{Message: message}
+	if spanContext := trace.SpanContextFromContext(c.Request().Context()); spanContext.HasTraceID() {
+		response.TraceID = spanContext.TraceID().String()
+	}
+	return response
+}
+
+// NewHTTPErrorHandler はハンドラー外で発生したエラー（404やミドルウェアのエラーなど）を
+// ErrorResponse の形式で返す echo のエラーハンドラーを作成します
+func NewHTTPErrorHandler(logger *slog.Logger) echo.HTTPErrorHandler {
+	return func(err error, c echo.Context) {
+		handleHTTPError(logger, err, c)
+	}
+}
+
+func handleHTTPError(logger *slog.Logger, err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	status := http.StatusInternalServerError
+	message := http.StatusText(status)
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		status = he.Code
+		message = fmt.Sprint(he.Message)
+	}
+
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(status)
+	} else {
+		err = c.JSON(status, newErrorResponse(c, message))
+	}
+	if err != nil {
+		logger.ErrorContext(c.Request().Context(), "failed to write error response", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,56 @@
+package presenter
+
+import (
+	"errors"
+	"net/http"
+	"stackies/backend/usecase"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	HeaderETag    = "ETag"
+	HeaderIfMatch = "If-Match"
+)
+
+// ConflictResponse は If-Match のバージョンが一致しなかった場合のレスポンスです
+// フロントエンドが変更をマージできるように現在の内容を返します
+type ConflictResponse struct {
+	ErrorResponse
+	Current ExperienceResponse `json:"current"`
+}
+
+// setETag はバージョンを ETag ヘッダーに設定します
+func setETag(c echo.Context, version int) {
+	c.Response().Header().Set(HeaderETag, strconv.Quote(strconv.Itoa(version)))
+}
+
+// ifMatchVersion は If-Match ヘッダーからバージョンを取得します
+// エラーの場合は返すべきステータス（ヘッダーがない場合は 428、形式が不正な場合は 400）も返します
+func ifMatchVersion(c echo.Context) (int, int, error) {
+	value := strings.TrimSpace(c.Request().Header.Get(HeaderIfMatch))
+	if value == "" {
+		return 0, http.StatusPreconditionRequired, errors.New("If-Match header is required")
+	}
+	version, err := strconv.Atoi(strings.Trim(strings.TrimPrefix(value, "W/"), `"`))
+	if err != nil {
+		return 0, http.StatusBadRequest, errors.New("invalid If-Match header")
+	}
+	return version, 0, nil
+}
+
+// experienceErrorJSON は業務経歴のエラーレスポンスを返します
+// バージョンが一致しなかった場合は 412 と現在の内容・ETag を返します
+func experienceErrorJSON(c echo.Context, err error) error {
+	var conflict *usecase.ExperienceConflictError
+	if !errors.As(err, &conflict) {
+		return usecaseErrorJSON(c, err)
+	}
+
+	response := ConflictResponse{ErrorResponse: newErrorResponse(c, err.Error())}
+	response.Current.ConvertToDto(conflict.Current)
+	setETag(c, conflict.Current.Version)
+	return c.JSON(http.StatusPreconditionFailed, response)
+}
@@ -1,8 +1,17 @@
 package presenter
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
+	"path"
+	"sort"
 	"stackies/backend/usecase"
+	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 )
@@ -11,38 +20,202 @@ type experienceHandler struct {
 	experienceUsecase usecase.ExperienceUsecase
 }
 
-type CreateExperienceRequest struct {
+// monthLayout は参画期間の開始月・終了月の形式です
+const monthLayout = "2006-01"
+
+// ExperienceRequest は業務経歴の作成・更新のリクエストです
+type ExperienceRequest struct {
 	Title string `json:"title"`
+	// ClientName は社外秘の顧客名、ClientAlias は匿名化したスキルシートで代わりに表示する公開用の名前（"大手金融機関向け" など）です
+	ClientName  string `json:"client_name,omitempty"`
+	ClientAlias string `json:"client_alias,omitempty"`
+	// Industry は顧客の業種です（"金融"、"製造" など）
+	Industry string `json:"industry,omitempty"`
+	// StartMonth / EndMonth は "2024-04" の形式です（EndMonth を省略した場合は参画中）
+	StartMonth string `json:"start_month,omitempty"`
+	EndMonth   string `json:"end_month,omitempty"`
+	TeamSize   int    `json:"team_size,omitempty"`
+	// Skills は案件で使用した言語・ツールです（category は "language" または "tool"）
+	Skills []SkillRequest `json:"skills,omitempty"`
+	// Phases は担当した工程です（requirements / basic_design / detailed_design / implementation / testing / operation）
+	Phases []string `json:"phases,omitempty"`
+}
+
+type SkillRequest struct {
+	Category string `json:"category"`
+	Name     string `json:"name"`
+}
+
+type CreateExperienceRequest = ExperienceRequest
+
+type UpdateExperienceRequest = ExperienceRequest
+
+func (r *ExperienceRequest) ConvertToInput() (usecase.ExperienceInput, error) {
+	input := usecase.ExperienceInput{
+		Title:       r.Title,
+		ClientName:  r.ClientName,
+		ClientAlias: r.ClientAlias,
+		Industry:    r.Industry,
+		TeamSize:    r.TeamSize,
+		Phases:      r.Phases,
+	}
+	var err error
+	if input.StartMonth, err = parseMonth(r.StartMonth); err != nil {
+		return usecase.ExperienceInput{}, err
+	}
+	if input.EndMonth, err = parseMonth(r.EndMonth); err != nil {
+		return usecase.ExperienceInput{}, err
+	}
+	for _, skill := range r.Skills {
+		input.Skills = append(input.Skills, usecase.SkillDto{Category: skill.Category, Name: skill.Name})
+	}
+	return input, nil
 }
 
 type ExperienceResponse struct {
-	ID    int    `json:"id"`
-	Title string `json:"title"`
+	ID          int             `json:"id"`
+	Title       string          `json:"title"`
+	ClientName  string          `json:"client_name,omitempty"`
+	ClientAlias string          `json:"client_alias,omitempty"`
+	Industry    string          `json:"industry,omitempty"`
+	StartMonth  string          `json:"start_month,omitempty"`
+	EndMonth    string          `json:"end_month,omitempty"`
+	TeamSize    int             `json:"team_size,omitempty"`
+	Skills      []SkillResponse `json:"skills,omitempty"`
+	Phases      []string        `json:"phases,omitempty"`
+}
+
+type SkillResponse struct {
+	Category string `json:"category"`
+	Name     string `json:"name"`
 }
 
 func (e *ExperienceResponse) ConvertToDto(experience usecase.ExperienceDto) {
 	e.ID = experience.ID
 	e.Title = experience.Title
+	e.ClientName = experience.ClientName
+	e.ClientAlias = experience.ClientAlias
+	e.Industry = experience.Industry
+	e.StartMonth = formatMonth(experience.StartMonth)
+	e.EndMonth = formatMonth(experience.EndMonth)
+	e.TeamSize = experience.TeamSize
+	e.Skills = nil
+	for _, skill := range experience.Skills {
+		e.Skills = append(e.Skills, SkillResponse{Category: skill.Category, Name: skill.Name})
+	}
+	e.Phases = experience.Phases
+}
+
+func parseMonth(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(monthLayout, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid month %q: must be YYYY-MM", value)
+	}
+	return &t, nil
+}
+
+func formatMonth(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(monthLayout)
+}
+
+// maxImportFileSize は取り込むファイルの最大サイズ（バイト）です
+const maxImportFileSize = 5 << 20
+
+// ImportResultResponse は業務経歴の取り込み結果です
+type ImportResultResponse struct {
+	// DryRun が true の場合、Created は登録する内容で、まだ登録していません
+	DryRun  bool                 `json:"dry_run"`
+	Created []ExperienceResponse `json:"created"`
+	// Unmatched は言語・ツールのカタログに対応付けられず、スキルとして登録しなかった名前です
+	Unmatched []UnmatchedTechnologyResponse `json:"unmatched"`
+}
+
+type UnmatchedTechnologyResponse struct {
+	// Index は取り込んだ業務経歴の位置（0 始まり）、Row はファイルの行番号です（JSON Resume の場合は省略）
+	Index int    `json:"index"`
+	Row   int    `json:"row,omitempty"`
+	Name  string `json:"name"`
+}
+
+// ImportErrorResponse は取り込む業務経歴に不正な行があった場合のレスポンスです
+type ImportErrorResponse struct {
+	ErrorResponse
+	Errors []ImportRowErrorResponse `json:"errors"`
+}
+
+type ImportRowErrorResponse struct {
+	// Index は取り込んだ業務経歴の位置（0 始まり）、Row はファイルの行番号です（JSON Resume の場合は省略）
+	Index   int    `json:"index"`
+	Row     int    `json:"row,omitempty"`
+	Message string `json:"message"`
+}
+
+func (r *ImportResultResponse) ConvertToDto(result usecase.ImportResultDto) {
+	r.Created = make([]ExperienceResponse, len(result.Created))
+	for i, experience := range result.Created {
+		r.Created[i].ConvertToDto(experience)
+	}
+	r.Unmatched = make([]UnmatchedTechnologyResponse, len(result.Unmatched))
+	for i, unmatched := range result.Unmatched {
+		r.Unmatched[i] = UnmatchedTechnologyResponse{Index: unmatched.Index, Name: unmatched.Name}
+	}
+}
+
+type ExperienceRevisionResponse struct {
+	Revision  int                `json:"revision"`
+	Snapshot  ExperienceResponse `json:"snapshot"`
+	ActorID   string             `json:"actor_id"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
+func (e *ExperienceRevisionResponse) ConvertToDto(revision usecase.ExperienceRevisionDto) {
+	e.Revision = revision.Revision
+	e.Snapshot.ConvertToDto(revision.Snapshot)
+	e.ActorID = revision.ActorID
+	e.CreatedAt = revision.CreatedAt
+}
+
+type FieldDiffResponse struct {
+	Field string      `json:"field"`
+	From  interface{} `json:"from"`
+	To    interface{} `json:"to"`
+}
+
+func (f *FieldDiffResponse) ConvertToDto(diff usecase.FieldDiffDto) {
+	f.Field = diff.Field
+	f.From = diff.From
+	f.To = diff.To
 }
 
 // Create implements ExperienceHandler.
 func (e *experienceHandler) Create(c echo.Context) error {
 	var request CreateExperienceRequest
 	if err := c.Bind(&request); err != nil {
-		return c.JSON(http.StatusBadRequest, err.Error())
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	input, err := request.ConvertToInput()
+	if err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
 	}
-	err := e.experienceUsecase.Create(request.Title)
+	experience, err := e.experienceUsecase.Create(c.Request().Context(), input)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, err.Error())
+		return usecaseErrorJSON(c, err)
 	}
-	return c.JSON(http.StatusCreated, request)
+	// 作成した直後に更新・削除できるよう、ID と ETag を返す
+	return experienceJSON(c, http.StatusCreated, experience)
 }
 
 // GetAll implements ExperienceHandler.
 func (e *experienceHandler) GetAll(c echo.Context) error {
-	experiences, err := e.experienceUsecase.GetAll()
+	experiences, err := e.experienceUsecase.GetAll(c.Request().Context())
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, err.Error())
+		return errorJSON(c, http.StatusInternalServerError, err)
 	}
 
 	response := make([]ExperienceResponse, len(experiences))
@@ -52,9 +225,273 @@ func (e *experienceHandler) GetAll(c echo.Context) error {
 	return c.JSON(http.StatusOK, response)
 }
 
+// Get implements ExperienceHandler.
+func (e *experienceHandler) Get(c echo.Context) error {
+	var id int
+	if err := echo.PathParamsBinder(c).MustInt("id", &id).BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	experience, err := e.experienceUsecase.Get(c.Request().Context(), id)
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+	return experienceJSON(c, http.StatusOK, experience)
+}
+
+// Update implements ExperienceHandler.
+func (e *experienceHandler) Update(c echo.Context) error {
+	var id int
+	if err := echo.PathParamsBinder(c).MustInt("id", &id).BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	version, status, err := ifMatchVersion(c)
+	if err != nil {
+		return errorJSON(c, status, err)
+	}
+	var request UpdateExperienceRequest
+	if err := c.Bind(&request); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	input, err := request.ConvertToInput()
+	if err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	experience, err := e.experienceUsecase.Update(c.Request().Context(), id, version, input)
+	if err != nil {
+		return experienceErrorJSON(c, err)
+	}
+	return experienceJSON(c, http.StatusOK, experience)
+}
+
+// Delete implements ExperienceHandler.
+func (e *experienceHandler) Delete(c echo.Context) error {
+	var id int
+	if err := echo.PathParamsBinder(c).MustInt("id", &id).BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	version, status, err := ifMatchVersion(c)
+	if err != nil {
+		return errorJSON(c, status, err)
+	}
+	if err := e.experienceUsecase.Delete(c.Request().Context(), id, version); err != nil {
+		return experienceErrorJSON(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Restore implements ExperienceHandler.
+func (e *experienceHandler) Restore(c echo.Context) error {
+	var id int
+	if err := echo.PathParamsBinder(c).MustInt("id", &id).BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	experience, err := e.experienceUsecase.Restore(c.Request().Context(), id)
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+	return experienceJSON(c, http.StatusOK, experience)
+}
+
+// ListRevisions implements ExperienceHandler.
+func (e *experienceHandler) ListRevisions(c echo.Context) error {
+	var id int
+	if err := echo.PathParamsBinder(c).MustInt("id", &id).BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	revisions, err := e.experienceUsecase.ListRevisions(c.Request().Context(), id)
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	response := make([]ExperienceRevisionResponse, len(revisions))
+	for i, revision := range revisions {
+		response[i].ConvertToDto(revision)
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// DiffRevisions implements ExperienceHandler.
+func (e *experienceHandler) DiffRevisions(c echo.Context) error {
+	var id, from, to int
+	if err := echo.PathParamsBinder(c).MustInt("id", &id).BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	if err := echo.QueryParamsBinder(c).
+		MustInt("from", &from).
+		MustInt("to", &to).
+		BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	diffs, err := e.experienceUsecase.DiffRevisions(c.Request().Context(), id, from, to)
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	response := make([]FieldDiffResponse, len(diffs))
+	for i, diff := range diffs {
+		response[i].ConvertToDto(diff)
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// RestoreRevision implements ExperienceHandler.
+func (e *experienceHandler) RestoreRevision(c echo.Context) error {
+	var id, revision int
+	if err := echo.PathParamsBinder(c).
+		MustInt("id", &id).
+		MustInt("revision", &revision).
+		BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	version, status, err := ifMatchVersion(c)
+	if err != nil {
+		return errorJSON(c, status, err)
+	}
+	experience, err := e.experienceUsecase.RestoreRevision(c.Request().Context(), id, revision, version)
+	if err != nil {
+		return experienceErrorJSON(c, err)
+	}
+	return experienceJSON(c, http.StatusOK, experience)
+}
+
+// Import implements ExperienceHandler.
+// multipart/form-data の file にアップロードされた CSV または xlsx の各行を業務経歴として登録します
+// dry_run=true の場合は検証結果と登録する内容だけを返します
+func (e *experienceHandler) Import(c echo.Context) error {
+	file, fileName, status, err := importFile(c)
+	if err != nil {
+		return errorJSON(c, status, err)
+	}
+	defer file.Close()
+
+	var rows []importRow
+	switch ext := strings.ToLower(path.Ext(fileName)); ext {
+	case ".csv":
+		rows, err = readImportCSV(io.LimitReader(file, maxImportFileSize))
+	case ".xlsx":
+		rows, err = readImportXLSX(io.LimitReader(file, maxImportFileSize))
+	default:
+		err = fmt.Errorf("unsupported file type %q: must be .csv or .xlsx", ext)
+	}
+	if err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	return e.importRows(c, rows)
+}
+
+// ImportJSONResume implements ExperienceHandler.
+// multipart/form-data の file にアップロードされた JSON Resume の職歴・案件を業務経歴として登録します
+func (e *experienceHandler) ImportJSONResume(c echo.Context) error {
+	file, _, status, err := importFile(c)
+	if err != nil {
+		return errorJSON(c, status, err)
+	}
+	defer file.Close()
+
+	var resume jsonResume
+	if err := json.NewDecoder(io.LimitReader(file, maxImportFileSize)).Decode(&resume); err != nil {
+		return errorJSON(c, http.StatusBadRequest, fmt.Errorf("file must be a JSON Resume: %w", err))
+	}
+	rows := resume.toImportRows()
+	if len(rows) == 0 {
+		return errorJSON(c, http.StatusBadRequest, errors.New("file has no work or projects to import"))
+	}
+	return e.importRows(c, rows)
+}
+
+// importFile は multipart/form-data の file を開きます
+func importFile(c echo.Context) (multipart.File, string, int, error) {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return nil, "", http.StatusBadRequest, errors.New("file is required")
+	}
+	if fileHeader.Size > maxImportFileSize {
+		return nil, "", http.StatusRequestEntityTooLarge, fmt.Errorf("file must be at most %d bytes", maxImportFileSize)
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return nil, "", http.StatusBadRequest, err
+	}
+	return file, fileHeader.Filename, 0, nil
+}
+
+// importRows は読み込んだ行を業務経歴として登録します
+// 読み込めなかった行がある場合も残りの行を検証し、全ての行のエラーをまとめて 400 で返します
+func (e *experienceHandler) importRows(c echo.Context, rows []importRow) error {
+	var dryRun bool
+	if err := echo.QueryParamsBinder(c).Bool("dry_run", &dryRun).BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+
+	var inputs []usecase.ExperienceImportInput
+	// indexes は inputs の位置から rows の位置への対応です
+	var indexes []int
+	var rowErrors []ImportRowErrorResponse
+	for i, row := range rows {
+		if row.err != nil {
+			rowErrors = append(rowErrors, ImportRowErrorResponse{Index: i, Row: row.row, Message: row.err.Error()})
+			continue
+		}
+		inputs = append(inputs, row.input)
+		indexes = append(indexes, i)
+	}
+
+	var result usecase.ImportResultDto
+	if len(inputs) > 0 {
+		var err error
+		// 読み込めなかった行がある場合は登録せずに検証だけを行う
+		result, err = e.experienceUsecase.Import(c.Request().Context(), inputs, dryRun || len(rowErrors) > 0)
+		var validation *usecase.ImportValidationError
+		if errors.As(err, &validation) {
+			for _, rowErr := range validation.Errors {
+				i := indexes[rowErr.Index]
+				rowErrors = append(rowErrors, ImportRowErrorResponse{Index: i, Row: rows[i].row, Message: rowErr.Message})
+			}
+		} else if err != nil {
+			return usecaseErrorJSON(c, err)
+		}
+	}
+	if len(rowErrors) > 0 {
+		sort.SliceStable(rowErrors, func(i, j int) bool { return rowErrors[i].Index < rowErrors[j].Index })
+		return c.JSON(http.StatusBadRequest, ImportErrorResponse{
+			ErrorResponse: newErrorResponse(c, fmt.Sprintf("%d of %d rows are invalid", len(rowErrors), len(rows))),
+			Errors:        rowErrors,
+		})
+	}
+
+	var response ImportResultResponse
+	response.ConvertToDto(result)
+	response.DryRun = dryRun
+	for i := range response.Unmatched {
+		response.Unmatched[i].Index = indexes[response.Unmatched[i].Index]
+		response.Unmatched[i].Row = rows[response.Unmatched[i].Index].row
+	}
+	if dryRun {
+		return c.JSON(http.StatusOK, response)
+	}
+	return c.JSON(http.StatusCreated, response)
+}
+
+// experienceJSON は業務経歴をバージョンの ETag とともに返します
+func experienceJSON(c echo.Context, status int, experience usecase.ExperienceDto) error {
+	var response ExperienceResponse
+	response.ConvertToDto(experience)
+	setETag(c, experience.Version)
+	return c.JSON(status, response)
+}
+
 type ExperienceHandler interface {
 	Create(c echo.Context) error
 	GetAll(c echo.Context) error
+	Get(c echo.Context) error
+	Update(c echo.Context) error
+	Delete(c echo.Context) error
+	Restore(c echo.Context) error
+	ListRevisions(c echo.Context) error
+	DiffRevisions(c echo.Context) error
+	RestoreRevision(c echo.Context) error
+	Import(c echo.Context) error
+	ImportJSONResume(c echo.Context) error
 }
 
 func NewExperienceHandler(experienceUsecase usecase.ExperienceUsecase) ExperienceHandler {
@@ -1,11 +1,15 @@
 package presenter_test
 
 import (
+	"bytes"
+	"context"
 	"errors"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"stackies/backend/presenter"
 	"stackies/backend/usecase"
@@ -14,6 +18,11 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
 )
 
 func TestExperienceHandler_Create(t *testing.T) {
@@ -24,15 +33,61 @@ func TestExperienceHandler_Create(t *testing.T) {
 		setupMock      func(mock *mock_usecase.MockExperienceUsecase)
 		expectedStatus int
 		expectedBody   string
+		expectedETag   string
 	}{
 		{
-			name:        "正常系: 体験を作成できる",
+			name:        "正常系: 体験を作成でき、IDとバージョンの ETag を返す",
 			requestBody: `{"title":"テスト体験"}`,
 			setupMock: func(mock *mock_usecase.MockExperienceUsecase) {
-				mock.EXPECT().Create("テスト体験").Return(nil)
+				mock.EXPECT().Create(gomock.Any(), usecase.ExperienceInput{Title: "テスト体験"}).
+					Return(usecase.ExperienceDto{ID: 1, Title: "テスト体験", Version: 1}, nil)
 			},
 			expectedStatus: http.StatusCreated,
-			expectedBody:   `{"title":"テスト体験"}`,
+			expectedBody:   `{"id":1,"title":"テスト体験"}`,
+			expectedETag:   `"1"`,
+		},
+		{
+			name:        "正常系: 参画期間とチーム人数を指定して作成できる",
+			requestBody: `{"title":"テスト体験","start_month":"2024-04","end_month":"2025-03","team_size":5}`,
+			setupMock: func(mock *mock_usecase.MockExperienceUsecase) {
+				start := time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)
+				end := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+				mock.EXPECT().Create(gomock.Any(), usecase.ExperienceInput{
+					Title:      "テスト体験",
+					StartMonth: &start,
+					EndMonth:   &end,
+					TeamSize:   5,
+				}).Return(usecase.ExperienceDto{ID: 2, Title: "テスト体験", StartMonth: &start, EndMonth: &end, TeamSize: 5, Version: 1}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"id":2,"title":"テスト体験","start_month":"2024-04","end_month":"2025-03","team_size":5}`,
+			expectedETag:   `"1"`,
+		},
+		{
+			name:        "正常系: 使用した言語・ツールを指定して作成できる",
+			requestBody: `{"title":"テスト体験","skills":[{"category":"language","name":"Go"},{"category":"tool","name":"AWS"}]}`,
+			setupMock: func(mock *mock_usecase.MockExperienceUsecase) {
+				mock.EXPECT().Create(gomock.Any(), usecase.ExperienceInput{
+					Title:  "テスト体験",
+					Skills: []usecase.SkillDto{{Category: "language", Name: "Go"}, {Category: "tool", Name: "AWS"}},
+				}).Return(usecase.ExperienceDto{ID: 3, Title: "テスト体験", Version: 1}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedETag:   `"1"`,
+		},
+		{
+			name:           "異常系: 開始月の形式が不正",
+			requestBody:    `{"title":"テスト体験","start_month":"2024/04"}`,
+			setupMock:      func(mock *mock_usecase.MockExperienceUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "異常系: 入力値が不正",
+			requestBody: `{"title":""}`,
+			setupMock: func(mock *mock_usecase.MockExperienceUsecase) {
+				mock.EXPECT().Create(gomock.Any(), usecase.ExperienceInput{}).Return(usecase.ExperienceDto{}, usecase.ErrInvalidInput)
+			},
+			expectedStatus: http.StatusBadRequest,
 		},
 		{
 			name:           "異常系: リクエストボディが不正",
@@ -70,6 +125,7 @@ func TestExperienceHandler_Create(t *testing.T) {
 			if tt.expectedBody != "" {
 				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
 			}
+			assert.Equal(t, tt.expectedETag, rec.Header().Get(presenter.HeaderETag))
 		})
 	}
 }
@@ -85,7 +141,7 @@ func TestExperienceHandler_GetAll(t *testing.T) {
 		{
 			name: "正常系: 体験一覧を取得できる",
 			setupMock: func(mock *mock_usecase.MockExperienceUsecase) {
-				mock.EXPECT().GetAll().Return([]usecase.ExperienceDto{
+				mock.EXPECT().GetAll(gomock.Any()).Return([]usecase.ExperienceDto{
 					{ID: 1, Title: "体験1"},
 					{ID: 2, Title: "体験2"},
 				}, nil)
@@ -96,10 +152,10 @@ func TestExperienceHandler_GetAll(t *testing.T) {
 		{
 			name: "異常系: 体験一覧の取得に失敗",
 			setupMock: func(mock *mock_usecase.MockExperienceUsecase) {
-				mock.EXPECT().GetAll().Return(nil, errors.New("データベースエラー"))
+				mock.EXPECT().GetAll(gomock.Any()).Return(nil, errors.New("データベースエラー"))
 			},
 			expectedStatus: http.StatusInternalServerError,
-			expectedBody:   `"データベースエラー"`,
+			expectedBody:   `{"message":"データベースエラー"}`,
 		},
 	}
 
@@ -133,3 +189,529 @@ func TestExperienceHandler_GetAll(t *testing.T) {
 		})
 	}
 }
+
+func TestExperienceHandler_ErrorResponseTraceID(t *testing.T) {
+	tp := sdktrace.NewTracerProvider()
+	ctx, span := tp.Tracer("test").Start(context.Background(), "request")
+	defer span.End()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/experiences", nil).WithContext(ctx)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockUsecase := mock_usecase.NewMockExperienceUsecase(ctrl)
+	mockUsecase.EXPECT().GetAll(gomock.Any()).Return(nil, errors.New("データベースエラー"))
+
+	handler := presenter.NewExperienceHandler(mockUsecase)
+	err := handler.GetAll(c)
+
+	// エラーレスポンスにトレースIDが含まれること
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.JSONEq(t, `{"message":"データベースエラー","trace_id":"`+span.SpanContext().TraceID().String()+`"}`, rec.Body.String())
+}
+
+func TestExperienceHandler_Get(t *testing.T) {
+	tests := []struct {
+		name           string
+		id             string
+		setupMock      func(mock *mock_usecase.MockExperienceUsecase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "正常系: 体験を取得できる",
+			id:   "1",
+			setupMock: func(mock *mock_usecase.MockExperienceUsecase) {
+				mock.EXPECT().Get(gomock.Any(), 1).Return(usecase.ExperienceDto{ID: 1, Title: "体験1", Version: 3}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"id":1,"title":"体験1"}`,
+		},
+		{
+			name: "異常系: 体験が存在しない",
+			id:   "99",
+			setupMock: func(mock *mock_usecase.MockExperienceUsecase) {
+				mock.EXPECT().Get(gomock.Any(), 99).Return(usecase.ExperienceDto{}, usecase.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "異常系: IDが数値でない",
+			id:             "abc",
+			setupMock:      func(mock *mock_usecase.MockExperienceUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/experiences/"+tt.id, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.id)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockExperienceUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewExperienceHandler(mockUsecase)
+			err := handler.Get(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+				assert.Equal(t, `"3"`, rec.Header().Get(presenter.HeaderETag))
+			}
+		})
+	}
+}
+
+func TestExperienceHandler_Update(t *testing.T) {
+	tests := []struct {
+		name           string
+		ifMatch        string
+		requestBody    string
+		setupMock      func(mock *mock_usecase.MockExperienceUsecase)
+		expectedStatus int
+		expectedBody   string
+		expectedETag   string
+	}{
+		{
+			name:        "正常系: 体験を更新でき、新しいバージョンを ETag で返す",
+			ifMatch:     `"1"`,
+			requestBody: `{"title":"更新後"}`,
+			setupMock: func(mock *mock_usecase.MockExperienceUsecase) {
+				mock.EXPECT().Update(gomock.Any(), 1, 1, usecase.ExperienceInput{Title: "更新後"}).Return(usecase.ExperienceDto{ID: 1, Title: "更新後", Version: 2}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"id":1,"title":"更新後"}`,
+			expectedETag:   `"2"`,
+		},
+		{
+			name:        "異常系: バージョンが一致しない場合は現在の内容を返す",
+			ifMatch:     `"1"`,
+			requestBody: `{"title":"更新後"}`,
+			setupMock: func(mock *mock_usecase.MockExperienceUsecase) {
+				mock.EXPECT().Update(gomock.Any(), 1, 1, usecase.ExperienceInput{Title: "更新後"}).Return(usecase.ExperienceDto{}, &usecase.ExperienceConflictError{
+					Current: usecase.ExperienceDto{ID: 1, Title: "他の人の更新", Version: 2},
+				})
+			},
+			expectedStatus: http.StatusPreconditionFailed,
+			expectedBody:   `{"message":"experience 1 has been modified (current version 2)","current":{"id":1,"title":"他の人の更新"}}`,
+			expectedETag:   `"2"`,
+		},
+		{
+			name:           "異常系: If-Match がない",
+			requestBody:    `{"title":"更新後"}`,
+			setupMock:      func(mock *mock_usecase.MockExperienceUsecase) {},
+			expectedStatus: http.StatusPreconditionRequired,
+		},
+		{
+			name:           "異常系: If-Match が不正",
+			ifMatch:        `"abc"`,
+			requestBody:    `{"title":"更新後"}`,
+			setupMock:      func(mock *mock_usecase.MockExperienceUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "異常系: タイトルが空",
+			ifMatch:     `"1"`,
+			requestBody: `{"title":""}`,
+			setupMock: func(mock *mock_usecase.MockExperienceUsecase) {
+				mock.EXPECT().Update(gomock.Any(), 1, 1, usecase.ExperienceInput{Title: ""}).Return(usecase.ExperienceDto{}, usecase.ErrInvalidInput)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/experiences/1", strings.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tt.ifMatch != "" {
+				req.Header.Set(presenter.HeaderIfMatch, tt.ifMatch)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("1")
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockExperienceUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewExperienceHandler(mockUsecase)
+			err := handler.Update(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+			assert.Equal(t, tt.expectedETag, rec.Header().Get(presenter.HeaderETag))
+		})
+	}
+}
+
+func TestExperienceHandler_DiffRevisions(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/experiences/1/revisions/diff?from=1&to=2", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockUsecase := mock_usecase.NewMockExperienceUsecase(ctrl)
+	mockUsecase.EXPECT().DiffRevisions(gomock.Any(), 1, 1, 2).Return([]usecase.FieldDiffDto{
+		{Field: "title", From: "更新前", To: "更新後"},
+	}, nil)
+
+	handler := presenter.NewExperienceHandler(mockUsecase)
+	err := handler.DiffRevisions(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `[{"field":"title","from":"更新前","to":"更新後"}]`, rec.Body.String())
+}
+
+func TestExperienceHandler_Delete(t *testing.T) {
+	tests := []struct {
+		name           string
+		ifMatch        string
+		setupMock      func(mock *mock_usecase.MockExperienceUsecase)
+		expectedStatus int
+	}{
+		{
+			name:    "正常系: 体験を削除できる",
+			ifMatch: `W/"3"`,
+			setupMock: func(mock *mock_usecase.MockExperienceUsecase) {
+				mock.EXPECT().Delete(gomock.Any(), 1, 3).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:    "異常系: バージョンが一致しない",
+			ifMatch: `"2"`,
+			setupMock: func(mock *mock_usecase.MockExperienceUsecase) {
+				mock.EXPECT().Delete(gomock.Any(), 1, 2).Return(&usecase.ExperienceConflictError{
+					Current: usecase.ExperienceDto{ID: 1, Title: "体験1", Version: 3},
+				})
+			},
+			expectedStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:           "異常系: If-Match がない",
+			setupMock:      func(mock *mock_usecase.MockExperienceUsecase) {},
+			expectedStatus: http.StatusPreconditionRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/experiences/1", nil)
+			if tt.ifMatch != "" {
+				req.Header.Set(presenter.HeaderIfMatch, tt.ifMatch)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("1")
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockExperienceUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewExperienceHandler(mockUsecase)
+			err := handler.Delete(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestExperienceHandler_ImportJSONResume(t *testing.T) {
+	start := time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	year := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		file           string
+		setupMock      func(mock *mock_usecase.MockExperienceUsecase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "正常系: 職歴と案件を業務経歴として取り込める",
+			file: `{
+				"basics": {"name": "Taro Yamada"},
+				"work": [{"name": "Example Inc.", "position": "Backend Engineer", "startDate": "2024-04-01", "endDate": "2025-03", "keywords": ["Go", "gRPC"]}],
+				"projects": [{"name": "社内ツールの保守", "startDate": "2024", "roles": ["実装", "operation", "PM"]}]
+			}`,
+			setupMock: func(mock *mock_usecase.MockExperienceUsecase) {
+				mock.EXPECT().Import(gomock.Any(), []usecase.ExperienceImportInput{
+					{Title: "Backend Engineer（Example Inc.）", StartMonth: &start, EndMonth: &end, Technologies: []string{"Go", "gRPC"}},
+					{Title: "社内ツールの保守", StartMonth: &year, Phases: []string{"implementation", "operation"}},
+				}, false).Return(usecase.ImportResultDto{
+					Created: []usecase.ExperienceDto{
+						{ID: 1, Title: "Backend Engineer（Example Inc.）", StartMonth: &start, EndMonth: &end, Skills: []usecase.SkillDto{{Category: "language", Name: "Go"}}},
+						{ID: 2, Title: "社内ツールの保守"},
+					},
+					Unmatched: []usecase.UnmatchedTechnologyDto{{Index: 0, Name: "gRPC"}},
+				}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: `{
+				"dry_run": false,
+				"created": [
+					{"id":1,"title":"Backend Engineer（Example Inc.）","start_month":"2024-04","end_month":"2025-03","skills":[{"category":"language","name":"Go"}]},
+					{"id":2,"title":"社内ツールの保守"}
+				],
+				"unmatched": [{"index":0,"name":"gRPC"}]
+			}`,
+		},
+		{
+			name:           "異常系: JSON ではない",
+			file:           `name,title`,
+			setupMock:      func(mock *mock_usecase.MockExperienceUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "異常系: 日付の形式が不正",
+			file:           `{"projects": [{"name": "社内ツールの保守", "startDate": "2024/04"}]}`,
+			setupMock:      func(mock *mock_usecase.MockExperienceUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "異常系: 業務経歴が不正",
+			file: `{"projects": [{"name": ""}]}`,
+			setupMock: func(mock *mock_usecase.MockExperienceUsecase) {
+				mock.EXPECT().Import(gomock.Any(), gomock.Any(), false).Return(usecase.ImportResultDto{}, &usecase.ImportValidationError{
+					Errors: []usecase.ImportRowErrorDto{{Index: 0, Message: "title is required"}},
+				})
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"message":"1 of 1 rows are invalid","errors":[{"index":0,"message":"title is required"}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body bytes.Buffer
+			writer := multipart.NewWriter(&body)
+			part, err := writer.CreateFormFile("file", "resume.json")
+			require.NoError(t, err)
+			_, err = part.Write([]byte(tt.file))
+			require.NoError(t, err)
+			require.NoError(t, writer.Close())
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/experiences/import/json-resume", &body)
+			req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockExperienceUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewExperienceHandler(mockUsecase)
+			err = handler.ImportJSONResume(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestExperienceHandler_Import(t *testing.T) {
+	april := time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)
+	march := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	october := time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+	// 標準のスキルシートの業務経歴シートと同じ形式の xlsx
+	skillSheet := func(t *testing.T) []byte {
+		f := excelize.NewFile()
+		defer f.Close()
+		_, err := f.NewSheet("業務経歴")
+		require.NoError(t, err)
+		require.NoError(t, f.SetSheetRow("業務経歴", "A1", &[]string{"No", "期間", "業務内容", "顧客", "使用技術", "規模", "要件定義", "基本設計", "詳細設計", "実装", "テスト", "運用"}))
+		require.NoError(t, f.SetSheetRow("業務経歴", "A2", &[]interface{}{1, "2024年04月 〜 2025年03月 （1年）", "決済基盤の開発", "A社", "Go、AWS", 8, "", "●", "", "●"}))
+		require.NoError(t, f.SetSheetRow("業務経歴", "A4", &[]interface{}{2, "2023年10月 〜 現在 （3年1ヶ月）", "社内ツールの保守", "", "", ""}))
+		var buf bytes.Buffer
+		require.NoError(t, f.Write(&buf))
+		return buf.Bytes()
+	}
+	shiftJIS := func(t *testing.T, s string) []byte {
+		b, _, err := transform.Bytes(japanese.ShiftJIS.NewEncoder(), []byte(s))
+		require.NoError(t, err)
+		return b
+	}
+
+	tests := []struct {
+		name           string
+		fileName       string
+		file           func(t *testing.T) []byte
+		query          string
+		setupMock      func(mock *mock_usecase.MockExperienceUsecase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:     "正常系: CSV を dry run で検証できる",
+			fileName: "experiences.csv",
+			file: func(t *testing.T) []byte {
+				return []byte("\xef\xbb\xbftitle,client_name,client_alias,start_month,end_month,team_size,skills,phases\n" +
+					"決済基盤の開発,株式会社〇〇銀行,大手金融機関向け,2024-04,2025/3,8名,\"Go, AWS, gRPC\",\"implementation,基本設計\"\n" +
+					",,,,,,,\n" +
+					"社内ツールの保守,,,2023年10月,,,,\n")
+			},
+			query: "?dry_run=true",
+			setupMock: func(mock *mock_usecase.MockExperienceUsecase) {
+				mock.EXPECT().Import(gomock.Any(), []usecase.ExperienceImportInput{
+					{Title: "決済基盤の開発", ClientName: "株式会社〇〇銀行", ClientAlias: "大手金融機関向け", StartMonth: &april, EndMonth: &march, TeamSize: 8, Technologies: []string{"Go", "AWS", "gRPC"}, Phases: []string{"implementation", "basic_design"}},
+					{Title: "社内ツールの保守", StartMonth: &october},
+				}, true).Return(usecase.ImportResultDto{
+					Created: []usecase.ExperienceDto{
+						{Title: "決済基盤の開発", StartMonth: &april, EndMonth: &march, TeamSize: 8},
+						{Title: "社内ツールの保守", StartMonth: &october},
+					},
+					Unmatched: []usecase.UnmatchedTechnologyDto{{Index: 0, Name: "gRPC"}},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{
+				"dry_run": true,
+				"created": [
+					{"id":0,"title":"決済基盤の開発","start_month":"2024-04","end_month":"2025-03","team_size":8},
+					{"id":0,"title":"社内ツールの保守","start_month":"2023-10"}
+				],
+				"unmatched": [{"index":0,"row":2,"name":"gRPC"}]
+			}`,
+		},
+		{
+			name:     "正常系: 標準のスキルシートの xlsx を取り込める",
+			fileName: "スキルシート.xlsx",
+			file:     skillSheet,
+			setupMock: func(mock *mock_usecase.MockExperienceUsecase) {
+				mock.EXPECT().Import(gomock.Any(), []usecase.ExperienceImportInput{
+					{Title: "決済基盤の開発", ClientName: "A社", StartMonth: &april, EndMonth: &march, TeamSize: 8, Technologies: []string{"Go", "AWS"}, Phases: []string{"basic_design", "implementation"}},
+					{Title: "社内ツールの保守", StartMonth: &october},
+				}, false).Return(usecase.ImportResultDto{
+					Created:   []usecase.ExperienceDto{{ID: 1, Title: "決済基盤の開発"}, {ID: 2, Title: "社内ツールの保守"}},
+					Unmatched: []usecase.UnmatchedTechnologyDto{},
+				}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"dry_run":false,"created":[{"id":1,"title":"決済基盤の開発"},{"id":2,"title":"社内ツールの保守"}],"unmatched":[]}`,
+		},
+		{
+			name:     "正常系: Shift_JIS の CSV を取り込める",
+			fileName: "experiences.csv",
+			file: func(t *testing.T) []byte {
+				return shiftJIS(t, "業務内容,開始年月\r\n決済基盤の開発,2024/04\r\n")
+			},
+			setupMock: func(mock *mock_usecase.MockExperienceUsecase) {
+				mock.EXPECT().Import(gomock.Any(), []usecase.ExperienceImportInput{
+					{Title: "決済基盤の開発", StartMonth: &april},
+				}, false).Return(usecase.ImportResultDto{
+					Created:   []usecase.ExperienceDto{{ID: 1, Title: "決済基盤の開発", StartMonth: &april}},
+					Unmatched: []usecase.UnmatchedTechnologyDto{},
+				}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"dry_run":false,"created":[{"id":1,"title":"決済基盤の開発","start_month":"2024-04"}],"unmatched":[]}`,
+		},
+		{
+			name:     "異常系: 読み込めない行と検証エラーの行をまとめて返し、登録しない",
+			fileName: "experiences.csv",
+			file: func(t *testing.T) []byte {
+				return []byte("title,start_month,team_size\n" +
+					"決済基盤の開発,2024-04,8\n" +
+					",2024-04,\n" +
+					"社内ツールの保守,2024-13,many\n")
+			},
+			setupMock: func(mock *mock_usecase.MockExperienceUsecase) {
+				mock.EXPECT().Import(gomock.Any(), []usecase.ExperienceImportInput{
+					{Title: "決済基盤の開発", StartMonth: &april, TeamSize: 8},
+					{StartMonth: &april},
+				}, true).Return(usecase.ImportResultDto{}, &usecase.ImportValidationError{
+					Errors: []usecase.ImportRowErrorDto{{Index: 1, Message: "title is required"}},
+				})
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: `{
+				"message": "2 of 3 rows are invalid",
+				"errors": [
+					{"index":1,"row":3,"message":"title is required"},
+					{"index":2,"row":4,"message":"invalid month \"2024-13\": must be YYYY-MM\ninvalid team size \"many\""}
+				]
+			}`,
+		},
+		{
+			name:           "異常系: 業務内容の列がない",
+			fileName:       "experiences.csv",
+			file:           func(t *testing.T) []byte { return []byte("name,start_month\n決済基盤の開発,2024-04\n") },
+			setupMock:      func(mock *mock_usecase.MockExperienceUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "異常系: 対応していないファイル形式",
+			fileName:       "experiences.json",
+			file:           func(t *testing.T) []byte { return []byte("{}") },
+			setupMock:      func(mock *mock_usecase.MockExperienceUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body bytes.Buffer
+			writer := multipart.NewWriter(&body)
+			part, err := writer.CreateFormFile("file", tt.fileName)
+			require.NoError(t, err)
+			_, err = part.Write(tt.file(t))
+			require.NoError(t, err)
+			require.NoError(t, writer.Close())
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/experiences/import"+tt.query, &body)
+			req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockExperienceUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewExperienceHandler(mockUsecase)
+			err = handler.Import(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,324 @@
+package presenter
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"stackies/backend/usecase"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/xuri/excelize/v2"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+// importRow は取り込むファイルの1行（JSON Resume の場合は職歴・案件の1件）です
+type importRow struct {
+	// row はファイルの行番号（1 始まり）です。JSON Resume の場合は 0 です
+	row   int
+	input usecase.ExperienceImportInput
+	// err は行を読み込めなかった理由です
+	err error
+}
+
+// importColumn は取り込むファイルの列の種類です
+type importColumn int
+
+const (
+	importColumnTitle importColumn = iota + 1
+	importColumnClientName
+	importColumnClientAlias
+	importColumnIndustry
+	importColumnStartMonth
+	importColumnEndMonth
+	importColumnPeriod
+	importColumnTeamSize
+	importColumnTechnologies
+	importColumnPhases
+	// importColumnPhase は工程ごとの列です（● などの印があれば担当）
+	importColumnPhase
+)
+
+// importHeaders は見出しの名前と列の種類の対応です
+// 見出しは大文字・小文字と前後の空白を区別せず、ここにない列は読み飛ばします
+// 標準のスキルシート（GET /me/resume.xlsx）の業務経歴シートもそのまま取り込めます
+var importHeaders = map[string]importColumn{
+	"title":        importColumnTitle,
+	"業務内容":         importColumnTitle,
+	"案件名":          importColumnTitle,
+	"client_name":  importColumnClientName,
+	"顧客":           importColumnClientName,
+	"顧客名":          importColumnClientName,
+	"client_alias": importColumnClientAlias,
+	"顧客名（公開用）":     importColumnClientAlias,
+	"industry":     importColumnIndustry,
+	"業種":           importColumnIndustry,
+	"業界":           importColumnIndustry,
+	"start_month":  importColumnStartMonth,
+	"開始年月":         importColumnStartMonth,
+	"end_month":    importColumnEndMonth,
+	"終了年月":         importColumnEndMonth,
+	"period":       importColumnPeriod,
+	"期間":           importColumnPeriod,
+	"team_size":    importColumnTeamSize,
+	"規模":           importColumnTeamSize,
+	"チーム人数":        importColumnTeamSize,
+	"skills":       importColumnTechnologies,
+	"使用技術":         importColumnTechnologies,
+	"languages":    importColumnTechnologies,
+	"言語":           importColumnTechnologies,
+	"tools":        importColumnTechnologies,
+	"ツール":          importColumnTechnologies,
+	"phases":       importColumnPhases,
+	"担当工程":         importColumnPhases,
+}
+
+// maxImportRows は取り込むファイルの最大行数（見出しを除く）です
+const maxImportRows = 200
+
+var (
+	// importListSeparator は使用技術・担当工程の区切り文字です
+	importListSeparator = regexp.MustCompile(`[,、，;；\n]`)
+	// importMonthPattern は年月です（"2024-04"、"2024/4"、"2024年4月"、"2024.04"）
+	importMonthPattern = regexp.MustCompile(`^(\d{4})\s*[-/.年]\s*(\d{1,2})\s*月?$`)
+	// importPeriodSeparator は期間の開始と終了の区切りです（"2024年04月 〜 2025年03月（1年）"）
+	importPeriodSeparator = regexp.MustCompile(`\s*[〜~～]\s*`)
+)
+
+// readImportCSV は CSV を読み込みます。文字コードは UTF-8（BOM 付きを含む）と Shift_JIS に対応します
+func readImportCSV(r io.Reader) ([]importRow, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	if !utf8.Valid(data) {
+		// Excel で保存した CSV は Shift_JIS のことが多い
+		if data, _, err = transform.Bytes(japanese.ShiftJIS.NewDecoder(), data); err != nil {
+			return nil, fmt.Errorf("csv must be encoded in UTF-8 or Shift_JIS: %w", err)
+		}
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read csv: %w", err)
+	}
+	return parseImportRecords(records)
+}
+
+// readImportXLSX は xlsx を読み込みます
+// 「業務経歴」シートがあればそのシートを、なければ最初のシートを読み込みます
+func readImportXLSX(r io.Reader) ([]importRow, error) {
+	f, err := excelize.OpenReader(r, excelize.Options{UnzipSizeLimit: maxTemplateUnzipSize, RawCellValue: true})
+	if err != nil {
+		return nil, errors.New("file must be an xlsx file")
+	}
+	defer f.Close()
+
+	sheet := f.GetSheetName(0)
+	if index, _ := f.GetSheetIndex("業務経歴"); index >= 0 {
+		sheet = "業務経歴"
+	}
+	records, err := f.GetRows(sheet, excelize.Options{RawCellValue: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sheet %q: %w", sheet, err)
+	}
+	return parseImportRecords(records)
+}
+
+// parseImportRecords は1行目を見出しとして、2行目以降を取り込む業務経歴にします
+// 空の行は読み飛ばし、読み込めない値がある行は err を設定して返します
+func parseImportRecords(records [][]string) ([]importRow, error) {
+	if len(records) == 0 {
+		return nil, errors.New("file is empty")
+	}
+	columns := make([]importColumn, len(records[0]))
+	phases := make(map[int]string)
+	hasTitle := false
+	for i, header := range records[0] {
+		header = strings.ToLower(strings.TrimSpace(header))
+		columns[i] = importHeaders[header]
+		for _, column := range resumePhaseColumns {
+			if header == column.phase || header == column.label {
+				columns[i] = importColumnPhase
+				phases[i] = column.phase
+			}
+		}
+		if columns[i] == importColumnTitle {
+			hasTitle = true
+		}
+	}
+	if !hasTitle {
+		return nil, errors.New("header must have a title (業務内容) column")
+	}
+
+	var rows []importRow
+	for i, record := range records[1:] {
+		if isBlankRecord(record) {
+			continue
+		}
+		if len(rows) == maxImportRows {
+			return nil, fmt.Errorf("file must have at most %d rows", maxImportRows)
+		}
+		input, err := parseImportRecord(columns, phases, record)
+		rows = append(rows, importRow{row: i + 2, input: input, err: err})
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("file has no rows to import")
+	}
+	return rows, nil
+}
+
+func parseImportRecord(columns []importColumn, phases map[int]string, record []string) (usecase.ExperienceImportInput, error) {
+	var input usecase.ExperienceImportInput
+	var errs []error
+	for i, value := range record {
+		if i >= len(columns) {
+			break
+		}
+		value = strings.TrimSpace(value)
+		var err error
+		switch columns[i] {
+		case importColumnTitle:
+			input.Title = value
+		case importColumnClientName:
+			input.ClientName = value
+		case importColumnClientAlias:
+			input.ClientAlias = value
+		case importColumnIndustry:
+			input.Industry = value
+		case importColumnStartMonth:
+			input.StartMonth, err = parseImportMonth(value)
+		case importColumnEndMonth:
+			input.EndMonth, err = parseImportMonth(value)
+		case importColumnPeriod:
+			if input.StartMonth == nil && input.EndMonth == nil {
+				input.StartMonth, input.EndMonth, err = parseImportPeriod(value)
+			}
+		case importColumnTeamSize:
+			input.TeamSize, err = parseImportTeamSize(value)
+		case importColumnTechnologies:
+			input.Technologies = append(input.Technologies, splitImportList(value)...)
+		case importColumnPhases:
+			for _, name := range splitImportList(value) {
+				phase, ok := parseImportPhase(name)
+				if !ok {
+					err = fmt.Errorf("unknown phase %q", name)
+					break
+				}
+				input.Phases = append(input.Phases, phase)
+			}
+		case importColumnPhase:
+			if value != "" && value != "-" && value != "×" {
+				input.Phases = append(input.Phases, phases[i])
+			}
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return input, errors.Join(errs...)
+}
+
+// parseImportMonth は年月を月初にして返します
+// xlsx の日付のセル（シリアル値）と "2024-04"、"2024/4"、"2024年4月"、"2024-04-01" の形式に対応します
+func parseImportMonth(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	// 4桁以下の数値は年とみなしてシリアル値として扱わない
+	if serial, err := strconv.ParseFloat(value, 64); err == nil && serial >= 10000 {
+		t, err := excelize.ExcelDateToTime(serial, false)
+		if err == nil {
+			month := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+			return &month, nil
+		}
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		month := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return &month, nil
+	}
+	if m := importMonthPattern.FindStringSubmatch(value); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		month, _ := strconv.Atoi(m[2])
+		if month >= 1 && month <= 12 {
+			t := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid month %q: must be YYYY-MM", value)
+}
+
+// parseImportPeriod は「2024年04月 〜 2025年03月（1年）」の形式の期間を読み込みます
+// 終了が「現在」または空の場合は参画中とします
+func parseImportPeriod(value string) (start, end *time.Time, err error) {
+	if value == "" {
+		return nil, nil, nil
+	}
+	// 末尾の「（1年）」のような期間の長さは読み飛ばす
+	if i := strings.IndexAny(value, "（("); i >= 0 {
+		value = strings.TrimSpace(value[:i])
+	}
+	parts := importPeriodSeparator.Split(value, 2)
+	if start, err = parseImportMonth(strings.TrimSpace(parts[0])); err != nil {
+		return nil, nil, err
+	}
+	if len(parts) == 2 {
+		if last := strings.TrimSpace(parts[1]); last != "現在" {
+			if end, err = parseImportMonth(last); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	return start, end, nil
+}
+
+// parseImportTeamSize はチーム人数を読み込みます（"8"、"8名"、"8人"）
+func parseImportTeamSize(value string) (int, error) {
+	trimmed := strings.TrimSpace(strings.TrimRight(value, "名人"))
+	if trimmed == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(trimmed)
+	if err != nil {
+		return 0, fmt.Errorf("invalid team size %q", value)
+	}
+	return n, nil
+}
+
+// parseImportPhase は工程の名前（"implementation" または「実装」）を読み込みます
+func parseImportPhase(name string) (string, bool) {
+	for _, column := range resumePhaseColumns {
+		if strings.EqualFold(name, column.phase) || name == column.label {
+			return column.phase, true
+		}
+	}
+	return "", false
+}
+
+func splitImportList(value string) []string {
+	var items []string
+	for _, item := range importListSeparator.Split(value, -1) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func isBlankRecord(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
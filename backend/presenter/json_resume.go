@@ -0,0 +1,206 @@
+package presenter
+
+import (
+	"fmt"
+	"stackies/backend/usecase"
+	"strings"
+	"time"
+)
+
+// jsonResumeSchema は出力する JSON Resume のスキーマです
+const jsonResumeSchema = "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json"
+
+// jsonResume は JSON Resume（https://jsonresume.org/schema）形式の業務経歴書です
+// Stackies で扱う項目だけを定義しています。業務経歴は案件単位のため projects に出力します
+type jsonResume struct {
+	Schema       string                  `json:"$schema,omitempty"`
+	Basics       jsonResumeBasics        `json:"basics"`
+	Work         []jsonResumeWork        `json:"work,omitempty"`
+	Projects     []jsonResumeProject     `json:"projects"`
+	Education    []jsonResumeEducation   `json:"education"`
+	Certificates []jsonResumeCertificate `json:"certificates"`
+	Skills       []jsonResumeSkill       `json:"skills"`
+	Meta         *jsonResumeMeta         `json:"meta,omitempty"`
+}
+
+type jsonResumeBasics struct {
+	Name    string `json:"name"`
+	Label   string `json:"label,omitempty"`
+	Summary string `json:"summary,omitempty"`
+}
+
+// jsonResumeWork は職歴です。取り込みのみに使います
+// keywords はスキーマにはありませんが、使用技術を書く例が多いため読み込みます
+type jsonResumeWork struct {
+	Name      string   `json:"name"`
+	Position  string   `json:"position"`
+	Summary   string   `json:"summary"`
+	StartDate string   `json:"startDate"`
+	EndDate   string   `json:"endDate"`
+	Keywords  []string `json:"keywords"`
+}
+
+type jsonResumeProject struct {
+	Name string `json:"name"`
+	// Entity は顧客名です。顧客名を除いた業務経歴書では公開用の名前を出力します
+	Entity      string   `json:"entity,omitempty"`
+	Description string   `json:"description,omitempty"`
+	StartDate   string   `json:"startDate,omitempty"`
+	EndDate     string   `json:"endDate,omitempty"`
+	Keywords    []string `json:"keywords,omitempty"`
+	// Roles は担当した工程です
+	Roles []string `json:"roles,omitempty"`
+}
+
+type jsonResumeEducation struct {
+	Institution string `json:"institution"`
+	Area        string `json:"area,omitempty"`
+	StudyType   string `json:"studyType,omitempty"`
+	StartDate   string `json:"startDate,omitempty"`
+	EndDate     string `json:"endDate,omitempty"`
+}
+
+type jsonResumeCertificate struct {
+	Name   string `json:"name"`
+	Date   string `json:"date,omitempty"`
+	Issuer string `json:"issuer,omitempty"`
+}
+
+// jsonResumeSkill はスキルです。level に経験期間、keywords に区分（言語・ツール）を出力します
+type jsonResumeSkill struct {
+	Name     string   `json:"name"`
+	Level    string   `json:"level,omitempty"`
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+type jsonResumeMeta struct {
+	Version      string `json:"version,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+}
+
+func newJSONResume(resume usecase.ResumeDto) jsonResume {
+	profile := resume.Profile
+	r := jsonResume{
+		Schema: jsonResumeSchema,
+		Basics: jsonResumeBasics{
+			Name:    profile.DisplayName,
+			Label:   profile.Headline,
+			Summary: profile.SelfPR,
+		},
+		Projects:     []jsonResumeProject{},
+		Education:    []jsonResumeEducation{},
+		Certificates: []jsonResumeCertificate{},
+		Skills:       []jsonResumeSkill{},
+		Meta:         &jsonResumeMeta{Version: "v1.0.0", LastModified: resume.GeneratedAt.Format(time.RFC3339)},
+	}
+	for _, e := range resume.Experiences {
+		project := jsonResumeProject{
+			Name:      e.Title,
+			Entity:    clientLabel(e),
+			StartDate: formatMonth(e.StartMonth),
+			EndDate:   formatMonth(e.EndMonth),
+		}
+		if e.TeamSize > 0 {
+			project.Description = "チーム規模: " + formatTeamSize(e.TeamSize, resume.Anonymized)
+		}
+		for _, s := range e.Skills {
+			project.Keywords = append(project.Keywords, s.Name)
+		}
+		for _, phase := range e.Phases {
+			project.Roles = append(project.Roles, phaseLabel(phase))
+		}
+		r.Projects = append(r.Projects, project)
+	}
+	for _, e := range profile.Educations {
+		r.Education = append(r.Education, jsonResumeEducation{
+			Institution: e.SchoolName,
+			Area:        e.Faculty,
+			StudyType:   e.Degree,
+			StartDate:   formatMonth(e.StartMonth),
+			EndDate:     formatMonth(e.EndMonth),
+		})
+	}
+	for _, c := range profile.Certifications {
+		r.Certificates = append(r.Certificates, jsonResumeCertificate{
+			Name:   c.Name,
+			Date:   formatMonth(c.AcquiredMonth),
+			Issuer: c.Issuer,
+		})
+	}
+	for _, s := range resume.Skills {
+		r.Skills = append(r.Skills, jsonResumeSkill{
+			Name:     s.Name,
+			Level:    formatDuration(s.Months),
+			Keywords: []string{skillCategoryLabel(s.Category)},
+		})
+	}
+	return r
+}
+
+// toImportRows は職歴（work）と案件（projects）を取り込む業務経歴に変換します
+// 職歴の業務内容は「役職（会社名）」、案件は名前にします。使用技術は keywords から読み込みます
+func (r jsonResume) toImportRows() []importRow {
+	var rows []importRow
+	for i, w := range r.Work {
+		title := strings.TrimSpace(w.Position)
+		if name := strings.TrimSpace(w.Name); name != "" {
+			if title == "" {
+				title = name
+			} else {
+				title += "（" + name + "）"
+			}
+		}
+		input, err := newImportInput(title, w.StartDate, w.EndDate, w.Keywords, nil)
+		if err != nil {
+			err = fmt.Errorf("work[%d]: %w", i, err)
+		}
+		rows = append(rows, importRow{input: input, err: err})
+	}
+	for i, p := range r.Projects {
+		title := strings.TrimSpace(p.Name)
+		if title == "" {
+			title = strings.TrimSpace(p.Description)
+		}
+		input, err := newImportInput(title, p.StartDate, p.EndDate, p.Keywords, p.Roles)
+		if err != nil {
+			err = fmt.Errorf("projects[%d]: %w", i, err)
+		}
+		input.ClientName = strings.TrimSpace(p.Entity)
+		rows = append(rows, importRow{input: input, err: err})
+	}
+	return rows
+}
+
+// newImportInput は取り込む業務経歴を作成します
+// roles のうち工程の名前（"implementation" や「実装」）に一致するものを担当工程にします
+func newImportInput(title, startDate, endDate string, keywords, roles []string) (usecase.ExperienceImportInput, error) {
+	input := usecase.ExperienceImportInput{Title: title, Technologies: keywords}
+	var err error
+	if input.StartMonth, err = parseJSONResumeDate(startDate); err != nil {
+		return usecase.ExperienceImportInput{}, err
+	}
+	if input.EndMonth, err = parseJSONResumeDate(endDate); err != nil {
+		return usecase.ExperienceImportInput{}, err
+	}
+	for _, role := range roles {
+		if phase, ok := parseImportPhase(strings.TrimSpace(role)); ok {
+			input.Phases = append(input.Phases, phase)
+		}
+	}
+	return input, nil
+}
+
+// parseJSONResumeDate は JSON Resume の日付（"2024-04-01"、"2024-04"、"2024"）を月初にして返します
+func parseJSONResumeDate(value string) (*time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	for _, layout := range []string{"2006-01-02", monthLayout, "2006"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			month := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+			return &month, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid date %q: must be YYYY-MM-DD, YYYY-MM or YYYY", value)
+}
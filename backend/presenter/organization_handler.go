@@ -0,0 +1,404 @@
+package presenter
+
+import (
+	"net/http"
+	"stackies/backend/usecase"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type organizationHandler struct {
+	organizationUsecase usecase.OrganizationUsecase
+}
+
+// OrganizationRequest は組織の作成のリクエストです
+type OrganizationRequest struct {
+	Name string `json:"name"`
+}
+
+func (r *OrganizationRequest) ConvertToInput() usecase.OrganizationInput {
+	return usecase.OrganizationInput{Name: r.Name}
+}
+
+// TeamRequest はチームの作成のリクエストです
+type TeamRequest struct {
+	Name string `json:"name"`
+}
+
+func (r *TeamRequest) ConvertToInput() usecase.TeamInput {
+	return usecase.TeamInput{Name: r.Name}
+}
+
+// TeamMemberRequest はチームへの所属のリクエストです
+type TeamMemberRequest struct {
+	// Role は member または manager です（省略時は member）
+	Role string `json:"role"`
+}
+
+func (r *TeamMemberRequest) ConvertToInput() usecase.TeamMemberInput {
+	return usecase.TeamMemberInput{Role: r.Role}
+}
+
+type OrganizationResponse struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (r *OrganizationResponse) ConvertToDto(organization usecase.OrganizationDto) {
+	r.ID = organization.ID
+	r.Name = organization.Name
+	r.CreatedAt = organization.CreatedAt
+}
+
+type TeamResponse struct {
+	ID             int       `json:"id"`
+	OrganizationID int       `json:"organization_id"`
+	Name           string    `json:"name"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+func (r *TeamResponse) ConvertToDto(team usecase.TeamDto) {
+	r.ID = team.ID
+	r.OrganizationID = team.OrganizationID
+	r.Name = team.Name
+	r.CreatedAt = team.CreatedAt
+}
+
+type TeamMemberResponse struct {
+	TeamID      int       `json:"team_id"`
+	UserID      string    `json:"user_id"`
+	DisplayName string    `json:"display_name"`
+	Role        string    `json:"role"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func (r *TeamMemberResponse) ConvertToDto(member usecase.TeamMemberDto) {
+	r.TeamID = member.TeamID
+	r.UserID = member.UserID
+	r.DisplayName = member.DisplayName
+	r.Role = member.Role
+	r.CreatedAt = member.CreatedAt
+}
+
+// MembershipResponse はログイン中のユーザーが所属するチームと役割です
+type MembershipResponse struct {
+	Team TeamResponse `json:"team"`
+	Role string       `json:"role"`
+}
+
+// MemberResumeResponse はマネージャーが閲覧するメンバーの業務経歴とスキルです
+type MemberResumeResponse struct {
+	Profile     ProfileResponse        `json:"profile"`
+	Skills      []SkillSummaryResponse `json:"skills"`
+	Experiences []ExperienceResponse   `json:"experiences"`
+	GeneratedAt time.Time              `json:"generated_at"`
+}
+
+func (r *MemberResumeResponse) ConvertToDto(resume usecase.ResumeDto) {
+	r.Profile.ConvertToDto(resume.Profile)
+	r.Skills = newSkillSummaryResponses(resume.Skills)
+	r.Experiences = make([]ExperienceResponse, len(resume.Experiences))
+	for i, e := range resume.Experiences {
+		r.Experiences[i].ConvertToDto(e)
+	}
+	r.GeneratedAt = resume.GeneratedAt
+}
+
+// SkillMatrixResponse は組織のスキルとメンバーの経験期間の表です
+type SkillMatrixResponse struct {
+	Members     []MemberResponse         `json:"members"`
+	Skills      []SkillMatrixRowResponse `json:"skills"`
+	GeneratedAt time.Time                `json:"generated_at"`
+}
+
+type MemberResponse struct {
+	UserID      string `json:"user_id"`
+	DisplayName string `json:"display_name"`
+}
+
+// SkillMatrixRowResponse は1つのスキルのメンバーごとの経験期間です。経験のないメンバーは含めません
+type SkillMatrixRowResponse struct {
+	Category    string                `json:"category"`
+	Name        string                `json:"name"`
+	Members     []MemberSkillResponse `json:"members"`
+	TotalMonths int                   `json:"total_months"`
+}
+
+type MemberSkillResponse struct {
+	UserID          string `json:"user_id"`
+	Months          int    `json:"months"`
+	LastUsedMonth   string `json:"last_used_month,omitempty"`
+	ExperienceCount int    `json:"experience_count"`
+}
+
+func (r *SkillMatrixResponse) ConvertToDto(matrix usecase.SkillMatrixDto) {
+	r.Members = make([]MemberResponse, len(matrix.Members))
+	for i, m := range matrix.Members {
+		r.Members[i] = MemberResponse{UserID: m.UserID, DisplayName: m.DisplayName}
+	}
+	r.Skills = make([]SkillMatrixRowResponse, len(matrix.Skills))
+	for i, row := range matrix.Skills {
+		r.Skills[i] = SkillMatrixRowResponse{
+			Category:    row.Category,
+			Name:        row.Name,
+			Members:     newMemberSkillResponses(row.Members),
+			TotalMonths: row.TotalMonths,
+		}
+	}
+	r.GeneratedAt = matrix.GeneratedAt
+}
+
+func newMemberSkillResponses(skills []usecase.MemberSkillDto) []MemberSkillResponse {
+	responses := make([]MemberSkillResponse, len(skills))
+	for i, s := range skills {
+		responses[i] = MemberSkillResponse{
+			UserID:          s.UserID,
+			Months:          s.Months,
+			LastUsedMonth:   formatMonth(s.LastUsedMonth),
+			ExperienceCount: s.ExperienceCount,
+		}
+	}
+	return responses
+}
+
+// CreateOrganization implements OrganizationHandler.
+func (o *organizationHandler) CreateOrganization(c echo.Context) error {
+	var request OrganizationRequest
+	if err := c.Bind(&request); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	organization, err := o.organizationUsecase.CreateOrganization(c.Request().Context(), request.ConvertToInput())
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	var response OrganizationResponse
+	response.ConvertToDto(organization)
+	return c.JSON(http.StatusCreated, response)
+}
+
+// CreateTeam implements OrganizationHandler.
+func (o *organizationHandler) CreateTeam(c echo.Context) error {
+	var organizationID int
+	if err := echo.PathParamsBinder(c).MustInt("id", &organizationID).BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	var request TeamRequest
+	if err := c.Bind(&request); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	team, err := o.organizationUsecase.CreateTeam(c.Request().Context(), organizationID, request.ConvertToInput())
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	var response TeamResponse
+	response.ConvertToDto(team)
+	return c.JSON(http.StatusCreated, response)
+}
+
+// SaveTeamMember implements OrganizationHandler.
+// 所属済みのユーザーの場合は役割を変更します
+func (o *organizationHandler) SaveTeamMember(c echo.Context) error {
+	var teamID int
+	if err := echo.PathParamsBinder(c).MustInt("id", &teamID).BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	var request TeamMemberRequest
+	if err := c.Bind(&request); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	member, err := o.organizationUsecase.SaveTeamMember(c.Request().Context(), teamID, c.Param("user_id"), request.ConvertToInput())
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	var response TeamMemberResponse
+	response.ConvertToDto(member)
+	return c.JSON(http.StatusOK, response)
+}
+
+// RemoveTeamMember implements OrganizationHandler.
+func (o *organizationHandler) RemoveTeamMember(c echo.Context) error {
+	var teamID int
+	if err := echo.PathParamsBinder(c).MustInt("id", &teamID).BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	if err := o.organizationUsecase.RemoveTeamMember(c.Request().Context(), teamID, c.Param("user_id")); err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ListMyTeams implements OrganizationHandler.
+func (o *organizationHandler) ListMyTeams(c echo.Context) error {
+	memberships, err := o.organizationUsecase.ListMyTeams(c.Request().Context())
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	response := make([]MembershipResponse, len(memberships))
+	for i, m := range memberships {
+		response[i].Team.ConvertToDto(m.Team)
+		response[i].Role = m.Role
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// ListTeamMembers implements OrganizationHandler.
+func (o *organizationHandler) ListTeamMembers(c echo.Context) error {
+	var teamID int
+	if err := echo.PathParamsBinder(c).MustInt("id", &teamID).BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	members, err := o.organizationUsecase.ListTeamMembers(c.Request().Context(), teamID)
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	response := make([]TeamMemberResponse, len(members))
+	for i, m := range members {
+		response[i].ConvertToDto(m)
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetMemberResume implements OrganizationHandler.
+// チームのマネージャーだけが閲覧できます（読み取り専用）
+func (o *organizationHandler) GetMemberResume(c echo.Context) error {
+	var teamID int
+	if err := echo.PathParamsBinder(c).MustInt("id", &teamID).BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	resume, err := o.organizationUsecase.GetMemberResume(c.Request().Context(), teamID, c.Param("user_id"))
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	var response MemberResumeResponse
+	response.ConvertToDto(resume)
+	return c.JSON(http.StatusOK, response)
+}
+
+// SkillGapRequest はスキルのギャップ分析のリクエストです。team_id と user_ids のいずれか一方を指定します
+type SkillGapRequest struct {
+	TeamID       int                       `json:"team_id"`
+	UserIDs      []string                  `json:"user_ids"`
+	Requirements []SkillRequirementRequest `json:"requirements"`
+}
+
+// SkillRequirementRequest は必要なスキルです（「Go の経験が 24 か月以上の人が 2 人」など）
+type SkillRequirementRequest struct {
+	Category  string `json:"category"`
+	Name      string `json:"name"`
+	MinMonths int    `json:"min_months"`
+	// MinPeople は必要な人数です（省略時は 1 人）
+	MinPeople int `json:"min_people"`
+}
+
+func (r *SkillGapRequest) ConvertToInput() usecase.SkillGapInput {
+	return usecase.SkillGapInput{
+		TeamID:       r.TeamID,
+		UserIDs:      r.UserIDs,
+		Requirements: toSkillRequirementInputs(r.Requirements),
+	}
+}
+
+func toSkillRequirementInputs(requests []SkillRequirementRequest) []usecase.SkillRequirementInput {
+	inputs := make([]usecase.SkillRequirementInput, len(requests))
+	for i, r := range requests {
+		inputs[i] = usecase.SkillRequirementInput{
+			Category:  r.Category,
+			Name:      r.Name,
+			MinMonths: r.MinMonths,
+			MinPeople: r.MinPeople,
+		}
+	}
+	return inputs
+}
+
+// SkillGapAnalysisResponse はスキルの表と、必要なスキルに対する不足の分析です
+type SkillGapAnalysisResponse struct {
+	SkillMatrixResponse
+	Gaps      []SkillGapResponse `json:"gaps"`
+	Satisfied bool               `json:"satisfied"`
+}
+
+type SkillGapResponse struct {
+	Category   string                `json:"category"`
+	Name       string                `json:"name"`
+	MinMonths  int                   `json:"min_months"`
+	MinPeople  int                   `json:"min_people"`
+	Qualified  []MemberSkillResponse `json:"qualified"`
+	Candidates []MemberSkillResponse `json:"candidates"`
+	Shortage   int                   `json:"shortage"`
+}
+
+func (r *SkillGapAnalysisResponse) ConvertToDto(analysis usecase.SkillGapAnalysisDto) {
+	r.SkillMatrixResponse.ConvertToDto(analysis.SkillMatrixDto)
+	r.Gaps = make([]SkillGapResponse, len(analysis.Gaps))
+	for i, gap := range analysis.Gaps {
+		r.Gaps[i] = SkillGapResponse{
+			Category:   gap.Category,
+			Name:       gap.Name,
+			MinMonths:  gap.MinMonths,
+			MinPeople:  gap.MinPeople,
+			Qualified:  newMemberSkillResponses(gap.Qualified),
+			Candidates: newMemberSkillResponses(gap.Candidates),
+			Shortage:   gap.Shortage,
+		}
+	}
+	r.Satisfied = analysis.Satisfied
+}
+
+// GetOrganizationSkills implements OrganizationHandler.
+// 組織のいずれかのチームのマネージャーだけが閲覧できます
+func (o *organizationHandler) GetOrganizationSkills(c echo.Context) error {
+	var organizationID int
+	if err := echo.PathParamsBinder(c).MustInt("id", &organizationID).BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	matrix, err := o.organizationUsecase.GetOrganizationSkills(c.Request().Context(), organizationID)
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	var response SkillMatrixResponse
+	response.ConvertToDto(matrix)
+	return c.JSON(http.StatusOK, response)
+}
+
+// AnalyzeSkillGaps implements OrganizationHandler.
+// 管理しているチームのメンバーだけを分析できます
+func (o *organizationHandler) AnalyzeSkillGaps(c echo.Context) error {
+	var request SkillGapRequest
+	if err := c.Bind(&request); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	analysis, err := o.organizationUsecase.AnalyzeSkillGaps(c.Request().Context(), request.ConvertToInput())
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	var response SkillGapAnalysisResponse
+	response.ConvertToDto(analysis)
+	return c.JSON(http.StatusOK, response)
+}
+
+type OrganizationHandler interface {
+	CreateOrganization(c echo.Context) error
+	CreateTeam(c echo.Context) error
+	SaveTeamMember(c echo.Context) error
+	RemoveTeamMember(c echo.Context) error
+	ListMyTeams(c echo.Context) error
+	ListTeamMembers(c echo.Context) error
+	GetMemberResume(c echo.Context) error
+	GetOrganizationSkills(c echo.Context) error
+	AnalyzeSkillGaps(c echo.Context) error
+}
+
+func NewOrganizationHandler(organizationUsecase usecase.OrganizationUsecase) OrganizationHandler {
+	return &organizationHandler{organizationUsecase: organizationUsecase}
+}
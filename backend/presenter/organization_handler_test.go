@@ -0,0 +1,318 @@
+package presenter_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"stackies/backend/presenter"
+	"stackies/backend/usecase"
+	mock_usecase "stackies/backend/usecase/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrganizationHandler_SaveTeamMember(t *testing.T) {
+	createdAt := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		teamID         string
+		requestBody    string
+		setupMock      func(mock *mock_usecase.MockOrganizationUsecase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "正常系: ユーザーをマネージャーとして所属させる",
+			teamID:      "1",
+			requestBody: `{"role":"manager"}`,
+			setupMock: func(mock *mock_usecase.MockOrganizationUsecase) {
+				mock.EXPECT().SaveTeamMember(gomock.Any(), 1, "user-1", usecase.TeamMemberInput{Role: "manager"}).
+					Return(usecase.TeamMemberDto{TeamID: 1, UserID: "user-1", Role: "manager", CreatedAt: createdAt}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"team_id":1,"user_id":"user-1","display_name":"","role":"manager","created_at":"2026-10-19T12:00:00Z"}`,
+		},
+		{
+			name:        "異常系: 未知の役割",
+			teamID:      "1",
+			requestBody: `{"role":"owner"}`,
+			setupMock: func(mock *mock_usecase.MockOrganizationUsecase) {
+				mock.EXPECT().SaveTeamMember(gomock.Any(), 1, "user-1", gomock.Any()).Return(usecase.TeamMemberDto{}, usecase.ErrInvalidInput)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "異常系: IDが数値でない",
+			teamID:         "abc",
+			requestBody:    `{}`,
+			setupMock:      func(mock *mock_usecase.MockOrganizationUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/admin/teams/"+tt.teamID+"/members/user-1", strings.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id", "user_id")
+			c.SetParamValues(tt.teamID, "user-1")
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockOrganizationUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewOrganizationHandler(mockUsecase)
+			err := handler.SaveTeamMember(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestOrganizationHandler_GetMemberResume(t *testing.T) {
+	start := time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)
+	generatedAt := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		setupMock      func(mock *mock_usecase.MockOrganizationUsecase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "正常系: メンバーの業務経歴とスキルを返す",
+			setupMock: func(mock *mock_usecase.MockOrganizationUsecase) {
+				mock.EXPECT().GetMemberResume(gomock.Any(), 1, "user-1").Return(usecase.ResumeDto{
+					Profile: usecase.ProfileDto{DisplayName: "山田 太郎"},
+					Skills:  []usecase.SkillSummaryDto{{Category: "language", Name: "Go", Months: 12, ExperienceCount: 1}},
+					Experiences: []usecase.ExperienceDto{
+						{ID: 1, Title: "決済基盤の開発", StartMonth: &start, Skills: []usecase.SkillDto{{Category: "language", Name: "Go"}}},
+					},
+					GeneratedAt: generatedAt,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"profile":{"display_name":"山田 太郎","initials":"","headline":"","self_pr":"","nearest_station":"","work_style":"",` +
+				`"preferred_locations":[],"certifications":[],"educations":[]},` +
+				`"skills":[{"category":"language","name":"Go","months":12,"experience_count":1}],` +
+				`"experiences":[{"id":1,"title":"決済基盤の開発","start_month":"2024-04","skills":[{"category":"language","name":"Go"}]}],` +
+				`"generated_at":"2026-10-19T12:00:00Z"}`,
+		},
+		{
+			name: "異常系: マネージャーでない",
+			setupMock: func(mock *mock_usecase.MockOrganizationUsecase) {
+				mock.EXPECT().GetMemberResume(gomock.Any(), 1, "user-1").Return(usecase.ResumeDto{}, usecase.ErrForbidden)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name: "異常系: チームに所属していない",
+			setupMock: func(mock *mock_usecase.MockOrganizationUsecase) {
+				mock.EXPECT().GetMemberResume(gomock.Any(), 1, "user-1").Return(usecase.ResumeDto{}, usecase.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/teams/1/members/user-1/resume", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id", "user_id")
+			c.SetParamValues("1", "user-1")
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockOrganizationUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewOrganizationHandler(mockUsecase)
+			err := handler.GetMemberResume(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestOrganizationHandler_GetOrganizationSkills(t *testing.T) {
+	lastUsed := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	generatedAt := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		id             string
+		setupMock      func(mock *mock_usecase.MockOrganizationUsecase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "正常系: スキルとメンバーの経験期間の表を返す",
+			id:   "1",
+			setupMock: func(mock *mock_usecase.MockOrganizationUsecase) {
+				mock.EXPECT().GetOrganizationSkills(gomock.Any(), 1).Return(usecase.SkillMatrixDto{
+					Members: []usecase.MemberDto{{UserID: "user-1", DisplayName: "山田 太郎"}, {UserID: "user-2"}},
+					Skills: []usecase.SkillMatrixRowDto{{
+						Category:    "language",
+						Name:        "Go",
+						Members:     []usecase.MemberSkillDto{{UserID: "user-1", Months: 12, LastUsedMonth: &lastUsed, ExperienceCount: 1}},
+						TotalMonths: 12,
+					}},
+					GeneratedAt: generatedAt,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"members":[{"user_id":"user-1","display_name":"山田 太郎"},{"user_id":"user-2","display_name":""}],` +
+				`"skills":[{"category":"language","name":"Go","members":[{"user_id":"user-1","months":12,"last_used_month":"2025-03","experience_count":1}],"total_months":12}],` +
+				`"generated_at":"2026-10-19T12:00:00Z"}`,
+		},
+		{
+			name: "異常系: マネージャーでない",
+			id:   "1",
+			setupMock: func(mock *mock_usecase.MockOrganizationUsecase) {
+				mock.EXPECT().GetOrganizationSkills(gomock.Any(), 1).Return(usecase.SkillMatrixDto{}, usecase.ErrForbidden)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "異常系: IDが数値でない",
+			id:             "abc",
+			setupMock:      func(mock *mock_usecase.MockOrganizationUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/orgs/"+tt.id+"/skills", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.id)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockOrganizationUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewOrganizationHandler(mockUsecase)
+			err := handler.GetOrganizationSkills(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestOrganizationHandler_AnalyzeSkillGaps(t *testing.T) {
+	generatedAt := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		setupMock      func(mock *mock_usecase.MockOrganizationUsecase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "正常系: スキルの表と不足している人数を返す",
+			requestBody: `{"team_id":1,"requirements":[{"category":"language","name":"Go","min_months":24,"min_people":2}]}`,
+			setupMock: func(mock *mock_usecase.MockOrganizationUsecase) {
+				mock.EXPECT().AnalyzeSkillGaps(gomock.Any(), usecase.SkillGapInput{
+					TeamID:       1,
+					Requirements: []usecase.SkillRequirementInput{{Category: "language", Name: "Go", MinMonths: 24, MinPeople: 2}},
+				}).Return(usecase.SkillGapAnalysisDto{
+					SkillMatrixDto: usecase.SkillMatrixDto{
+						Members: []usecase.MemberDto{{UserID: "user-1"}},
+						Skills: []usecase.SkillMatrixRowDto{{
+							Category:    "language",
+							Name:        "Go",
+							Members:     []usecase.MemberSkillDto{{UserID: "user-1", Months: 36, ExperienceCount: 1}},
+							TotalMonths: 36,
+						}},
+						GeneratedAt: generatedAt,
+					},
+					Gaps: []usecase.SkillGapDto{{
+						Category:   "language",
+						Name:       "Go",
+						MinMonths:  24,
+						MinPeople:  2,
+						Qualified:  []usecase.MemberSkillDto{{UserID: "user-1", Months: 36, ExperienceCount: 1}},
+						Candidates: []usecase.MemberSkillDto{},
+						Shortage:   1,
+					}},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"members":[{"user_id":"user-1","display_name":""}],` +
+				`"skills":[{"category":"language","name":"Go","members":[{"user_id":"user-1","months":36,"experience_count":1}],"total_months":36}],` +
+				`"generated_at":"2026-10-19T12:00:00Z",` +
+				`"gaps":[{"category":"language","name":"Go","min_months":24,"min_people":2,` +
+				`"qualified":[{"user_id":"user-1","months":36,"experience_count":1}],"candidates":[],"shortage":1}],` +
+				`"satisfied":false}`,
+		},
+		{
+			name:        "異常系: 管理していないユーザーを含む",
+			requestBody: `{"user_ids":["user-9"]}`,
+			setupMock: func(mock *mock_usecase.MockOrganizationUsecase) {
+				mock.EXPECT().AnalyzeSkillGaps(gomock.Any(), gomock.Any()).Return(usecase.SkillGapAnalysisDto{}, usecase.ErrForbidden)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:        "異常系: 対象を指定していない",
+			requestBody: `{"requirements":[]}`,
+			setupMock: func(mock *mock_usecase.MockOrganizationUsecase) {
+				mock.EXPECT().AnalyzeSkillGaps(gomock.Any(), gomock.Any()).Return(usecase.SkillGapAnalysisDto{}, usecase.ErrInvalidInput)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/skill-matrix", strings.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockOrganizationUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewOrganizationHandler(mockUsecase)
+			err := handler.AnalyzeSkillGaps(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package presenter
+
+import (
+	"fmt"
+	"net/http"
+	"stackies/backend/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type profileHandler struct {
+	profileUsecase usecase.ProfileUsecase
+}
+
+// CertificationRequest / EducationRequest の月は "2024-04" の形式です
+type CertificationRequest struct {
+	Name          string `json:"name"`
+	Issuer        string `json:"issuer"`
+	AcquiredMonth string `json:"acquired_month,omitempty"`
+}
+
+type EducationRequest struct {
+	SchoolName string `json:"school_name"`
+	Faculty    string `json:"faculty"`
+	Degree     string `json:"degree"`
+	StartMonth string `json:"start_month,omitempty"`
+	EndMonth   string `json:"end_month,omitempty"`
+}
+
+// ProfileRequest はプロフィールの更新のリクエストです
+// 資格・学歴は指定した内容で全て置き換えます
+type ProfileRequest struct {
+	DisplayName string `json:"display_name"`
+	// Initials は匿名化したスキルシートで氏名の代わりに表示するイニシャルです（省略時は英字の氏名から作成）
+	Initials           string                 `json:"initials"`
+	Headline           string                 `json:"headline"`
+	SelfPR             string                 `json:"self_pr"`
+	NearestStation     string                 `json:"nearest_station"`
+	WorkStyle          string                 `json:"work_style"`
+	PreferredLocations []string               `json:"preferred_locations"`
+	Certifications     []CertificationRequest `json:"certifications"`
+	Educations         []EducationRequest     `json:"educations"`
+}
+
+func (r *ProfileRequest) ConvertToInput() (usecase.ProfileInput, error) {
+	input := usecase.ProfileInput{
+		DisplayName:        r.DisplayName,
+		Initials:           r.Initials,
+		Headline:           r.Headline,
+		SelfPR:             r.SelfPR,
+		NearestStation:     r.NearestStation,
+		WorkStyle:          r.WorkStyle,
+		PreferredLocations: r.PreferredLocations,
+		Certifications:     make([]usecase.CertificationDto, len(r.Certifications)),
+		Educations:         make([]usecase.EducationDto, len(r.Educations)),
+	}
+	var err error
+	for i, c := range r.Certifications {
+		input.Certifications[i] = usecase.CertificationDto{Name: c.Name, Issuer: c.Issuer}
+		if input.Certifications[i].AcquiredMonth, err = parseMonth(c.AcquiredMonth); err != nil {
+			return usecase.ProfileInput{}, fmt.Errorf("certifications[%d]: %w", i, err)
+		}
+	}
+	for i, e := range r.Educations {
+		input.Educations[i] = usecase.EducationDto{SchoolName: e.SchoolName, Faculty: e.Faculty, Degree: e.Degree}
+		if input.Educations[i].StartMonth, err = parseMonth(e.StartMonth); err != nil {
+			return usecase.ProfileInput{}, fmt.Errorf("educations[%d]: %w", i, err)
+		}
+		if input.Educations[i].EndMonth, err = parseMonth(e.EndMonth); err != nil {
+			return usecase.ProfileInput{}, fmt.Errorf("educations[%d]: %w", i, err)
+		}
+	}
+	return input, nil
+}
+
+type CertificationResponse struct {
+	Name          string `json:"name"`
+	Issuer        string `json:"issuer"`
+	AcquiredMonth string `json:"acquired_month,omitempty"`
+}
+
+type EducationResponse struct {
+	SchoolName string `json:"school_name"`
+	Faculty    string `json:"faculty"`
+	Degree     string `json:"degree"`
+	StartMonth string `json:"start_month,omitempty"`
+	EndMonth   string `json:"end_month,omitempty"`
+}
+
+type ProfileResponse struct {
+	DisplayName        string                  `json:"display_name"`
+	Initials           string                  `json:"initials"`
+	Headline           string                  `json:"headline"`
+	SelfPR             string                  `json:"self_pr"`
+	NearestStation     string                  `json:"nearest_station"`
+	WorkStyle          string                  `json:"work_style"`
+	PreferredLocations []string                `json:"preferred_locations"`
+	Certifications     []CertificationResponse `json:"certifications"`
+	Educations         []EducationResponse     `json:"educations"`
+}
+
+func (p *ProfileResponse) ConvertToDto(profile usecase.ProfileDto) {
+	p.DisplayName = profile.DisplayName
+	p.Initials = profile.Initials
+	p.Headline = profile.Headline
+	p.SelfPR = profile.SelfPR
+	p.NearestStation = profile.NearestStation
+	p.WorkStyle = profile.WorkStyle
+	p.PreferredLocations = profile.PreferredLocations
+	if p.PreferredLocations == nil {
+		p.PreferredLocations = []string{}
+	}
+	p.Certifications = make([]CertificationResponse, len(profile.Certifications))
+	for i, c := range profile.Certifications {
+		p.Certifications[i] = CertificationResponse{
+			Name:          c.Name,
+			Issuer:        c.Issuer,
+			AcquiredMonth: formatMonth(c.AcquiredMonth),
+		}
+	}
+	p.Educations = make([]EducationResponse, len(profile.Educations))
+	for i, e := range profile.Educations {
+		p.Educations[i] = EducationResponse{
+			SchoolName: e.SchoolName,
+			Faculty:    e.Faculty,
+			Degree:     e.Degree,
+			StartMonth: formatMonth(e.StartMonth),
+			EndMonth:   formatMonth(e.EndMonth),
+		}
+	}
+}
+
+// Get implements ProfileHandler.
+func (p *profileHandler) Get(c echo.Context) error {
+	profile, err := p.profileUsecase.Get(c.Request().Context())
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	var response ProfileResponse
+	response.ConvertToDto(profile)
+	return c.JSON(http.StatusOK, response)
+}
+
+// Put implements ProfileHandler.
+func (p *profileHandler) Put(c echo.Context) error {
+	var request ProfileRequest
+	if err := c.Bind(&request); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	input, err := request.ConvertToInput()
+	if err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	profile, err := p.profileUsecase.Put(c.Request().Context(), input)
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	var response ProfileResponse
+	response.ConvertToDto(profile)
+	return c.JSON(http.StatusOK, response)
+}
+
+type ProfileHandler interface {
+	Get(c echo.Context) error
+	Put(c echo.Context) error
+}
+
+func NewProfileHandler(profileUsecase usecase.ProfileUsecase) ProfileHandler {
+	return &profileHandler{profileUsecase: profileUsecase}
+}
@@ -0,0 +1,139 @@
+package presenter_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"stackies/backend/presenter"
+	"stackies/backend/usecase"
+	mock_usecase "stackies/backend/usecase/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfileHandler_Get(t *testing.T) {
+	acquired := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		setupMock      func(mock *mock_usecase.MockProfileUsecase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "正常系: プロフィールを取得できる",
+			setupMock: func(mock *mock_usecase.MockProfileUsecase) {
+				mock.EXPECT().Get(gomock.Any()).Return(usecase.ProfileDto{
+					DisplayName:    "山田 太郎",
+					WorkStyle:      "remote",
+					Certifications: []usecase.CertificationDto{{Name: "AWS SAA", Issuer: "AWS", AcquiredMonth: &acquired}},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"display_name":"山田 太郎","initials":"","headline":"","self_pr":"","nearest_station":"","work_style":"remote",` +
+				`"preferred_locations":[],"certifications":[{"name":"AWS SAA","issuer":"AWS","acquired_month":"2023-06"}],"educations":[]}`,
+		},
+		{
+			name: "異常系: 認証されていない",
+			setupMock: func(mock *mock_usecase.MockProfileUsecase) {
+				mock.EXPECT().Get(gomock.Any()).Return(usecase.ProfileDto{}, usecase.ErrUnauthenticated)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "異常系: プロフィールの取得に失敗",
+			setupMock: func(mock *mock_usecase.MockProfileUsecase) {
+				mock.EXPECT().Get(gomock.Any()).Return(usecase.ProfileDto{}, errors.New("DB error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/me/profile", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockProfileUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewProfileHandler(mockUsecase)
+			err := handler.Get(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestProfileHandler_Put(t *testing.T) {
+	start := time.Date(2015, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		setupMock      func(mock *mock_usecase.MockProfileUsecase)
+		expectedStatus int
+	}{
+		{
+			name:        "正常系: プロフィールを更新できる",
+			requestBody: `{"display_name":"山田 太郎","educations":[{"school_name":"東京大学","start_month":"2015-04"}]}`,
+			setupMock: func(mock *mock_usecase.MockProfileUsecase) {
+				mock.EXPECT().Put(gomock.Any(), usecase.ProfileInput{
+					DisplayName:    "山田 太郎",
+					Certifications: []usecase.CertificationDto{},
+					Educations:     []usecase.EducationDto{{SchoolName: "東京大学", StartMonth: &start}},
+				}).Return(usecase.ProfileDto{DisplayName: "山田 太郎"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "異常系: 資格の取得月の形式が不正",
+			requestBody:    `{"certifications":[{"name":"AWS SAA","acquired_month":"2023/06"}]}`,
+			setupMock:      func(mock *mock_usecase.MockProfileUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "異常系: 入力値が不正",
+			requestBody: `{"work_style":"freelance"}`,
+			setupMock: func(mock *mock_usecase.MockProfileUsecase) {
+				mock.EXPECT().Put(gomock.Any(), gomock.Any()).Return(usecase.ProfileDto{}, usecase.ErrInvalidInput)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/me/profile", strings.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockProfileUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewProfileHandler(mockUsecase)
+			err := handler.Put(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package presenter
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"stackies/backend/usecase"
+
+	"github.com/labstack/echo/v4"
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	// resumeFileName・skillSheetFileName・markdownFileName はダウンロード時のファイル名です
+	resumeFileName     = "業務経歴書.pdf"
+	skillSheetFileName = "スキルシート.xlsx"
+	markdownFileName   = "業務経歴書.md"
+
+	xlsxContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	// maxTemplateSize はアップロードできるテンプレートの最大サイズ（バイト）です
+	maxTemplateSize = 5 << 20
+	// maxTemplateUnzipSize は展開後のテンプレートの最大サイズ（バイト）です。圧縮爆弾への対策です
+	maxTemplateUnzipSize = 50 << 20
+)
+
+type resumeHandler struct {
+	resumeUsecase usecase.ResumeUsecase
+	// pdfFont は PDF に埋め込む日本語フォントです。nil の場合は PDF を出力できません
+	pdfFont []byte
+}
+
+// GetPDF implements ResumeHandler.
+func (r *resumeHandler) GetPDF(c echo.Context) error {
+	if len(r.pdfFont) == 0 {
+		return errorJSON(c, http.StatusServiceUnavailable, errors.New("pdf export is not configured"))
+	}
+
+	options, err := newResumeOptions(c)
+	if err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	resume, err := r.resumeUsecase.Get(c.Request().Context(), options)
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	var buf bytes.Buffer
+	if err := renderResumePDF(&buf, resume, r.pdfFont); err != nil {
+		return errorJSON(c, http.StatusInternalServerError, err)
+	}
+	c.Response().Header().Set(echo.HeaderContentDisposition, attachment("resume.pdf", resumeFileName))
+	return c.Blob(http.StatusOK, "application/pdf", buf.Bytes())
+}
+
+// GetXLSX implements ResumeHandler.
+// 標準のテンプレートでスキルシートを出力します
+func (r *resumeHandler) GetXLSX(c echo.Context) error {
+	template, err := newDefaultResumeTemplate()
+	if err != nil {
+		return errorJSON(c, http.StatusInternalServerError, err)
+	}
+	defer template.Close()
+	return r.writeXLSX(c, template)
+}
+
+// PostXLSX implements ResumeHandler.
+// multipart/form-data の template にアップロードされた xlsx をテンプレートとしてスキルシートを出力します
+func (r *resumeHandler) PostXLSX(c echo.Context) error {
+	fileHeader, err := c.FormFile("template")
+	if err != nil {
+		return errorJSON(c, http.StatusBadRequest, errors.New("template file is required"))
+	}
+	if fileHeader.Size > maxTemplateSize {
+		return errorJSON(c, http.StatusRequestEntityTooLarge, fmt.Errorf("template must be at most %d bytes", maxTemplateSize))
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	defer file.Close()
+
+	template, err := excelize.OpenReader(io.LimitReader(file, maxTemplateSize), excelize.Options{UnzipSizeLimit: maxTemplateUnzipSize})
+	if err != nil {
+		return errorJSON(c, http.StatusBadRequest, errors.New("template must be an xlsx file"))
+	}
+	defer template.Close()
+	return r.writeXLSX(c, template)
+}
+
+// writeXLSX はテンプレートにログイン中のユーザーの業務経歴書の値を埋め込んで返します
+func (r *resumeHandler) writeXLSX(c echo.Context, template *excelize.File) error {
+	options, err := newResumeOptions(c)
+	if err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	resume, err := r.resumeUsecase.Get(c.Request().Context(), options)
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+	if err := fillResumeTemplate(template, newResumeValues(resume)); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+
+	var buf bytes.Buffer
+	if err := template.Write(&buf); err != nil {
+		return errorJSON(c, http.StatusInternalServerError, err)
+	}
+	c.Response().Header().Set(echo.HeaderContentDisposition, attachment("skill-sheet.xlsx", skillSheetFileName))
+	return c.Blob(http.StatusOK, xlsxContentType, buf.Bytes())
+}
+
+// GetMarkdown implements ResumeHandler.
+func (r *resumeHandler) GetMarkdown(c echo.Context) error {
+	options, err := newResumeOptions(c)
+	if err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	resume, err := r.resumeUsecase.Get(c.Request().Context(), options)
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	var buf bytes.Buffer
+	if err := renderResumeMarkdown(&buf, resume); err != nil {
+		return errorJSON(c, http.StatusInternalServerError, err)
+	}
+	c.Response().Header().Set(echo.HeaderContentDisposition, attachment("resume.md", markdownFileName))
+	return c.Blob(http.StatusOK, "text/markdown; charset=utf-8", buf.Bytes())
+}
+
+// GetJSONResume implements ResumeHandler.
+func (r *resumeHandler) GetJSONResume(c echo.Context) error {
+	options, err := newResumeOptions(c)
+	if err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	resume, err := r.resumeUsecase.Get(c.Request().Context(), options)
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+	return c.JSON(http.StatusOK, newJSONResume(resume))
+}
+
+// newResumeOptions はクエリパラメーターから業務経歴書の出力方法を読み取ります
+// anonymize=true の場合は匿名化したスキルシートを出力します
+func newResumeOptions(c echo.Context) (usecase.ResumeOptions, error) {
+	var options usecase.ResumeOptions
+	err := echo.QueryParamsBinder(c).Bool("anonymize", &options.Anonymize).BindError()
+	return options, err
+}
+
+// attachment は日本語のファイル名でダウンロードさせる Content-Disposition を返します（RFC 6266）
+// fallback は filename* に対応していないクライアント向けの ASCII のファイル名です
+func attachment(fallback, fileName string) string {
+	return `attachment; filename="` + fallback + `"; filename*=UTF-8''` + url.PathEscape(fileName)
+}
+
+type ResumeHandler interface {
+	GetPDF(c echo.Context) error
+	GetXLSX(c echo.Context) error
+	PostXLSX(c echo.Context) error
+	GetMarkdown(c echo.Context) error
+	GetJSONResume(c echo.Context) error
+}
+
+// NewResumeHandler は業務経歴書のハンドラーを作成します
+// pdfFont は日本語のグリフを含む TrueType フォントです
+func NewResumeHandler(resumeUsecase usecase.ResumeUsecase, pdfFont []byte) ResumeHandler {
+	return &resumeHandler{resumeUsecase: resumeUsecase, pdfFont: pdfFont}
+}
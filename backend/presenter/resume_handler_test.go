@@ -0,0 +1,415 @@
+package presenter_test
+
+import (
+	"bytes"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"stackies/backend/presenter"
+	"stackies/backend/usecase"
+	mock_usecase "stackies/backend/usecase/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+// loadResumeFont は PDF のテストで使うフォントを読み込みます
+// RESUME_FONT_PATH が未設定の場合は testdata の Go フォントを使います。日本語のグリフはありませんが、レイアウトと改ページは確認できます
+func loadResumeFont(t *testing.T) []byte {
+	t.Helper()
+	path := os.Getenv("RESUME_FONT_PATH")
+	if path == "" {
+		path = "testdata/Go-Regular.ttf"
+	}
+	font, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return font
+}
+
+func TestResumeHandler_GetPDF(t *testing.T) {
+	start := time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)
+	resume := usecase.ResumeDto{
+		Profile: usecase.ProfileDto{
+			DisplayName: "山田 太郎",
+			SelfPR:      strings.Repeat("Go と AWS を用いたバックエンド開発を担当しました。\n", 80),
+			Certifications: []usecase.CertificationDto{
+				{Name: "AWS Certified Solutions Architect - Associate", Issuer: "AWS", AcquiredMonth: &start},
+			},
+		},
+		Skills: []usecase.SkillSummaryDto{
+			{Category: "language", Name: "Go", Months: 18, LastUsedMonth: &start, ExperienceCount: 2},
+		},
+		GeneratedAt: time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC),
+	}
+	for i := 0; i < 40; i++ {
+		resume.Experiences = append(resume.Experiences, usecase.ExperienceDto{
+			ID:         i + 1,
+			Title:      "決済基盤のマイクロサービス化 🚀",
+			StartMonth: &start,
+			TeamSize:   8,
+			Skills:     []usecase.SkillDto{{Category: "language", Name: "Go"}, {Category: "tool", Name: "AWS"}},
+		})
+	}
+
+	tests := []struct {
+		name           string
+		font           func(t *testing.T) []byte
+		setupMock      func(mock *mock_usecase.MockResumeUsecase)
+		expectedStatus int
+	}{
+		{
+			name: "正常系: 複数ページの業務経歴書を PDF で出力できる",
+			font: loadResumeFont,
+			setupMock: func(mock *mock_usecase.MockResumeUsecase) {
+				mock.EXPECT().Get(gomock.Any(), usecase.ResumeOptions{}).Return(resume, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "異常系: フォントが設定されていない",
+			font:           func(t *testing.T) []byte { return nil },
+			setupMock:      func(mock *mock_usecase.MockResumeUsecase) {},
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name: "異常系: 認証されていない",
+			font: func(t *testing.T) []byte { return []byte("font") },
+			setupMock: func(mock *mock_usecase.MockResumeUsecase) {
+				mock.EXPECT().Get(gomock.Any(), usecase.ResumeOptions{}).Return(usecase.ResumeDto{}, usecase.ErrUnauthenticated)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "異常系: 業務経歴書の取得に失敗",
+			font: func(t *testing.T) []byte { return []byte("font") },
+			setupMock: func(mock *mock_usecase.MockResumeUsecase) {
+				mock.EXPECT().Get(gomock.Any(), usecase.ResumeOptions{}).Return(usecase.ResumeDto{}, errors.New("DB error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			font := tt.font(t)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/me/resume.pdf", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockResumeUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewResumeHandler(mockUsecase, font)
+			err := handler.GetPDF(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, "application/pdf", rec.Header().Get(echo.HeaderContentType))
+				assert.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), "attachment;")
+				assert.True(t, strings.HasPrefix(rec.Body.String(), "%PDF-"))
+				// 自己PRと業務経歴が1ページに収まらず改ページされること
+				assert.Greater(t, strings.Count(rec.Body.String(), "<</Type /Page\n"), 1)
+			}
+		})
+	}
+}
+
+// xlsxResume はスキルシートのテストで使う業務経歴書です
+func xlsxResume() usecase.ResumeDto {
+	start := time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	return usecase.ResumeDto{
+		Profile: usecase.ProfileDto{DisplayName: "山田 太郎", WorkStyle: "remote"},
+		Skills: []usecase.SkillSummaryDto{
+			{Category: "language", Name: "Go", Months: 40, LastUsedMonth: &end, ExperienceCount: 2, SelfLevel: 4, PeerLevel: 3.5, EndorsementCount: 2},
+			{Category: "tool", Name: "AWS", Months: 12, LastUsedMonth: &end, ExperienceCount: 1},
+		},
+		Experiences: []usecase.ExperienceDto{
+			{
+				ID:          1,
+				Title:       "決済基盤のマイクロサービス化",
+				ClientAlias: "大手決済事業者向け",
+				StartMonth:  &start,
+				EndMonth:    &end,
+				TeamSize:    8,
+				Skills:      []usecase.SkillDto{{Category: "language", Name: "Go"}, {Category: "tool", Name: "AWS"}},
+				Phases:      []string{"basic_design", "implementation"},
+			},
+			{ID: 2, Title: "社内ツールの保守"},
+		},
+		GeneratedAt: time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func openXLSX(t *testing.T, body []byte) *excelize.File {
+	t.Helper()
+	f, err := excelize.OpenReader(bytes.NewReader(body))
+	require.NoError(t, err)
+	t.Cleanup(func() { f.Close() })
+	return f
+}
+
+func cellValue(t *testing.T, f *excelize.File, sheet, cell string) string {
+	t.Helper()
+	value, err := f.GetCellValue(sheet, cell)
+	require.NoError(t, err)
+	return value
+}
+
+func TestResumeHandler_GetXLSX(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		setupMock      func(mock *mock_usecase.MockResumeUsecase)
+		expectedStatus int
+		assertBody     func(t *testing.T, f *excelize.File)
+	}{
+		{
+			name: "正常系: 標準のテンプレートでスキルシートを出力できる",
+			setupMock: func(mock *mock_usecase.MockResumeUsecase) {
+				mock.EXPECT().Get(gomock.Any(), usecase.ResumeOptions{}).Return(xlsxResume(), nil)
+			},
+			expectedStatus: http.StatusOK,
+			assertBody: func(t *testing.T, f *excelize.File) {
+				assert.Equal(t, []string{"プロフィール", "スキル", "業務経歴"}, f.GetSheetList())
+				assert.Equal(t, "山田 太郎", cellValue(t, f, "プロフィール", "B3"))
+				assert.Equal(t, "フルリモート", cellValue(t, f, "プロフィール", "B6"))
+				assert.Equal(t, "", cellValue(t, f, "プロフィール", "B12"))
+
+				assert.Equal(t, "Go", cellValue(t, f, "スキル", "B2"))
+				assert.Equal(t, "3年4ヶ月", cellValue(t, f, "スキル", "C2"))
+				assert.Equal(t, "40", cellValue(t, f, "スキル", "D2"))
+				assert.Equal(t, "◎", cellValue(t, f, "スキル", "G2"))
+				assert.Equal(t, "4", cellValue(t, f, "スキル", "H2"))
+				assert.Equal(t, "3.5", cellValue(t, f, "スキル", "I2"))
+				assert.Equal(t, "AWS", cellValue(t, f, "スキル", "B3"))
+				assert.Equal(t, "○", cellValue(t, f, "スキル", "G3"))
+				assert.Equal(t, "", cellValue(t, f, "スキル", "H3"))
+
+				assert.Equal(t, "決済基盤のマイクロサービス化", cellValue(t, f, "業務経歴", "C2"))
+				assert.Equal(t, "大手決済事業者向け", cellValue(t, f, "業務経歴", "D2"))
+				assert.Equal(t, "Go、AWS", cellValue(t, f, "業務経歴", "E2"))
+				assert.Equal(t, "8", cellValue(t, f, "業務経歴", "F2"))
+				assert.Equal(t, "", cellValue(t, f, "業務経歴", "G2"))
+				assert.Equal(t, "●", cellValue(t, f, "業務経歴", "H2"))
+				assert.Equal(t, "●", cellValue(t, f, "業務経歴", "J2"))
+				assert.Equal(t, "社内ツールの保守", cellValue(t, f, "業務経歴", "C3"))
+				assert.Equal(t, "", cellValue(t, f, "業務経歴", "B3"))
+			},
+		},
+		{
+			name:  "正常系: 匿名化したスキルシートを出力できる",
+			query: "?anonymize=true",
+			setupMock: func(mock *mock_usecase.MockResumeUsecase) {
+				resume := xlsxResume()
+				resume.Profile.DisplayName = "T.Y."
+				resume.Anonymized = true
+				mock.EXPECT().Get(gomock.Any(), usecase.ResumeOptions{Anonymize: true}).Return(resume, nil)
+			},
+			expectedStatus: http.StatusOK,
+			assertBody: func(t *testing.T, f *excelize.File) {
+				assert.Equal(t, "T.Y.", cellValue(t, f, "プロフィール", "B3"))
+				assert.Equal(t, "大手決済事業者向け", cellValue(t, f, "業務経歴", "D2"))
+			},
+		},
+		{
+			name:           "異常系: anonymize が真偽値でない",
+			query:          "?anonymize=yes",
+			setupMock:      func(mock *mock_usecase.MockResumeUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "異常系: 認証されていない",
+			setupMock: func(mock *mock_usecase.MockResumeUsecase) {
+				mock.EXPECT().Get(gomock.Any(), usecase.ResumeOptions{}).Return(usecase.ResumeDto{}, usecase.ErrUnauthenticated)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/me/resume.xlsx"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockResumeUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewResumeHandler(mockUsecase, nil)
+			err := handler.GetXLSX(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.assertBody != nil {
+				assert.Equal(t, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", rec.Header().Get(echo.HeaderContentType))
+				assert.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), `filename="skill-sheet.xlsx"`)
+				tt.assertBody(t, openXLSX(t, rec.Body.Bytes()))
+			}
+		})
+	}
+}
+
+func TestResumeHandler_PostXLSX(t *testing.T) {
+	// 1行目は見出し、2行目は業務経歴の繰り返し、3行目はその下の行がずれないことの確認用
+	template := func(t *testing.T) []byte {
+		f := excelize.NewFile()
+		defer f.Close()
+		require.NoError(t, f.SetSheetRow("Sheet1", "A1", &[]string{"氏名: {{display_name}}", "{{unknown}}"}))
+		require.NoError(t, f.SetSheetRow("Sheet1", "A2", &[]string{"{{experiences.no}}", "{{experiences.title}}（{{experiences.team_size}}名）", "{{display_name}}"}))
+		require.NoError(t, f.SetSheetRow("Sheet1", "A3", &[]string{"以上"}))
+		var buf bytes.Buffer
+		require.NoError(t, f.Write(&buf))
+		return buf.Bytes()
+	}
+
+	tests := []struct {
+		name           string
+		file           func(t *testing.T) []byte
+		setupMock      func(mock *mock_usecase.MockResumeUsecase)
+		expectedStatus int
+		assertBody     func(t *testing.T, f *excelize.File)
+	}{
+		{
+			name: "正常系: アップロードしたテンプレートにスキルシートを埋め込める",
+			file: template,
+			setupMock: func(mock *mock_usecase.MockResumeUsecase) {
+				mock.EXPECT().Get(gomock.Any(), usecase.ResumeOptions{}).Return(xlsxResume(), nil)
+			},
+			expectedStatus: http.StatusOK,
+			assertBody: func(t *testing.T, f *excelize.File) {
+				assert.Equal(t, "氏名: 山田 太郎", cellValue(t, f, "Sheet1", "A1"))
+				assert.Equal(t, "{{unknown}}", cellValue(t, f, "Sheet1", "B1"))
+				assert.Equal(t, "1", cellValue(t, f, "Sheet1", "A2"))
+				assert.Equal(t, "決済基盤のマイクロサービス化（8名）", cellValue(t, f, "Sheet1", "B2"))
+				assert.Equal(t, "山田 太郎", cellValue(t, f, "Sheet1", "C2"))
+				assert.Equal(t, "2", cellValue(t, f, "Sheet1", "A3"))
+				assert.Equal(t, "社内ツールの保守（名）", cellValue(t, f, "Sheet1", "B3"))
+				assert.Equal(t, "以上", cellValue(t, f, "Sheet1", "A4"))
+			},
+		},
+		{
+			name:           "異常系: テンプレートが指定されていない",
+			file:           nil,
+			setupMock:      func(mock *mock_usecase.MockResumeUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "異常系: テンプレートが xlsx ではない",
+			file:           func(t *testing.T) []byte { return []byte("name,title\n") },
+			setupMock:      func(mock *mock_usecase.MockResumeUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body bytes.Buffer
+			writer := multipart.NewWriter(&body)
+			if tt.file != nil {
+				part, err := writer.CreateFormFile("template", "template.xlsx")
+				require.NoError(t, err)
+				_, err = part.Write(tt.file(t))
+				require.NoError(t, err)
+			}
+			require.NoError(t, writer.Close())
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/me/resume.xlsx", &body)
+			req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockResumeUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewResumeHandler(mockUsecase, nil)
+			err := handler.PostXLSX(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.assertBody != nil {
+				tt.assertBody(t, openXLSX(t, rec.Body.Bytes()))
+			}
+		})
+	}
+}
+
+func TestResumeHandler_GetMarkdown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockUsecase := mock_usecase.NewMockResumeUsecase(ctrl)
+	resume := xlsxResume()
+	resume.Profile.Headline = "バックエンドエンジニア"
+	mockUsecase.EXPECT().Get(gomock.Any(), usecase.ResumeOptions{}).Return(resume, nil)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/me/resume.md", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	handler := presenter.NewResumeHandler(mockUsecase, nil)
+	err := handler.GetMarkdown(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/markdown; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+	body := rec.Body.String()
+	assert.True(t, strings.HasPrefix(body, "# 山田 太郎\n\n**バックエンドエンジニア**\n\n"))
+	assert.Contains(t, body, "| 言語 | Go | 3年4ヶ月 | 2025年03月 | 2 | 自己 4 / 推薦 3.5（2件） |\n")
+	assert.Contains(t, body, "| ツール | AWS | 1年 | 2025年03月 | 1 | - |\n")
+	assert.Contains(t, body, "### 決済基盤のマイクロサービス化\n\n- 期間: 2024年04月 〜 2025年03月 （1年）\n- 顧客: 大手決済事業者向け\n- 規模: 8名\n- 担当工程: 基本設計、実装\n- 使用技術: `Go` `AWS`\n")
+	assert.Contains(t, body, "### 社内ツールの保守\n\n")
+}
+
+func TestResumeHandler_GetJSONResume(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockUsecase := mock_usecase.NewMockResumeUsecase(ctrl)
+	mockUsecase.EXPECT().Get(gomock.Any(), usecase.ResumeOptions{}).Return(xlsxResume(), nil)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/me/resume.json", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	handler := presenter.NewResumeHandler(mockUsecase, nil)
+	err := handler.GetJSONResume(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{
+		"$schema": "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json",
+		"basics": {"name": "山田 太郎"},
+		"projects": [
+			{"name": "決済基盤のマイクロサービス化", "entity": "大手決済事業者向け", "description": "チーム規模: 8名", "startDate": "2024-04", "endDate": "2025-03", "keywords": ["Go", "AWS"], "roles": ["基本設計", "実装"]},
+			{"name": "社内ツールの保守"}
+		],
+		"education": [],
+		"certificates": [],
+		"skills": [
+			{"name": "Go", "level": "3年4ヶ月", "keywords": ["言語"]},
+			{"name": "AWS", "level": "1年", "keywords": ["ツール"]}
+		],
+		"meta": {"version": "v1.0.0", "lastModified": "2026-10-19T00:00:00Z"}
+	}`, rec.Body.String())
+}
@@ -0,0 +1,137 @@
+package presenter
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"stackies/backend/usecase"
+	"strings"
+)
+
+// renderResumeMarkdown は業務経歴書を Markdown にして w に書き出します
+// GitHub のプロフィール README に貼り付けることを想定し、表は GitHub Flavored Markdown で出力します
+func renderResumeMarkdown(w io.Writer, resume usecase.ResumeDto) error {
+	b := bufio.NewWriter(w)
+	profile := resume.Profile
+
+	title := profile.DisplayName
+	if title == "" {
+		title = "業務経歴書"
+	}
+	fmt.Fprintf(b, "# %s\n\n", markdownLine(title))
+	if profile.Headline != "" {
+		fmt.Fprintf(b, "**%s**\n\n", markdownLine(profile.Headline))
+	}
+
+	var basics []string
+	if profile.NearestStation != "" {
+		basics = append(basics, "最寄り駅: "+markdownLine(profile.NearestStation))
+	}
+	if profile.WorkStyle != "" {
+		basics = append(basics, "希望する働き方: "+workStyleLabel(profile.WorkStyle))
+	}
+	if len(profile.PreferredLocations) > 0 {
+		basics = append(basics, "希望勤務地: "+markdownLine(strings.Join(profile.PreferredLocations, "、")))
+	}
+	if len(basics) > 0 {
+		b.WriteString("## 基本情報\n\n")
+		for _, basic := range basics {
+			fmt.Fprintf(b, "- %s\n", basic)
+		}
+		b.WriteString("\n")
+	}
+
+	// 自己PRは本人が書いた Markdown としてそのまま出力する
+	if selfPR := strings.TrimSpace(profile.SelfPR); selfPR != "" {
+		fmt.Fprintf(b, "## 自己PR\n\n%s\n\n", selfPR)
+	}
+
+	if len(resume.Skills) > 0 {
+		b.WriteString("## スキル\n\n| 区分 | スキル | 経験期間 | 最終使用 | 案件数 | 習熟度 |\n| --- | --- | --- | --- | --: | --- |\n")
+		for _, s := range resume.Skills {
+			fmt.Fprintf(b, "| %s | %s | %s | %s | %d | %s |\n",
+				skillCategoryLabel(s.Category), markdownCell(s.Name), formatDuration(s.Months), formatResumeMonth(s.LastUsedMonth), s.ExperienceCount, formatSkillProficiency(s))
+		}
+		b.WriteString("\n")
+	}
+
+	if len(resume.Experiences) > 0 {
+		b.WriteString("## 業務経歴\n\n")
+		for _, e := range resume.Experiences {
+			fmt.Fprintf(b, "### %s\n\n", markdownLine(e.Title))
+			if e.StartMonth != nil {
+				fmt.Fprintf(b, "- 期間: %s\n", strings.ReplaceAll(formatResumePeriod(e.StartMonth, e.EndMonth, resume.GeneratedAt), "\n", " "))
+			}
+			if client := clientLabel(e); client != "" {
+				fmt.Fprintf(b, "- 顧客: %s\n", markdownLine(client))
+			}
+			if e.TeamSize > 0 {
+				fmt.Fprintf(b, "- 規模: %s\n", formatTeamSize(e.TeamSize, resume.Anonymized))
+			}
+			if len(e.Phases) > 0 {
+				phases := make([]string, len(e.Phases))
+				for i, phase := range e.Phases {
+					phases[i] = phaseLabel(phase)
+				}
+				fmt.Fprintf(b, "- 担当工程: %s\n", strings.Join(phases, "、"))
+			}
+			if len(e.Skills) > 0 {
+				skills := make([]string, len(e.Skills))
+				for i, s := range e.Skills {
+					skills[i] = "`" + strings.ReplaceAll(s.Name, "`", "") + "`"
+				}
+				fmt.Fprintf(b, "- 使用技術: %s\n", strings.Join(skills, " "))
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	if len(profile.Certifications) > 0 {
+		b.WriteString("## 保有資格\n\n")
+		for _, c := range profile.Certifications {
+			line := markdownLine(c.Name)
+			if c.AcquiredMonth != nil {
+				line = formatResumeMonth(c.AcquiredMonth) + " " + line
+			}
+			if c.Issuer != "" {
+				line += "（" + markdownLine(c.Issuer) + "）"
+			}
+			fmt.Fprintf(b, "- %s\n", line)
+		}
+		b.WriteString("\n")
+	}
+
+	if len(profile.Educations) > 0 {
+		b.WriteString("## 学歴\n\n")
+		for _, e := range profile.Educations {
+			parts := []string{}
+			if e.StartMonth != nil {
+				end := "在学中"
+				if e.EndMonth != nil {
+					end = formatResumeMonth(e.EndMonth)
+				}
+				parts = append(parts, formatResumeMonth(e.StartMonth)+" 〜 "+end)
+			}
+			for _, part := range []string{e.SchoolName, e.Faculty, e.Degree} {
+				if part != "" {
+					parts = append(parts, markdownLine(part))
+				}
+			}
+			fmt.Fprintf(b, "- %s\n", strings.Join(parts, " "))
+		}
+		b.WriteString("\n")
+	}
+
+	fmt.Fprintf(b, "_%s現在_\n", resume.GeneratedAt.Format("2006年01月02日"))
+	return b.Flush()
+}
+
+// markdownLine は改行を空白にして1行にします
+func markdownLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// markdownCell は表のセルに書けるよう「|」をエスケープします
+func markdownCell(s string) string {
+	return strings.ReplaceAll(markdownLine(s), "|", `\|`)
+}
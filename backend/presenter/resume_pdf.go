@@ -0,0 +1,397 @@
+package presenter
+
+import (
+	"fmt"
+	"io"
+	"stackies/backend/usecase"
+	"strings"
+	"time"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+// 業務経歴書の PDF のレイアウト（単位は mm）
+const (
+	resumeFontFamily   = "resume"
+	resumeMargin       = 15.0
+	resumeFooterHeight = 10.0
+	resumeFontSize     = 9.0
+	resumeLineHeight   = 4.8
+	resumeCellPadding  = 1.2
+)
+
+var (
+	resumeHeaderFill  = [3]int{221, 230, 241}
+	resumeSectionFill = [3]int{47, 84, 150}
+)
+
+// resumePDF は業務経歴書を A4 縦の PDF に描画します
+// 表の行の途中で改ページしないよう、自動改ページは使わずに行ごとに残りの高さを確認します
+type resumePDF struct {
+	pdf *gofpdf.Fpdf
+}
+
+// renderResumePDF は業務経歴書を PDF にして w に書き出します
+// font は日本語のグリフを含む TrueType フォントで、PDF に埋め込みます
+func renderResumePDF(w io.Writer, resume usecase.ResumeDto, font []byte) error {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(resumeMargin, resumeMargin, resumeMargin)
+	pdf.SetAutoPageBreak(false, resumeMargin)
+	pdf.AddUTF8FontFromBytes(resumeFontFamily, "", font)
+	pdf.SetTitle("業務経歴書", true)
+	pdf.SetCreator("Stackies", true)
+	pdf.AliasNbPages("")
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-resumeFooterHeight)
+		pdf.SetFont(resumeFontFamily, "", 8)
+		pdf.SetTextColor(96, 96, 96)
+		pdf.CellFormat(0, 5, fmt.Sprintf("%d / {nb}", pdf.PageNo()), "", 0, "C", false, 0, "")
+	})
+
+	r := &resumePDF{pdf: pdf}
+	pdf.AddPage()
+	r.title(resume)
+	r.profile(resume.Profile)
+	r.selfPR(resume.Profile.SelfPR)
+	r.certifications(resume.Profile.Certifications)
+	r.educations(resume.Profile.Educations)
+	r.skills(resume.Skills)
+	r.experiences(resume.Experiences, resume.GeneratedAt, resume.Anonymized)
+
+	if err := pdf.Error(); err != nil {
+		return fmt.Errorf("failed to render resume pdf: %w", err)
+	}
+	return pdf.Output(w)
+}
+
+func (r *resumePDF) title(resume usecase.ResumeDto) {
+	r.pdf.SetFont(resumeFontFamily, "", 16)
+	r.pdf.SetTextColor(0, 0, 0)
+	r.pdf.CellFormat(0, 10, "業務経歴書", "", 1, "C", false, 0, "")
+	r.pdf.SetFont(resumeFontFamily, "", resumeFontSize)
+	r.pdf.CellFormat(0, resumeLineHeight, resume.GeneratedAt.Format("2006年01月02日")+"現在", "", 1, "R", false, 0, "")
+	r.pdf.Ln(2)
+}
+
+func (r *resumePDF) profile(profile usecase.ProfileDto) {
+	r.section("基本情報")
+	rows := [][]string{
+		{"氏名", profile.DisplayName},
+		{"肩書き", profile.Headline},
+		{"最寄り駅", profile.NearestStation},
+		{"希望する働き方", workStyleLabel(profile.WorkStyle)},
+		{"希望勤務地", strings.Join(profile.PreferredLocations, "、")},
+	}
+	widths := []float64{35, r.contentWidth() - 35}
+	for _, row := range rows {
+		r.ensureSpace(r.rowHeight(widths, row))
+		r.row(widths, row, []bool{true, false})
+	}
+}
+
+func (r *resumePDF) selfPR(selfPR string) {
+	if strings.TrimSpace(selfPR) == "" {
+		return
+	}
+	r.section("自己PR")
+	r.pdf.SetFont(resumeFontFamily, "", resumeFontSize)
+	for _, line := range r.pdf.SplitText(pdfText(selfPR), r.contentWidth()) {
+		r.ensureSpace(resumeLineHeight)
+		r.pdf.CellFormat(0, resumeLineHeight, line, "", 1, "L", false, 0, "")
+	}
+}
+
+func (r *resumePDF) certifications(certifications []usecase.CertificationDto) {
+	if len(certifications) == 0 {
+		return
+	}
+	r.section("保有資格")
+	rows := make([][]string, len(certifications))
+	for i, c := range certifications {
+		rows[i] = []string{formatResumeMonth(c.AcquiredMonth), c.Name, c.Issuer}
+	}
+	r.table([]float64{30, 100, r.contentWidth() - 130}, []string{"取得年月", "資格名", "発行元"}, rows)
+}
+
+func (r *resumePDF) educations(educations []usecase.EducationDto) {
+	if len(educations) == 0 {
+		return
+	}
+	r.section("学歴")
+	rows := make([][]string, len(educations))
+	for i, e := range educations {
+		period := ""
+		if e.StartMonth != nil {
+			end := "在学中"
+			if e.EndMonth != nil {
+				end = formatResumeMonth(e.EndMonth)
+			}
+			period = formatResumeMonth(e.StartMonth) + " 〜 " + end
+		}
+		rows[i] = []string{period, e.SchoolName, e.Faculty, e.Degree}
+	}
+	r.table([]float64{45, 60, 50, r.contentWidth() - 155}, []string{"期間", "学校名", "学部・学科", "学位"}, rows)
+}
+
+func (r *resumePDF) skills(skills []usecase.SkillSummaryDto) {
+	if len(skills) == 0 {
+		return
+	}
+	r.section("スキルサマリ")
+	rows := make([][]string, len(skills))
+	for i, s := range skills {
+		rows[i] = []string{
+			skillCategoryLabel(s.Category),
+			s.Name,
+			formatDuration(s.Months),
+			formatResumeMonth(s.LastUsedMonth),
+			fmt.Sprintf("%d件", s.ExperienceCount),
+			formatSkillProficiency(s),
+		}
+	}
+	r.table([]float64{22, r.contentWidth() - 147, 35, 30, 25, 35}, []string{"区分", "スキル", "経験期間", "最終使用", "案件数", "習熟度"}, rows)
+}
+
+// approximate はチーム人数が丸めた値であることを表します
+func (r *resumePDF) experiences(experiences []usecase.ExperienceDto, now time.Time, approximate bool) {
+	if len(experiences) == 0 {
+		return
+	}
+	r.section("業務経歴")
+	rows := make([][]string, len(experiences))
+	for i, e := range experiences {
+		skills := make([]string, len(e.Skills))
+		for j, s := range e.Skills {
+			skills[j] = s.Name
+		}
+		description := e.Title
+		if client := clientLabel(e); client != "" {
+			description += "\n顧客: " + client
+		}
+		if len(e.Phases) > 0 {
+			phases := make([]string, len(e.Phases))
+			for j, phase := range e.Phases {
+				phases[j] = phaseLabel(phase)
+			}
+			description += "\n担当工程: " + strings.Join(phases, "、")
+		}
+		rows[i] = []string{
+			fmt.Sprint(i + 1),
+			formatResumePeriod(e.StartMonth, e.EndMonth, now),
+			description,
+			strings.Join(skills, "\n"),
+			formatTeamSize(e.TeamSize, approximate),
+		}
+	}
+	r.table([]float64{10, 38, r.contentWidth() - 106, 40, 18}, []string{"No", "期間", "業務内容", "使用技術", "規模"}, rows)
+}
+
+// section は見出しを描画します。見出しだけがページの末尾に残らないよう、続く1行分の高さも確保します
+func (r *resumePDF) section(title string) {
+	r.pdf.Ln(4)
+	r.ensureSpace(7 + resumeLineHeight*2)
+	r.pdf.SetFont(resumeFontFamily, "", 11)
+	r.pdf.SetFillColor(resumeSectionFill[0], resumeSectionFill[1], resumeSectionFill[2])
+	r.pdf.SetTextColor(255, 255, 255)
+	r.pdf.CellFormat(0, 7, " "+title, "", 1, "L", true, 0, "")
+	r.pdf.SetTextColor(0, 0, 0)
+	r.pdf.SetFont(resumeFontFamily, "", resumeFontSize)
+	r.pdf.Ln(1)
+}
+
+// table は見出し行とデータ行からなる表を描画します。改ページした場合は次のページにも見出し行を描画します
+func (r *resumePDF) table(widths []float64, header []string, rows [][]string) {
+	headerFill := make([]bool, len(header))
+	for i := range headerFill {
+		headerFill[i] = true
+	}
+	r.pdf.SetFont(resumeFontFamily, "", resumeFontSize)
+
+	headerHeight := r.rowHeight(widths, header)
+	if len(rows) > 0 {
+		r.ensureSpace(headerHeight + r.rowHeight(widths, rows[0]))
+	}
+	r.row(widths, header, headerFill)
+	for _, cells := range rows {
+		if h := r.rowHeight(widths, cells); h > r.remaining() {
+			r.pdf.AddPage()
+			r.row(widths, header, headerFill)
+		}
+		r.row(widths, cells, nil)
+	}
+}
+
+// row は表の1行を描画します。セル内の文字列は折り返し、行の高さは最も高いセルに合わせます
+func (r *resumePDF) row(widths []float64, cells []string, fill []bool) {
+	h := r.rowHeight(widths, cells)
+	x, y := r.pdf.GetXY()
+	for i, cell := range cells {
+		style := "D"
+		if i < len(fill) && fill[i] {
+			r.pdf.SetFillColor(resumeHeaderFill[0], resumeHeaderFill[1], resumeHeaderFill[2])
+			style = "FD"
+		}
+		r.pdf.SetDrawColor(128, 128, 128)
+		r.pdf.Rect(x, y, widths[i], h, style)
+		for j, line := range r.pdf.SplitText(pdfText(cell), widths[i]) {
+			r.pdf.SetXY(x, y+resumeCellPadding+float64(j)*resumeLineHeight)
+			r.pdf.CellFormat(widths[i], resumeLineHeight, line, "", 0, "L", false, 0, "")
+		}
+		x += widths[i]
+	}
+	r.pdf.SetXY(resumeMargin, y+h)
+}
+
+func (r *resumePDF) rowHeight(widths []float64, cells []string) float64 {
+	lines := 1
+	for i, cell := range cells {
+		if n := len(r.pdf.SplitText(pdfText(cell), widths[i])); n > lines {
+			lines = n
+		}
+	}
+	return float64(lines)*resumeLineHeight + resumeCellPadding*2
+}
+
+// ensureSpace は高さ h が現在のページに収まらない場合に改ページします
+func (r *resumePDF) ensureSpace(h float64) {
+	if h > r.remaining() {
+		r.pdf.AddPage()
+	}
+}
+
+func (r *resumePDF) remaining() float64 {
+	_, pageHeight := r.pdf.GetPageSize()
+	return pageHeight - resumeMargin - resumeFooterHeight - r.pdf.GetY()
+}
+
+func (r *resumePDF) contentWidth() float64 {
+	pageWidth, _ := r.pdf.GetPageSize()
+	return pageWidth - resumeMargin*2
+}
+
+// pdfText は PDF に描画できない文字を置き換えます
+// gofpdf は基本多言語面（U+FFFF）までの文字にしか対応していないため、絵文字などは「?」にします
+func pdfText(s string) string {
+	s = strings.ReplaceAll(s, "\r", "")
+	return strings.Map(func(r rune) rune {
+		if r > 0xFFFF {
+			return '?'
+		}
+		return r
+	}, s)
+}
+
+func formatResumeMonth(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format("2006年01月")
+}
+
+// formatResumePeriod は「2024年04月 〜 2025年03月（1年0ヶ月）」の形式で参画期間を返します
+func formatResumePeriod(start, end *time.Time, now time.Time) string {
+	if start == nil {
+		return ""
+	}
+	endLabel := "現在"
+	if end != nil {
+		endLabel = formatResumeMonth(end)
+	}
+	return fmt.Sprintf("%s 〜\n%s\n（%s）", formatResumeMonth(start), endLabel, formatDuration(experienceMonths(start, end, now)))
+}
+
+// experienceMonths は参画期間の月数を返します。参画中の場合は now の月までを数えます
+func experienceMonths(start, end *time.Time, now time.Time) int {
+	if start == nil {
+		return 0
+	}
+	last := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if end != nil {
+		last = *end
+	}
+	return (last.Year()-start.Year())*12 + int(last.Month()-start.Month()) + 1
+}
+
+// formatDuration は月数を「1年6ヶ月」の形式で返します
+func formatDuration(months int) string {
+	switch {
+	case months <= 0:
+		return "-"
+	case months < 12:
+		return fmt.Sprintf("%dヶ月", months)
+	case months%12 == 0:
+		return fmt.Sprintf("%d年", months/12)
+	default:
+		return fmt.Sprintf("%d年%dヶ月", months/12, months%12)
+	}
+}
+
+// formatSkillProficiency はスキルの習熟度を「自己 4 / 推薦 3.5（2件）」の形式で返します（評価がない場合は "-"）
+func formatSkillProficiency(s usecase.SkillSummaryDto) string {
+	var parts []string
+	if s.SelfLevel > 0 {
+		parts = append(parts, fmt.Sprintf("自己 %d", s.SelfLevel))
+	}
+	if s.EndorsementCount > 0 {
+		parts = append(parts, fmt.Sprintf("推薦 %.1f（%d件）", s.PeerLevel, s.EndorsementCount))
+	}
+	if len(parts) == 0 {
+		return "-"
+	}
+	return strings.Join(parts, " / ")
+}
+
+// formatTeamSize はチーム人数を「8名」の形式で返します（未設定は空文字）
+// approximate の場合は丸めた人数のため「約10名」とします
+func formatTeamSize(teamSize int, approximate bool) string {
+	switch {
+	case teamSize <= 0:
+		return ""
+	case approximate:
+		return fmt.Sprintf("約%d名", teamSize)
+	default:
+		return fmt.Sprintf("%d名", teamSize)
+	}
+}
+
+// clientLabel は業務経歴書に表示する顧客名を返します
+// 顧客名を除いた業務経歴書（匿名化・共有リンク）では公開用の名前を返します
+func clientLabel(e usecase.ExperienceDto) string {
+	if e.ClientName != "" {
+		return e.ClientName
+	}
+	return e.ClientAlias
+}
+
+func workStyleLabel(workStyle string) string {
+	switch workStyle {
+	case "remote":
+		return "フルリモート"
+	case "hybrid":
+		return "リモート・出社の併用"
+	case "onsite":
+		return "出社"
+	default:
+		return workStyle
+	}
+}
+
+func skillCategoryLabel(category string) string {
+	switch category {
+	case "language":
+		return "言語"
+	case "tool":
+		return "ツール"
+	default:
+		return category
+	}
+}
+
+func phaseLabel(phase string) string {
+	for _, column := range resumePhaseColumns {
+		if column.phase == phase {
+			return column.label
+		}
+	}
+	return phase
+}
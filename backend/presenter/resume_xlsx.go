@@ -0,0 +1,404 @@
+package presenter
+
+import (
+	"fmt"
+	"regexp"
+	"stackies/backend/usecase"
+	"strconv"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// placeholderPattern はテンプレートのセルに書くプレースホルダー（{{display_name}}、{{experiences.title}} など）です
+var placeholderPattern = regexp.MustCompile(`\{\{\s*([a-z_]+(?:\.[a-z_]+)*)\s*\}\}`)
+
+// resumeLists は行を繰り返して埋め込む一覧の名前です
+var resumeLists = []string{"experiences", "skills", "certifications", "educations"}
+
+// resumePhaseColumns は業務経歴の工程の列です（上流から順）
+var resumePhaseColumns = []struct {
+	phase string
+	label string
+}{
+	{"requirements", "要件定義"},
+	{"basic_design", "基本設計"},
+	{"detailed_design", "詳細設計"},
+	{"implementation", "実装"},
+	{"testing", "テスト"},
+	{"operation", "運用"},
+}
+
+// resumeValues はテンプレートのプレースホルダーに埋め込む値です
+type resumeValues struct {
+	// scalars は {{display_name}} のような1つの値です
+	scalars map[string]string
+	// lists は {{experiences.title}} のような一覧の値です。一覧を含む行は要素の数だけ繰り返します
+	lists map[string][]map[string]string
+}
+
+func newResumeValues(resume usecase.ResumeDto) resumeValues {
+	profile := resume.Profile
+	values := resumeValues{
+		scalars: map[string]string{
+			"display_name":        profile.DisplayName,
+			"headline":            profile.Headline,
+			"self_pr":             profile.SelfPR,
+			"nearest_station":     profile.NearestStation,
+			"work_style":          workStyleLabel(profile.WorkStyle),
+			"preferred_locations": strings.Join(profile.PreferredLocations, "、"),
+			"generated_at":        resume.GeneratedAt.Format("2006年01月02日"),
+		},
+		lists: make(map[string][]map[string]string),
+	}
+
+	for _, c := range profile.Certifications {
+		values.lists["certifications"] = append(values.lists["certifications"], map[string]string{
+			"name":           c.Name,
+			"issuer":         c.Issuer,
+			"acquired_month": formatResumeMonth(c.AcquiredMonth),
+		})
+	}
+	for _, e := range profile.Educations {
+		values.lists["educations"] = append(values.lists["educations"], map[string]string{
+			"school_name": e.SchoolName,
+			"faculty":     e.Faculty,
+			"degree":      e.Degree,
+			"start_month": formatResumeMonth(e.StartMonth),
+			"end_month":   formatResumeMonth(e.EndMonth),
+		})
+	}
+	for _, s := range resume.Skills {
+		values.lists["skills"] = append(values.lists["skills"], map[string]string{
+			"category":          skillCategoryLabel(s.Category),
+			"name":              s.Name,
+			"months":            strconv.Itoa(s.Months),
+			"duration":          formatDuration(s.Months),
+			"last_used":         formatResumeMonth(s.LastUsedMonth),
+			"experience_count":  strconv.Itoa(s.ExperienceCount),
+			"level":             skillLevelMark(s.Months),
+			"self_level":        formatLevel(float64(s.SelfLevel), "%.0f"),
+			"peer_level":        formatLevel(s.PeerLevel, "%.1f"),
+			"endorsement_count": strconv.Itoa(s.EndorsementCount),
+		})
+	}
+	for i, e := range resume.Experiences {
+		var languages, tools, skills []string
+		for _, s := range e.Skills {
+			skills = append(skills, s.Name)
+			if s.Category == "language" {
+				languages = append(languages, s.Name)
+			} else {
+				tools = append(tools, s.Name)
+			}
+		}
+		item := map[string]string{
+			"no":          strconv.Itoa(i + 1),
+			"title":       e.Title,
+			"client":      clientLabel(e),
+			"start_month": formatResumeMonth(e.StartMonth),
+			"end_month":   formatResumeMonth(e.EndMonth),
+			"period":      "",
+			"months":      "",
+			"duration":    "",
+			"team_size":   "",
+			"skills":      strings.Join(skills, "、"),
+			"languages":   strings.Join(languages, "、"),
+			"tools":       strings.Join(tools, "、"),
+		}
+		if e.StartMonth != nil {
+			months := experienceMonths(e.StartMonth, e.EndMonth, resume.GeneratedAt)
+			item["period"] = strings.ReplaceAll(formatResumePeriod(e.StartMonth, e.EndMonth, resume.GeneratedAt), "\n", " ")
+			item["months"] = strconv.Itoa(months)
+			item["duration"] = formatDuration(months)
+		}
+		if e.TeamSize > 0 {
+			item["team_size"] = strconv.Itoa(e.TeamSize)
+		}
+		for _, column := range resumePhaseColumns {
+			item["phase."+column.phase] = ""
+		}
+		for _, phase := range e.Phases {
+			item["phase."+phase] = "●"
+		}
+		values.lists["experiences"] = append(values.lists["experiences"], item)
+	}
+	return values
+}
+
+// resolve はプレースホルダーの値を返します。item は行を繰り返している一覧の要素です
+func (v resumeValues) resolve(name, list string, item map[string]string) (string, bool) {
+	if list != "" && strings.HasPrefix(name, list+".") {
+		if item == nil {
+			// 一覧が空の場合は一覧のプレースホルダーを空欄にする
+			return "", true
+		}
+		value, ok := item[strings.TrimPrefix(name, list+".")]
+		return value, ok
+	}
+	value, ok := v.scalars[name]
+	return value, ok
+}
+
+// fillResumeTemplate はテンプレートの全シートのプレースホルダーを業務経歴書の値で置き換えます
+// 一覧のプレースホルダーを含む行は要素の数だけ行を複製します（書式も複製されます）
+// 未知のプレースホルダーはテンプレートの誤りに気づけるようにそのまま残します
+func fillResumeTemplate(f *excelize.File, values resumeValues) error {
+	for _, sheet := range f.GetSheetList() {
+		rows, err := f.GetRows(sheet)
+		if err != nil {
+			return fmt.Errorf("failed to read sheet %q: %w", sheet, err)
+		}
+		// 行を複製すると下の行がずれるため、下の行から処理する
+		for r := len(rows); r >= 1; r-- {
+			cells := rows[r-1]
+			list := rowList(cells)
+			if list == "" {
+				if err := fillRow(f, sheet, r, cells, values, "", nil); err != nil {
+					return err
+				}
+				continue
+			}
+
+			items := values.lists[list]
+			for i := 1; i < len(items); i++ {
+				if err := f.DuplicateRow(sheet, r); err != nil {
+					return fmt.Errorf("failed to duplicate row %d of sheet %q: %w", r, sheet, err)
+				}
+			}
+			if len(items) == 0 {
+				if err := fillRow(f, sheet, r, cells, values, list, nil); err != nil {
+					return err
+				}
+			}
+			for i, item := range items {
+				if err := fillRow(f, sheet, r+i, cells, values, list, item); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// rowList は行が参照している一覧の名前を返します（参照していない場合は空文字）
+func rowList(cells []string) string {
+	for _, cell := range cells {
+		for _, match := range placeholderPattern.FindAllStringSubmatch(cell, -1) {
+			for _, list := range resumeLists {
+				if strings.HasPrefix(match[1], list+".") {
+					return list
+				}
+			}
+		}
+	}
+	return ""
+}
+
+func fillRow(f *excelize.File, sheet string, row int, cells []string, values resumeValues, list string, item map[string]string) error {
+	for c, text := range cells {
+		if !placeholderPattern.MatchString(text) {
+			continue
+		}
+		cell, err := excelize.CoordinatesToCellName(c+1, row)
+		if err != nil {
+			return err
+		}
+
+		// セル全体が1つのプレースホルダーで値が数値の場合は数値として設定する
+		if match := placeholderPattern.FindStringSubmatch(text); match[0] == strings.TrimSpace(text) {
+			if value, ok := values.resolve(match[1], list, item); ok {
+				if n, err := strconv.Atoi(value); err == nil {
+					if err := f.SetCellInt(sheet, cell, int64(n)); err != nil {
+						return err
+					}
+					continue
+				}
+			}
+		}
+
+		filled := placeholderPattern.ReplaceAllStringFunc(text, func(placeholder string) string {
+			name := placeholderPattern.FindStringSubmatch(placeholder)[1]
+			if value, ok := values.resolve(name, list, item); ok {
+				return value
+			}
+			return placeholder
+		})
+		if err := f.SetCellStr(sheet, cell, filled); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// formatLevel は習熟度を書式に従って返します。0（評価なし）は空文字です
+func formatLevel(level float64, format string) string {
+	if level == 0 {
+		return ""
+	}
+	return fmt.Sprintf(format, level)
+}
+
+// skillLevelMark は経験期間からスキルシートの習熟度の記号を返します
+// ◎ は3年以上、○ は1年以上、△ は1年未満の経験です
+func skillLevelMark(months int) string {
+	switch {
+	case months >= 36:
+		return "◎"
+	case months >= 12:
+		return "○"
+	case months > 0:
+		return "△"
+	default:
+		return ""
+	}
+}
+
+// newDefaultResumeTemplate は標準のスキルシートのテンプレートを作成します
+// プロフィール・スキル・業務経歴の3シートで、アップロードされたテンプレートと同じ方法で値を埋め込みます
+func newDefaultResumeTemplate() (*excelize.File, error) {
+	f := excelize.NewFile()
+	t := &templateBuilder{f: f}
+	t.styles()
+
+	t.sheet("プロフィール", []float64{18, 30, 30, 24})
+	t.set("A1", "業務経歴書", t.titleStyle)
+	t.set("D2", "{{generated_at}}現在", 0)
+	profileRows := [][2]string{
+		{"氏名", "{{display_name}}"},
+		{"肩書き", "{{headline}}"},
+		{"最寄り駅", "{{nearest_station}}"},
+		{"希望する働き方", "{{work_style}}"},
+		{"希望勤務地", "{{preferred_locations}}"},
+		{"自己PR", "{{self_pr}}"},
+	}
+	for i, row := range profileRows {
+		r := 3 + i
+		t.set(cellName(1, r), row[0], t.headerStyle)
+		t.set(cellName(2, r), row[1], t.bodyStyle)
+		t.merge(cellName(2, r), cellName(4, r), t.bodyStyle)
+	}
+	t.f.SetRowHeight(t.name, 8, 120)
+	t.set("A10", "保有資格", t.sectionStyle)
+	t.header(11, "取得年月", "資格名", "発行元")
+	t.body(12, "{{certifications.acquired_month}}", "{{certifications.name}}", "{{certifications.issuer}}")
+	t.set("A14", "学歴", t.sectionStyle)
+	t.header(15, "入学年月", "学校名", "学部・学科", "学位")
+	t.body(16, "{{educations.start_month}}", "{{educations.school_name}}", "{{educations.faculty}}", "{{educations.degree}}")
+
+	t.sheet("スキル", []float64{10, 24, 14, 12, 14, 10, 10, 10, 10})
+	t.header(1, "区分", "スキル", "経験期間", "経験月数", "最終使用", "案件数", "習熟度", "自己評価", "推薦")
+	t.body(2, "{{skills.category}}", "{{skills.name}}", "{{skills.duration}}", "{{skills.months}}", "{{skills.last_used}}", "{{skills.experience_count}}", "{{skills.level}}", "{{skills.self_level}}", "{{skills.peer_level}}")
+	t.freeze()
+
+	widths := []float64{6, 30, 40, 24, 30, 8}
+	header := []string{"No", "期間", "業務内容", "顧客", "使用技術", "規模"}
+	body := []string{"{{experiences.no}}", "{{experiences.period}}", "{{experiences.title}}", "{{experiences.client}}", "{{experiences.skills}}", "{{experiences.team_size}}"}
+	for _, column := range resumePhaseColumns {
+		widths = append(widths, 9)
+		header = append(header, column.label)
+		body = append(body, "{{experiences.phase."+column.phase+"}}")
+	}
+	t.sheet("業務経歴", widths)
+	t.header(1, header...)
+	t.body(2, body...)
+	t.freeze()
+
+	if err := f.DeleteSheet("Sheet1"); err != nil {
+		return nil, err
+	}
+	if t.err != nil {
+		return nil, t.err
+	}
+	return f, nil
+}
+
+// templateBuilder は標準テンプレートを作成します。最初に発生したエラーを err に保持します
+type templateBuilder struct {
+	f    *excelize.File
+	name string
+	err  error
+
+	titleStyle   int
+	sectionStyle int
+	headerStyle  int
+	bodyStyle    int
+}
+
+func (t *templateBuilder) styles() {
+	border := []excelize.Border{
+		{Type: "left", Color: "808080", Style: 1},
+		{Type: "right", Color: "808080", Style: 1},
+		{Type: "top", Color: "808080", Style: 1},
+		{Type: "bottom", Color: "808080", Style: 1},
+	}
+	t.titleStyle = t.style(&excelize.Style{Font: &excelize.Font{Bold: true, Size: 16}})
+	t.sectionStyle = t.style(&excelize.Style{Font: &excelize.Font{Bold: true, Size: 12}})
+	t.headerStyle = t.style(&excelize.Style{
+		Border:    border,
+		Fill:      excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{"DDE6F1"}},
+		Font:      &excelize.Font{Bold: true},
+		Alignment: &excelize.Alignment{Horizontal: "center", Vertical: "center", WrapText: true},
+	})
+	t.bodyStyle = t.style(&excelize.Style{
+		Border:    border,
+		Alignment: &excelize.Alignment{Vertical: "top", WrapText: true},
+	})
+}
+
+func (t *templateBuilder) style(style *excelize.Style) int {
+	id, err := t.f.NewStyle(style)
+	t.keep(err)
+	return id
+}
+
+func (t *templateBuilder) sheet(name string, widths []float64) {
+	_, err := t.f.NewSheet(name)
+	t.keep(err)
+	t.name = name
+	for i, width := range widths {
+		col, err := excelize.ColumnNumberToName(i + 1)
+		t.keep(err)
+		t.keep(t.f.SetColWidth(name, col, col, width))
+	}
+}
+
+func (t *templateBuilder) set(cell, value string, style int) {
+	t.keep(t.f.SetCellStr(t.name, cell, value))
+	if style != 0 {
+		t.keep(t.f.SetCellStyle(t.name, cell, cell, style))
+	}
+}
+
+func (t *templateBuilder) merge(from, to string, style int) {
+	t.keep(t.f.MergeCell(t.name, from, to))
+	t.keep(t.f.SetCellStyle(t.name, from, to, style))
+}
+
+func (t *templateBuilder) header(row int, labels ...string) {
+	for i, label := range labels {
+		t.set(cellName(i+1, row), label, t.headerStyle)
+	}
+}
+
+func (t *templateBuilder) body(row int, placeholders ...string) {
+	for i, placeholder := range placeholders {
+		t.set(cellName(i+1, row), placeholder, t.bodyStyle)
+	}
+}
+
+// freeze は見出し行（1行目）を固定します
+func (t *templateBuilder) freeze() {
+	t.keep(t.f.SetPanes(t.name, &excelize.Panes{Freeze: true, YSplit: 1, TopLeftCell: "A2", ActivePane: "bottomLeft"}))
+}
+
+func (t *templateBuilder) keep(err error) {
+	if t.err == nil {
+		t.err = err
+	}
+}
+
+func cellName(col, row int) string {
+	name, _ := excelize.CoordinatesToCellName(col, row)
+	return name
+}
@@ -0,0 +1,266 @@
+package presenter
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"stackies/backend/usecase"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// HeaderXSharePassword はパスワード付きの共有リンクを閲覧するためのパスワードのヘッダーです
+// アクセスログや Referer に残らないよう、クエリパラメータでは受け付けません
+const HeaderXSharePassword = "X-Share-Password"
+
+type shareLinkHandler struct {
+	shareLinkUsecase usecase.ShareLinkUsecase
+	// pdfFont は PDF に埋め込む日本語フォントです。nil の場合は PDF を出力できません
+	pdfFont []byte
+}
+
+// ShareLinkRequest は共有リンクの作成のリクエストです
+type ShareLinkRequest struct {
+	Label string `json:"label"`
+	// Sections は公開する項目です（省略時は全ての項目）
+	Sections        []string `json:"sections"`
+	HideClientNames bool     `json:"hide_client_names"`
+	HidePeriod      bool     `json:"hide_period"`
+	// Anonymize は顧客名を公開用の名前に置き換え、チーム人数を丸め、氏名をイニシャルにすることを表します
+	Anonymize bool `json:"anonymize"`
+	// ExpiresInDays は有効日数です（省略時は 30 日、最大 90 日）
+	ExpiresInDays int `json:"expires_in_days"`
+	// Password は閲覧用パスワードです（省略時はパスワードなし）
+	Password string `json:"password"`
+}
+
+func (r *ShareLinkRequest) ConvertToInput() usecase.ShareLinkInput {
+	return usecase.ShareLinkInput{
+		Label:           r.Label,
+		Sections:        r.Sections,
+		HideClientNames: r.HideClientNames,
+		HidePeriod:      r.HidePeriod,
+		Anonymize:       r.Anonymize,
+		ExpiresInDays:   r.ExpiresInDays,
+		Password:        r.Password,
+	}
+}
+
+type ShareLinkResponse struct {
+	ID                int        `json:"id"`
+	Label             string     `json:"label"`
+	Sections          []string   `json:"sections"`
+	HideClientNames   bool       `json:"hide_client_names"`
+	HidePeriod        bool       `json:"hide_period"`
+	Anonymize         bool       `json:"anonymize"`
+	PasswordProtected bool       `json:"password_protected"`
+	ExpiresAt         time.Time  `json:"expires_at"`
+	RevokedAt         *time.Time `json:"revoked_at,omitempty"`
+	ViewCount         int        `json:"view_count"`
+	LastViewedAt      *time.Time `json:"last_viewed_at,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	Active            bool       `json:"active"`
+}
+
+func (r *ShareLinkResponse) ConvertToDto(link usecase.ShareLinkDto) {
+	r.ID = link.ID
+	r.Label = link.Label
+	r.Sections = link.Sections
+	r.HideClientNames = link.HideClientNames
+	r.HidePeriod = link.HidePeriod
+	r.Anonymize = link.Anonymize
+	r.PasswordProtected = link.PasswordProtected
+	r.ExpiresAt = link.ExpiresAt
+	r.RevokedAt = link.RevokedAt
+	r.ViewCount = link.ViewCount
+	r.LastViewedAt = link.LastViewedAt
+	r.CreatedAt = link.CreatedAt
+	r.Active = link.Active
+}
+
+// CreatedShareLinkResponse は作成した共有リンクです
+// Token は GET /share/{token} の閲覧に使います。保存していないため、作成時にしか返しません
+type CreatedShareLinkResponse struct {
+	ShareLinkResponse
+	Token string `json:"token"`
+}
+
+// SharedResumeResponse は共有リンクで公開している業務経歴書です
+// 公開しない項目は空で返します
+type SharedResumeResponse struct {
+	Sections    []string                   `json:"sections"`
+	Profile     ProfileResponse            `json:"profile"`
+	Skills      []SkillSummaryResponse     `json:"skills"`
+	Experiences []SharedExperienceResponse `json:"experiences"`
+	// Anonymized は匿名化した業務経歴書であることを表します。チーム人数は丸めた値です
+	Anonymized  bool      `json:"anonymized"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	GeneratedAt time.Time `json:"generated_at"`
+}
+
+type SkillSummaryResponse struct {
+	Category        string `json:"category"`
+	Name            string `json:"name"`
+	Months          int    `json:"months"`
+	LastUsedMonth   string `json:"last_used_month,omitempty"`
+	ExperienceCount int    `json:"experience_count"`
+	// 自己評価・他者評価の習熟度と推薦の数です（評価がない場合は省略）
+	SelfLevel        int     `json:"self_level,omitempty"`
+	PeerLevel        float64 `json:"peer_level,omitempty"`
+	EndorsementCount int     `json:"endorsement_count,omitempty"`
+}
+
+func newSkillSummaryResponses(skills []usecase.SkillSummaryDto) []SkillSummaryResponse {
+	responses := make([]SkillSummaryResponse, len(skills))
+	for i, s := range skills {
+		responses[i] = SkillSummaryResponse{
+			Category:         s.Category,
+			Name:             s.Name,
+			Months:           s.Months,
+			LastUsedMonth:    formatMonth(s.LastUsedMonth),
+			ExperienceCount:  s.ExperienceCount,
+			SelfLevel:        s.SelfLevel,
+			PeerLevel:        s.PeerLevel,
+			EndorsementCount: s.EndorsementCount,
+		}
+	}
+	return responses
+}
+
+// SharedExperienceResponse は公開する業務経歴です。共有先には業務経歴の ID を返しません
+type SharedExperienceResponse struct {
+	Title string `json:"title"`
+	// Client は顧客名です。顧客名を公開しない共有リンクでは公開用の名前を返します
+	Client     string          `json:"client,omitempty"`
+	StartMonth string          `json:"start_month,omitempty"`
+	EndMonth   string          `json:"end_month,omitempty"`
+	TeamSize   int             `json:"team_size,omitempty"`
+	Skills     []SkillResponse `json:"skills,omitempty"`
+	Phases     []string        `json:"phases,omitempty"`
+}
+
+func (r *SharedResumeResponse) ConvertToDto(shared usecase.SharedResumeDto) {
+	resume := shared.Resume
+	r.Sections = shared.Sections
+	r.Profile.ConvertToDto(resume.Profile)
+	r.Skills = newSkillSummaryResponses(resume.Skills)
+	r.Experiences = make([]SharedExperienceResponse, len(resume.Experiences))
+	for i, e := range resume.Experiences {
+		r.Experiences[i] = SharedExperienceResponse{
+			Title:      e.Title,
+			Client:     clientLabel(e),
+			StartMonth: formatMonth(e.StartMonth),
+			EndMonth:   formatMonth(e.EndMonth),
+			TeamSize:   e.TeamSize,
+			Phases:     e.Phases,
+		}
+		for _, skill := range e.Skills {
+			r.Experiences[i].Skills = append(r.Experiences[i].Skills, SkillResponse{Category: skill.Category, Name: skill.Name})
+		}
+	}
+	r.Anonymized = resume.Anonymized
+	r.ExpiresAt = shared.ExpiresAt
+	r.GeneratedAt = resume.GeneratedAt
+}
+
+// Create implements ShareLinkHandler.
+func (s *shareLinkHandler) Create(c echo.Context) error {
+	var request ShareLinkRequest
+	if err := c.Bind(&request); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	link, err := s.shareLinkUsecase.Create(c.Request().Context(), request.ConvertToInput())
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	response := CreatedShareLinkResponse{Token: link.Token}
+	response.ConvertToDto(link.ShareLinkDto)
+	return c.JSON(http.StatusCreated, response)
+}
+
+// List implements ShareLinkHandler.
+func (s *shareLinkHandler) List(c echo.Context) error {
+	links, err := s.shareLinkUsecase.List(c.Request().Context())
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	response := make([]ShareLinkResponse, len(links))
+	for i, link := range links {
+		response[i].ConvertToDto(link)
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// Revoke implements ShareLinkHandler.
+func (s *shareLinkHandler) Revoke(c echo.Context) error {
+	var id int
+	if err := echo.PathParamsBinder(c).MustInt("id", &id).BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	if err := s.shareLinkUsecase.Revoke(c.Request().Context(), id); err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// View implements ShareLinkHandler.
+// 認証なしで呼び出されるため、検索エンジンやキャッシュに残らないようにします
+func (s *shareLinkHandler) View(c echo.Context) error {
+	setSharedHeaders(c)
+	shared, err := s.view(c)
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	var response SharedResumeResponse
+	response.ConvertToDto(shared)
+	return c.JSON(http.StatusOK, response)
+}
+
+// ViewPDF implements ShareLinkHandler.
+func (s *shareLinkHandler) ViewPDF(c echo.Context) error {
+	setSharedHeaders(c)
+	if len(s.pdfFont) == 0 {
+		return errorJSON(c, http.StatusServiceUnavailable, errors.New("pdf export is not configured"))
+	}
+	shared, err := s.view(c)
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	var buf bytes.Buffer
+	if err := renderResumePDF(&buf, shared.Resume, s.pdfFont); err != nil {
+		return errorJSON(c, http.StatusInternalServerError, err)
+	}
+	c.Response().Header().Set(echo.HeaderContentDisposition, attachment("resume.pdf", resumeFileName))
+	return c.Blob(http.StatusOK, "application/pdf", buf.Bytes())
+}
+
+func (s *shareLinkHandler) view(c echo.Context) (usecase.SharedResumeDto, error) {
+	return s.shareLinkUsecase.View(c.Request().Context(), c.Param("token"), c.Request().Header.Get(HeaderXSharePassword))
+}
+
+// setSharedHeaders は共有リンクのレスポンスをキャッシュ・インデックスさせず、閲覧先にトークンを送らないようにします
+func setSharedHeaders(c echo.Context) {
+	header := c.Response().Header()
+	header.Set("Cache-Control", "no-store")
+	header.Set("X-Robots-Tag", "noindex, nofollow")
+	header.Set("Referrer-Policy", "no-referrer")
+}
+
+type ShareLinkHandler interface {
+	Create(c echo.Context) error
+	List(c echo.Context) error
+	Revoke(c echo.Context) error
+	View(c echo.Context) error
+	ViewPDF(c echo.Context) error
+}
+
+// NewShareLinkHandler は共有リンクのハンドラーを作成します
+// pdfFont は日本語のグリフを含む TrueType フォントです
+func NewShareLinkHandler(shareLinkUsecase usecase.ShareLinkUsecase, pdfFont []byte) ShareLinkHandler {
+	return &shareLinkHandler{shareLinkUsecase: shareLinkUsecase, pdfFont: pdfFont}
+}
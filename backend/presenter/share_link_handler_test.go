@@ -0,0 +1,234 @@
+package presenter_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"stackies/backend/presenter"
+	"stackies/backend/usecase"
+	mock_usecase "stackies/backend/usecase/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShareLinkHandler_Create(t *testing.T) {
+	expiresAt := time.Date(2026, time.October, 26, 12, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		setupMock      func(mock *mock_usecase.MockShareLinkUsecase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "正常系: 共有リンクを作成してトークンを返す",
+			requestBody: `{"label":"A社 面談用","sections":["skills","experiences"],"hide_period":true,"expires_in_days":7,"password":"correct horse"}`,
+			setupMock: func(mock *mock_usecase.MockShareLinkUsecase) {
+				mock.EXPECT().Create(gomock.Any(), usecase.ShareLinkInput{
+					Label:         "A社 面談用",
+					Sections:      []string{"skills", "experiences"},
+					HidePeriod:    true,
+					ExpiresInDays: 7,
+					Password:      "correct horse",
+				}).Return(usecase.CreatedShareLinkDto{
+					ShareLinkDto: usecase.ShareLinkDto{
+						ID:                1,
+						Label:             "A社 面談用",
+						Sections:          []string{"skills", "experiences"},
+						HidePeriod:        true,
+						PasswordProtected: true,
+						ExpiresAt:         expiresAt,
+						CreatedAt:         createdAt,
+						Active:            true,
+					},
+					Token: "abc",
+				}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: `{"id":1,"label":"A社 面談用","sections":["skills","experiences"],"hide_client_names":false,"hide_period":true,"anonymize":false,` +
+				`"password_protected":true,"expires_at":"2026-10-26T12:00:00Z","view_count":0,"created_at":"2026-10-19T12:00:00Z","active":true,"token":"abc"}`,
+		},
+		{
+			name:        "異常系: 入力値が不正",
+			requestBody: `{"expires_in_days":365}`,
+			setupMock: func(mock *mock_usecase.MockShareLinkUsecase) {
+				mock.EXPECT().Create(gomock.Any(), gomock.Any()).Return(usecase.CreatedShareLinkDto{}, usecase.ErrInvalidInput)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/me/share-links", strings.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockShareLinkUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewShareLinkHandler(mockUsecase, nil)
+			err := handler.Create(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestShareLinkHandler_Revoke(t *testing.T) {
+	tests := []struct {
+		name           string
+		id             string
+		setupMock      func(mock *mock_usecase.MockShareLinkUsecase)
+		expectedStatus int
+	}{
+		{
+			name: "正常系: 共有リンクを無効にできる",
+			id:   "1",
+			setupMock: func(mock *mock_usecase.MockShareLinkUsecase) {
+				mock.EXPECT().Revoke(gomock.Any(), 1).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name: "異常系: 共有リンクが存在しない",
+			id:   "1",
+			setupMock: func(mock *mock_usecase.MockShareLinkUsecase) {
+				mock.EXPECT().Revoke(gomock.Any(), 1).Return(usecase.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "異常系: IDが数値でない",
+			id:             "abc",
+			setupMock:      func(mock *mock_usecase.MockShareLinkUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/me/share-links/"+tt.id, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.id)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockShareLinkUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewShareLinkHandler(mockUsecase, nil)
+			err := handler.Revoke(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestShareLinkHandler_View(t *testing.T) {
+	start := time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)
+	shared := usecase.SharedResumeDto{
+		Resume: usecase.ResumeDto{
+			Profile: usecase.ProfileDto{DisplayName: "山田 太郎"},
+			Skills:  []usecase.SkillSummaryDto{{Category: "language", Name: "Go", Months: 12, ExperienceCount: 1}},
+			Experiences: []usecase.ExperienceDto{
+				{Title: "ECサイト開発", ClientAlias: "大手小売業向け", StartMonth: &start, Skills: []usecase.SkillDto{{Category: "language", Name: "Go"}}},
+			},
+			GeneratedAt: time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC),
+		},
+		Sections:  []string{"profile", "skills", "experiences"},
+		ExpiresAt: time.Date(2026, time.November, 18, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name           string
+		password       string
+		setupMock      func(mock *mock_usecase.MockShareLinkUsecase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:     "正常系: 公開している業務経歴書を返す",
+			password: "correct horse",
+			setupMock: func(mock *mock_usecase.MockShareLinkUsecase) {
+				mock.EXPECT().View(gomock.Any(), "abc", "correct horse").Return(shared, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"sections":["profile","skills","experiences"],` +
+				`"profile":{"display_name":"山田 太郎","initials":"","headline":"","self_pr":"","nearest_station":"","work_style":"","preferred_locations":[],"certifications":[],"educations":[]},` +
+				`"skills":[{"category":"language","name":"Go","months":12,"experience_count":1}],` +
+				`"experiences":[{"title":"ECサイト開発","client":"大手小売業向け","start_month":"2024-04","skills":[{"category":"language","name":"Go"}]}],` +
+				`"anonymized":false,"expires_at":"2026-11-18T00:00:00Z","generated_at":"2026-10-19T00:00:00Z"}`,
+		},
+		{
+			name: "異常系: パスワードが必要",
+			setupMock: func(mock *mock_usecase.MockShareLinkUsecase) {
+				mock.EXPECT().View(gomock.Any(), "abc", "").Return(usecase.SharedResumeDto{}, usecase.ErrSharePasswordRequired)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "異常系: 期限切れまたは無効",
+			setupMock: func(mock *mock_usecase.MockShareLinkUsecase) {
+				mock.EXPECT().View(gomock.Any(), "abc", "").Return(usecase.SharedResumeDto{}, usecase.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "異常系: 業務経歴書の取得に失敗",
+			setupMock: func(mock *mock_usecase.MockShareLinkUsecase) {
+				mock.EXPECT().View(gomock.Any(), "abc", "").Return(usecase.SharedResumeDto{}, errors.New("DB error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/share/abc", nil)
+			if tt.password != "" {
+				req.Header.Set(presenter.HeaderXSharePassword, tt.password)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("token")
+			c.SetParamValues("abc")
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockShareLinkUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewShareLinkHandler(mockUsecase, nil)
+			err := handler.View(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package presenter
+
+import (
+	"net/http"
+	"stackies/backend/usecase"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type skillHandler struct {
+	skillUsecase usecase.SkillUsecase
+}
+
+// SkillLevelRequest はスキルの習熟度の自己評価のリクエストです
+type SkillLevelRequest struct {
+	Category string `json:"category"`
+	Name     string `json:"name"`
+	// Level は習熟度です（1: 学習中 〜 5: 他者に指導できる）
+	Level   int    `json:"level"`
+	Comment string `json:"comment"`
+}
+
+func (r *SkillLevelRequest) ConvertToInput() usecase.SkillLevelInput {
+	return usecase.SkillLevelInput{Category: r.Category, Name: r.Name, Level: r.Level, Comment: r.Comment}
+}
+
+// SkillEndorsementRequest はスキルの推薦のリクエストです
+type SkillEndorsementRequest struct {
+	Category string `json:"category"`
+	Name     string `json:"name"`
+	// Level は推薦するユーザーから見た習熟度です（1〜5）
+	Level   int    `json:"level"`
+	Comment string `json:"comment"`
+}
+
+func (r *SkillEndorsementRequest) ConvertToInput() usecase.SkillEndorsementInput {
+	return usecase.SkillEndorsementInput{Category: r.Category, Name: r.Name, Level: r.Level, Comment: r.Comment}
+}
+
+type SkillAssessmentResponse struct {
+	Category  string    `json:"category"`
+	Name      string    `json:"name"`
+	Level     int       `json:"level"`
+	Comment   string    `json:"comment"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (r *SkillAssessmentResponse) ConvertToDto(assessment usecase.SkillAssessmentDto) {
+	r.Category = assessment.Category
+	r.Name = assessment.Name
+	r.Level = assessment.Level
+	r.Comment = assessment.Comment
+	r.UpdatedAt = assessment.UpdatedAt
+}
+
+type SkillEndorsementResponse struct {
+	ID        int            `json:"id"`
+	UserID    string         `json:"user_id"`
+	Endorser  MemberResponse `json:"endorser"`
+	Category  string         `json:"category"`
+	Name      string         `json:"name"`
+	Level     int            `json:"level"`
+	Comment   string         `json:"comment"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+func (r *SkillEndorsementResponse) ConvertToDto(endorsement usecase.SkillEndorsementDto) {
+	r.ID = endorsement.ID
+	r.UserID = endorsement.UserID
+	r.Endorser = MemberResponse{UserID: endorsement.Endorser.UserID, DisplayName: endorsement.Endorser.DisplayName}
+	r.Category = endorsement.Category
+	r.Name = endorsement.Name
+	r.Level = endorsement.Level
+	r.Comment = endorsement.Comment
+	r.CreatedAt = endorsement.CreatedAt
+	r.UpdatedAt = endorsement.UpdatedAt
+}
+
+// ListMySkills implements SkillHandler.
+func (s *skillHandler) ListMySkills(c echo.Context) error {
+	skills, err := s.skillUsecase.ListMySkills(c.Request().Context())
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+	return c.JSON(http.StatusOK, newSkillSummaryResponses(skills))
+}
+
+// SetLevel implements SkillHandler.
+func (s *skillHandler) SetLevel(c echo.Context) error {
+	var request SkillLevelRequest
+	if err := c.Bind(&request); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	assessment, err := s.skillUsecase.SetLevel(c.Request().Context(), request.ConvertToInput())
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	var response SkillAssessmentResponse
+	response.ConvertToDto(assessment)
+	return c.JSON(http.StatusOK, response)
+}
+
+// Endorse implements SkillHandler.
+func (s *skillHandler) Endorse(c echo.Context) error {
+	var request SkillEndorsementRequest
+	if err := c.Bind(&request); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	endorsement, err := s.skillUsecase.Endorse(c.Request().Context(), c.Param("user_id"), request.ConvertToInput())
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	var response SkillEndorsementResponse
+	response.ConvertToDto(endorsement)
+	return c.JSON(http.StatusCreated, response)
+}
+
+// ListEndorsements implements SkillHandler.
+func (s *skillHandler) ListEndorsements(c echo.Context) error {
+	endorsements, err := s.skillUsecase.ListEndorsements(c.Request().Context(), c.Param("user_id"))
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	response := make([]SkillEndorsementResponse, len(endorsements))
+	for i, e := range endorsements {
+		response[i].ConvertToDto(e)
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+type SkillHandler interface {
+	ListMySkills(c echo.Context) error
+	SetLevel(c echo.Context) error
+	Endorse(c echo.Context) error
+	ListEndorsements(c echo.Context) error
+}
+
+func NewSkillHandler(skillUsecase usecase.SkillUsecase) SkillHandler {
+	return &skillHandler{skillUsecase: skillUsecase}
+}
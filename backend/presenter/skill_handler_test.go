@@ -0,0 +1,269 @@
+package presenter_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"stackies/backend/presenter"
+	"stackies/backend/usecase"
+	mock_usecase "stackies/backend/usecase/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSkillHandler_ListMySkills(t *testing.T) {
+	lastUsed := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		setupMock      func(mock *mock_usecase.MockSkillUsecase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "正常系: 経験期間と習熟度を返し、評価がない項目は省略する",
+			setupMock: func(mock *mock_usecase.MockSkillUsecase) {
+				mock.EXPECT().ListMySkills(gomock.Any()).Return([]usecase.SkillSummaryDto{
+					{Category: "language", Name: "Go", Months: 12, LastUsedMonth: &lastUsed, ExperienceCount: 1, SelfLevel: 4, PeerLevel: 4.5, EndorsementCount: 2},
+					{Category: "tool", Name: "AWS", Months: 6, ExperienceCount: 1},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `[{"category":"language","name":"Go","months":12,"last_used_month":"2025-03","experience_count":1,"self_level":4,"peer_level":4.5,"endorsement_count":2},` +
+				`{"category":"tool","name":"AWS","months":6,"experience_count":1}]`,
+		},
+		{
+			name: "異常系: ユーザーが設定されていない",
+			setupMock: func(mock *mock_usecase.MockSkillUsecase) {
+				mock.EXPECT().ListMySkills(gomock.Any()).Return(nil, usecase.ErrUnauthenticated)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/me/skills", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockSkillUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewSkillHandler(mockUsecase)
+			err := handler.ListMySkills(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestSkillHandler_SetLevel(t *testing.T) {
+	updatedAt := time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		setupMock      func(mock *mock_usecase.MockSkillUsecase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "正常系: 習熟度を自己評価する",
+			requestBody: `{"category":"language","name":"Go","level":4,"comment":"チームのレビューを担当"}`,
+			setupMock: func(mock *mock_usecase.MockSkillUsecase) {
+				mock.EXPECT().SetLevel(gomock.Any(), usecase.SkillLevelInput{Category: "language", Name: "Go", Level: 4, Comment: "チームのレビューを担当"}).
+					Return(usecase.SkillAssessmentDto{Category: "language", Name: "Go", Level: 4, Comment: "チームのレビューを担当", UpdatedAt: updatedAt}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"category":"language","name":"Go","level":4,"comment":"チームのレビューを担当","updated_at":"2026-10-19T10:00:00Z"}`,
+		},
+		{
+			name:        "異常系: 習熟度が範囲外",
+			requestBody: `{"category":"language","name":"Go","level":6}`,
+			setupMock: func(mock *mock_usecase.MockSkillUsecase) {
+				mock.EXPECT().SetLevel(gomock.Any(), gomock.Any()).Return(usecase.SkillAssessmentDto{}, usecase.ErrInvalidInput)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "異常系: リクエストボディが不正",
+			requestBody:    `{"level":"high"}`,
+			setupMock:      func(mock *mock_usecase.MockSkillUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/me/skill-levels", strings.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockSkillUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewSkillHandler(mockUsecase)
+			err := handler.SetLevel(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestSkillHandler_Endorse(t *testing.T) {
+	endorsedAt := time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		setupMock      func(mock *mock_usecase.MockSkillUsecase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "正常系: 同じ組織のユーザーのスキルを推薦する",
+			requestBody: `{"category":"language","name":"Go","level":4,"comment":"設計のレビューが的確"}`,
+			setupMock: func(mock *mock_usecase.MockSkillUsecase) {
+				mock.EXPECT().Endorse(gomock.Any(), "user-1", usecase.SkillEndorsementInput{Category: "language", Name: "Go", Level: 4, Comment: "設計のレビューが的確"}).
+					Return(usecase.SkillEndorsementDto{
+						ID: 10, UserID: "user-1", Endorser: usecase.MemberDto{UserID: "user-2"},
+						Category: "language", Name: "Go", Level: 4, Comment: "設計のレビューが的確",
+						CreatedAt: endorsedAt, UpdatedAt: endorsedAt,
+					}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: `{"id":10,"user_id":"user-1","endorser":{"user_id":"user-2","display_name":""},` +
+				`"category":"language","name":"Go","level":4,"comment":"設計のレビューが的確",` +
+				`"created_at":"2026-10-19T10:00:00Z","updated_at":"2026-10-19T10:00:00Z"}`,
+		},
+		{
+			name:        "異常系: 別の組織のユーザーは推薦できない",
+			requestBody: `{"category":"language","name":"Go","level":4}`,
+			setupMock: func(mock *mock_usecase.MockSkillUsecase) {
+				mock.EXPECT().Endorse(gomock.Any(), "user-1", gomock.Any()).Return(usecase.SkillEndorsementDto{}, usecase.ErrForbidden)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:        "異常系: 自分自身は推薦できない",
+			requestBody: `{"category":"language","name":"Go","level":4}`,
+			setupMock: func(mock *mock_usecase.MockSkillUsecase) {
+				mock.EXPECT().Endorse(gomock.Any(), "user-1", gomock.Any()).Return(usecase.SkillEndorsementDto{}, usecase.ErrInvalidInput)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/users/user-1/endorsements", strings.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("user_id")
+			c.SetParamValues("user-1")
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockSkillUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewSkillHandler(mockUsecase)
+			err := handler.Endorse(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestSkillHandler_ListEndorsements(t *testing.T) {
+	endorsedAt := time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		setupMock      func(mock *mock_usecase.MockSkillUsecase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "正常系: 受けた推薦を推薦したユーザーの表示名とともに返す",
+			setupMock: func(mock *mock_usecase.MockSkillUsecase) {
+				mock.EXPECT().ListEndorsements(gomock.Any(), "user-1").Return([]usecase.SkillEndorsementDto{{
+					ID: 10, UserID: "user-1", Endorser: usecase.MemberDto{UserID: "user-2", DisplayName: "佐藤 花子"},
+					Category: "language", Name: "Go", Level: 4,
+					CreatedAt: endorsedAt, UpdatedAt: endorsedAt,
+				}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `[{"id":10,"user_id":"user-1","endorser":{"user_id":"user-2","display_name":"佐藤 花子"},` +
+				`"category":"language","name":"Go","level":4,"comment":"",` +
+				`"created_at":"2026-10-19T10:00:00Z","updated_at":"2026-10-19T10:00:00Z"}]`,
+		},
+		{
+			name: "正常系: 推薦がない場合は空の配列を返す",
+			setupMock: func(mock *mock_usecase.MockSkillUsecase) {
+				mock.EXPECT().ListEndorsements(gomock.Any(), "user-1").Return(nil, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `[]`,
+		},
+		{
+			name: "異常系: 別の組織のユーザーは参照できない",
+			setupMock: func(mock *mock_usecase.MockSkillUsecase) {
+				mock.EXPECT().ListEndorsements(gomock.Any(), "user-1").Return(nil, usecase.ErrForbidden)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/users/user-1/endorsements", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("user_id")
+			c.SetParamValues("user-1")
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockSkillUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewSkillHandler(mockUsecase)
+			err := handler.ListEndorsements(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
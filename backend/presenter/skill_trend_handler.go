@@ -0,0 +1,114 @@
+package presenter
+
+import (
+	"net/http"
+	"stackies/backend/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type skillTrendHandler struct {
+	skillTrendUsecase usecase.SkillTrendUsecase
+}
+
+// SkillTrendReportResponse は組織のスキルの推移です
+type SkillTrendReportResponse struct {
+	Quarters []SkillQuarterResponse `json:"quarters"`
+	// Growing・Declining は直近2四半期と、その前の2四半期の人月の比較です
+	Growing   []SkillTrendResponse `json:"growing"`
+	Declining []SkillTrendResponse `json:"declining"`
+	// SoleHolders は経験者が1人だけのスキルです
+	SoleHolders []SkillHolderResponse `json:"sole_holders"`
+}
+
+type SkillQuarterResponse struct {
+	Quarter    string               `json:"quarter"`
+	StartMonth string               `json:"start_month"`
+	Skills     []SkillUsageResponse `json:"skills"`
+}
+
+type SkillUsageResponse struct {
+	Category       string `json:"category"`
+	Name           string `json:"name"`
+	EngineerMonths int    `json:"engineer_months"`
+}
+
+type SkillTrendResponse struct {
+	Category       string `json:"category"`
+	Name           string `json:"name"`
+	RecentMonths   int    `json:"recent_months"`
+	PreviousMonths int    `json:"previous_months"`
+}
+
+type SkillHolderResponse struct {
+	Category string         `json:"category"`
+	Name     string         `json:"name"`
+	Holder   MemberResponse `json:"holder"`
+}
+
+func (r *SkillTrendReportResponse) ConvertToDto(report usecase.SkillTrendReportDto) {
+	r.Quarters = make([]SkillQuarterResponse, len(report.Quarters))
+	for i, quarter := range report.Quarters {
+		skills := make([]SkillUsageResponse, len(quarter.Skills))
+		for j, skill := range quarter.Skills {
+			skills[j] = SkillUsageResponse{Category: skill.Category, Name: skill.Name, EngineerMonths: skill.EngineerMonths}
+		}
+		r.Quarters[i] = SkillQuarterResponse{
+			Quarter:    quarter.Quarter,
+			StartMonth: formatMonth(&quarter.StartMonth),
+			Skills:     skills,
+		}
+	}
+	r.Growing = newSkillTrendResponses(report.Growing)
+	r.Declining = newSkillTrendResponses(report.Declining)
+	r.SoleHolders = make([]SkillHolderResponse, len(report.SoleHolders))
+	for i, holder := range report.SoleHolders {
+		r.SoleHolders[i] = SkillHolderResponse{
+			Category: holder.Category,
+			Name:     holder.Name,
+			Holder:   MemberResponse{UserID: holder.Holder.UserID, DisplayName: holder.Holder.DisplayName},
+		}
+	}
+}
+
+func newSkillTrendResponses(trends []usecase.SkillTrendDto) []SkillTrendResponse {
+	responses := make([]SkillTrendResponse, len(trends))
+	for i, trend := range trends {
+		responses[i] = SkillTrendResponse{
+			Category:       trend.Category,
+			Name:           trend.Name,
+			RecentMonths:   trend.RecentMonths,
+			PreviousMonths: trend.PreviousMonths,
+		}
+	}
+	return responses
+}
+
+// GetOrganizationTrends implements SkillTrendHandler.
+// GET /orgs/:id/skill-trends?quarters=8
+func (s *skillTrendHandler) GetOrganizationTrends(c echo.Context) error {
+	var organizationID int
+	if err := echo.PathParamsBinder(c).MustInt("id", &organizationID).BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	var input usecase.SkillTrendInput
+	if err := echo.QueryParamsBinder(c).Int("quarters", &input.Quarters).BindError(); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	report, err := s.skillTrendUsecase.GetOrganizationTrends(c.Request().Context(), organizationID, input)
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	var response SkillTrendReportResponse
+	response.ConvertToDto(report)
+	return c.JSON(http.StatusOK, response)
+}
+
+type SkillTrendHandler interface {
+	GetOrganizationTrends(c echo.Context) error
+}
+
+func NewSkillTrendHandler(skillTrendUsecase usecase.SkillTrendUsecase) SkillTrendHandler {
+	return &skillTrendHandler{skillTrendUsecase: skillTrendUsecase}
+}
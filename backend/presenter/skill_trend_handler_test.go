@@ -0,0 +1,97 @@
+package presenter_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"stackies/backend/presenter"
+	"stackies/backend/usecase"
+	mock_usecase "stackies/backend/usecase/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSkillTrendHandler_GetOrganizationTrends(t *testing.T) {
+	tests := []struct {
+		name           string
+		id             string
+		query          string
+		setupMock      func(mock *mock_usecase.MockSkillTrendUsecase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:  "正常系: 四半期ごとの人月と増減、経験者が1人だけのスキルを返す",
+			id:    "1",
+			query: "?quarters=4",
+			setupMock: func(mock *mock_usecase.MockSkillTrendUsecase) {
+				mock.EXPECT().GetOrganizationTrends(gomock.Any(), 1, usecase.SkillTrendInput{Quarters: 4}).Return(usecase.SkillTrendReportDto{
+					Quarters: []usecase.SkillQuarterDto{{
+						Quarter:    "2026-Q3",
+						StartMonth: time.Date(2026, time.July, 1, 0, 0, 0, 0, time.UTC),
+						Skills:     []usecase.SkillUsageDto{{Category: "language", Name: "Go", EngineerMonths: 9}},
+					}},
+					Growing:   []usecase.SkillTrendDto{{Category: "language", Name: "Go", RecentMonths: 15, PreviousMonths: 3}},
+					Declining: []usecase.SkillTrendDto{},
+					SoleHolders: []usecase.SkillHolderDto{
+						{Category: "language", Name: "COBOL", Holder: usecase.MemberDto{UserID: "user-1", DisplayName: "山田 太郎"}},
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"quarters":[{"quarter":"2026-Q3","start_month":"2026-07","skills":[{"category":"language","name":"Go","engineer_months":9}]}],` +
+				`"growing":[{"category":"language","name":"Go","recent_months":15,"previous_months":3}],"declining":[],` +
+				`"sole_holders":[{"category":"language","name":"COBOL","holder":{"user_id":"user-1","display_name":"山田 太郎"}}]}`,
+		},
+		{
+			name: "異常系: 組織のマネージャーではない",
+			id:   "1",
+			setupMock: func(mock *mock_usecase.MockSkillTrendUsecase) {
+				mock.EXPECT().GetOrganizationTrends(gomock.Any(), 1, usecase.SkillTrendInput{}).Return(usecase.SkillTrendReportDto{}, usecase.ErrForbidden)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "異常系: 四半期の数が数値ではない",
+			id:             "1",
+			query:          "?quarters=many",
+			setupMock:      func(mock *mock_usecase.MockSkillTrendUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "異常系: IDが不正",
+			id:             "abc",
+			setupMock:      func(mock *mock_usecase.MockSkillTrendUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/orgs/"+tt.id+"/skill-trends"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.id)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockSkillTrendUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewSkillTrendHandler(mockUsecase)
+			err := handler.GetOrganizationTrends(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
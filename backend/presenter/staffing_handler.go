@@ -0,0 +1,109 @@
+package presenter
+
+import (
+	"net/http"
+	"stackies/backend/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type staffingHandler struct {
+	staffingUsecase usecase.StaffingUsecase
+}
+
+// StaffingSearchRequest は案件の募集要件です
+type StaffingSearchRequest struct {
+	// Required は必須スキル、Preferred は歓迎スキルです（min_people は使いません）
+	Required  []SkillRequirementRequest `json:"required"`
+	Preferred []SkillRequirementRequest `json:"preferred"`
+	// Industry は経験のある業種です（"金融" は "金融（銀行）" にも一致します）
+	Industry string `json:"industry"`
+	// Phases は経験のある工程です（requirements / basic_design / detailed_design / implementation / testing / operation）
+	Phases []string `json:"phases"`
+	// AvailableBy は参画開始月です（"2026-12" の形式）
+	AvailableBy string `json:"available_by"`
+	// Limit は返す人数です（省略時は 20 人、最大 100 人）
+	Limit int `json:"limit"`
+}
+
+func (r *StaffingSearchRequest) ConvertToInput() (usecase.StaffingSearchInput, error) {
+	availableBy, err := parseMonth(r.AvailableBy)
+	if err != nil {
+		return usecase.StaffingSearchInput{}, err
+	}
+	return usecase.StaffingSearchInput{
+		Required:    toSkillRequirementInputs(r.Required),
+		Preferred:   toSkillRequirementInputs(r.Preferred),
+		Industry:    r.Industry,
+		Phases:      r.Phases,
+		AvailableBy: availableBy,
+		Limit:       r.Limit,
+	}, nil
+}
+
+// StaffingCandidateResponse は募集要件に合うエンジニアと、点数の内訳です
+type StaffingCandidateResponse struct {
+	UserID      string `json:"user_id"`
+	DisplayName string `json:"display_name"`
+	Score       int    `json:"score"`
+	// AvailableFrom は稼働可能になる月です（参画中で終了月が未定の場合は省略）
+	AvailableFrom string                      `json:"available_from,omitempty"`
+	Breakdown     []StaffingScoreItemResponse `json:"breakdown"`
+}
+
+type StaffingScoreItemResponse struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name,omitempty"`
+	Points    int    `json:"points"`
+	Months    int    `json:"months"`
+	MinMonths int    `json:"min_months,omitempty"`
+	Matched   bool   `json:"matched"`
+}
+
+func (r *StaffingCandidateResponse) ConvertToDto(candidate usecase.StaffingCandidateDto) {
+	r.UserID = candidate.Member.UserID
+	r.DisplayName = candidate.Member.DisplayName
+	r.Score = candidate.Score
+	r.AvailableFrom = formatMonth(candidate.AvailableFrom)
+	r.Breakdown = make([]StaffingScoreItemResponse, len(candidate.Breakdown))
+	for i, item := range candidate.Breakdown {
+		r.Breakdown[i] = StaffingScoreItemResponse{
+			Kind:      item.Kind,
+			Name:      item.Name,
+			Points:    item.Points,
+			Months:    item.Months,
+			MinMonths: item.MinMonths,
+			Matched:   item.Matched,
+		}
+	}
+}
+
+// Search implements StaffingHandler.
+func (s *staffingHandler) Search(c echo.Context) error {
+	var request StaffingSearchRequest
+	if err := c.Bind(&request); err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	input, err := request.ConvertToInput()
+	if err != nil {
+		return errorJSON(c, http.StatusBadRequest, err)
+	}
+	candidates, err := s.staffingUsecase.Search(c.Request().Context(), input)
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	response := make([]StaffingCandidateResponse, len(candidates))
+	for i, candidate := range candidates {
+		response[i].ConvertToDto(candidate)
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+type StaffingHandler interface {
+	Search(c echo.Context) error
+}
+
+func NewStaffingHandler(staffingUsecase usecase.StaffingUsecase) StaffingHandler {
+	return &staffingHandler{staffingUsecase: staffingUsecase}
+}
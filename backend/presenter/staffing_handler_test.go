@@ -0,0 +1,99 @@
+package presenter_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"stackies/backend/presenter"
+	"stackies/backend/usecase"
+	mock_usecase "stackies/backend/usecase/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStaffingHandler_Search(t *testing.T) {
+	availableBy := time.Date(2026, time.December, 1, 0, 0, 0, 0, time.UTC)
+	availableFrom := time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		setupMock      func(mock *mock_usecase.MockStaffingUsecase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "正常系: 候補者と点数の内訳を返す",
+			requestBody: `{"required":[{"category":"language","name":"Go","min_months":24}],` +
+				`"industry":"金融","phases":["basic_design"],"available_by":"2026-12"}`,
+			setupMock: func(mock *mock_usecase.MockStaffingUsecase) {
+				mock.EXPECT().Search(gomock.Any(), usecase.StaffingSearchInput{
+					Required:    []usecase.SkillRequirementInput{{Category: "language", Name: "Go", MinMonths: 24}},
+					Preferred:   []usecase.SkillRequirementInput{},
+					Industry:    "金融",
+					Phases:      []string{"basic_design"},
+					AvailableBy: &availableBy,
+				}).Return([]usecase.StaffingCandidateDto{{
+					Member: usecase.MemberDto{UserID: "user-1", DisplayName: "山田 太郎"},
+					Score:  50,
+					Breakdown: []usecase.StaffingScoreItemDto{
+						{Kind: "required_skill", Name: "Go", Points: 20, Months: 24, MinMonths: 24, Matched: true},
+						{Kind: "industry", Name: "金融", Points: 15, Months: 12, Matched: true},
+						{Kind: "phase", Name: "basic_design", Points: 5, Months: 6, Matched: true},
+						{Kind: "availability", Points: 10, Matched: true},
+					},
+					AvailableFrom: &availableFrom,
+				}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `[{"user_id":"user-1","display_name":"山田 太郎","score":50,"available_from":"2026-11","breakdown":[` +
+				`{"kind":"required_skill","name":"Go","points":20,"months":24,"min_months":24,"matched":true},` +
+				`{"kind":"industry","name":"金融","points":15,"months":12,"matched":true},` +
+				`{"kind":"phase","name":"basic_design","points":5,"months":6,"matched":true},` +
+				`{"kind":"availability","points":10,"months":0,"matched":true}]}]`,
+		},
+		{
+			name:           "異常系: 参画開始月の形式が不正",
+			requestBody:    `{"available_by":"2026/12"}`,
+			setupMock:      func(mock *mock_usecase.MockStaffingUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "異常系: 要件を指定していない",
+			requestBody: `{}`,
+			setupMock: func(mock *mock_usecase.MockStaffingUsecase) {
+				mock.EXPECT().Search(gomock.Any(), gomock.Any()).Return(nil, usecase.ErrInvalidInput)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/staffing/search", strings.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockStaffingUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewStaffingHandler(mockUsecase)
+			err := handler.Search(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package presenter
+
+import (
+	"net/http"
+	"stackies/backend/usecase"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type timelineHandler struct {
+	timelineUsecase usecase.TimelineUsecase
+}
+
+// TimelineResponse はキャリアの年表です。ガントチャートの描画に使います
+type TimelineResponse struct {
+	Years    []TimelineYearResponse    `json:"years"`
+	Overlaps []TimelineOverlapResponse `json:"overlaps"`
+	// Undated は期間が未設定のため年表に並べられない項目です
+	Undated     []TimelineEntryResponse `json:"undated"`
+	GeneratedAt time.Time               `json:"generated_at"`
+}
+
+type TimelineYearResponse struct {
+	Year    int                     `json:"year"`
+	Entries []TimelineEntryResponse `json:"entries"`
+}
+
+type TimelineEntryResponse struct {
+	ID     string `json:"id"`
+	Kind   string `json:"kind"`
+	Title  string `json:"title"`
+	Detail string `json:"detail,omitempty"`
+	// StartMonth・EndMonth は期間が未設定の場合に省略し、EndMonth は参画中・所属中（ongoing）の場合にも省略します
+	StartMonth string   `json:"start_month,omitempty"`
+	EndMonth   string   `json:"end_month,omitempty"`
+	Ongoing    bool     `json:"ongoing"`
+	Months     int      `json:"months"`
+	Milestone  bool     `json:"milestone"`
+	Overlaps   []string `json:"overlaps,omitempty"`
+}
+
+type TimelineOverlapResponse struct {
+	StartMonth string   `json:"start_month"`
+	EndMonth   string   `json:"end_month"`
+	Months     int      `json:"months"`
+	Entries    []string `json:"entries"`
+}
+
+func (r *TimelineResponse) ConvertToDto(timeline usecase.TimelineDto) {
+	r.Years = make([]TimelineYearResponse, len(timeline.Years))
+	for i, year := range timeline.Years {
+		r.Years[i] = TimelineYearResponse{Year: year.Year, Entries: newTimelineEntryResponses(year.Entries)}
+	}
+	r.Overlaps = make([]TimelineOverlapResponse, len(timeline.Overlaps))
+	for i, overlap := range timeline.Overlaps {
+		r.Overlaps[i] = TimelineOverlapResponse{
+			StartMonth: formatMonth(&overlap.StartMonth),
+			EndMonth:   formatMonth(&overlap.EndMonth),
+			Months:     overlap.Months,
+			Entries:    overlap.EntryIDs,
+		}
+	}
+	r.Undated = newTimelineEntryResponses(timeline.Undated)
+	r.GeneratedAt = timeline.GeneratedAt
+}
+
+func newTimelineEntryResponses(entries []usecase.TimelineEntryDto) []TimelineEntryResponse {
+	responses := make([]TimelineEntryResponse, len(entries))
+	for i, e := range entries {
+		responses[i] = TimelineEntryResponse{
+			ID:         e.ID,
+			Kind:       e.Kind,
+			Title:      e.Title,
+			Detail:     e.Detail,
+			StartMonth: formatMonth(e.StartMonth),
+			EndMonth:   formatMonth(e.EndMonth),
+			Ongoing:    e.StartMonth != nil && e.EndMonth == nil,
+			Months:     e.Months,
+			Milestone:  e.Milestone,
+			Overlaps:   e.Overlaps,
+		}
+	}
+	return responses
+}
+
+// Get implements TimelineHandler.
+func (t *timelineHandler) Get(c echo.Context) error {
+	timeline, err := t.timelineUsecase.GetMyTimeline(c.Request().Context())
+	if err != nil {
+		return usecaseErrorJSON(c, err)
+	}
+
+	var response TimelineResponse
+	response.ConvertToDto(timeline)
+	return c.JSON(http.StatusOK, response)
+}
+
+type TimelineHandler interface {
+	Get(c echo.Context) error
+}
+
+func NewTimelineHandler(timelineUsecase usecase.TimelineUsecase) TimelineHandler {
+	return &timelineHandler{timelineUsecase: timelineUsecase}
+}
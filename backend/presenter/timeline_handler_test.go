@@ -0,0 +1,100 @@
+package presenter_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"stackies/backend/presenter"
+	"stackies/backend/usecase"
+	mock_usecase "stackies/backend/usecase/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimelineHandler_Get(t *testing.T) {
+	month := func(year int, m time.Month) *time.Time {
+		v := time.Date(year, m, 1, 0, 0, 0, 0, time.UTC)
+		return &v
+	}
+	generatedAt := time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		setupMock      func(mock *mock_usecase.MockTimelineUsecase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "正常系: 年ごとの項目と重複期間を返す",
+			setupMock: func(mock *mock_usecase.MockTimelineUsecase) {
+				mock.EXPECT().GetMyTimeline(gomock.Any()).Return(usecase.TimelineDto{
+					Years: []usecase.TimelineYearDto{{
+						Year: 2024,
+						Entries: []usecase.TimelineEntryDto{
+							{ID: "gap:1", Kind: "gap", Title: "空白期間", StartMonth: month(2024, time.April), EndMonth: month(2024, time.June), Months: 3},
+							{ID: "experience:2", Kind: "experience", Title: "決済基盤の刷新", Detail: "A銀行", StartMonth: month(2024, time.July), Months: 28, Overlaps: []string{"experience:3"}},
+							{ID: "experience:3", Kind: "experience", Title: "社内ポータル", StartMonth: month(2024, time.October), EndMonth: month(2025, time.March), Months: 6, Overlaps: []string{"experience:2"}},
+							{ID: "certification:1", Kind: "certification", Title: "応用情報技術者", StartMonth: month(2024, time.November), EndMonth: month(2024, time.November), Months: 1, Milestone: true},
+						},
+					}},
+					Overlaps: []usecase.TimelineOverlapDto{
+						{StartMonth: *month(2024, time.October), EndMonth: *month(2025, time.March), Months: 6, EntryIDs: []string{"experience:2", "experience:3"}},
+					},
+					Undated:     []usecase.TimelineEntryDto{{ID: "certification:2", Kind: "certification", Title: "AWS SAA", Milestone: true}},
+					GeneratedAt: generatedAt,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"years":[{"year":2024,"entries":[` +
+				`{"id":"gap:1","kind":"gap","title":"空白期間","start_month":"2024-04","end_month":"2024-06","ongoing":false,"months":3,"milestone":false},` +
+				`{"id":"experience:2","kind":"experience","title":"決済基盤の刷新","detail":"A銀行","start_month":"2024-07","ongoing":true,"months":28,"milestone":false,"overlaps":["experience:3"]},` +
+				`{"id":"experience:3","kind":"experience","title":"社内ポータル","start_month":"2024-10","end_month":"2025-03","ongoing":false,"months":6,"milestone":false,"overlaps":["experience:2"]},` +
+				`{"id":"certification:1","kind":"certification","title":"応用情報技術者","start_month":"2024-11","end_month":"2024-11","ongoing":false,"months":1,"milestone":true}]}],` +
+				`"overlaps":[{"start_month":"2024-10","end_month":"2025-03","months":6,"entries":["experience:2","experience:3"]}],` +
+				`"undated":[{"id":"certification:2","kind":"certification","title":"AWS SAA","ongoing":false,"months":0,"milestone":true}],` +
+				`"generated_at":"2026-10-19T10:00:00Z"}`,
+		},
+		{
+			name: "正常系: 項目がない場合は空の配列を返す",
+			setupMock: func(mock *mock_usecase.MockTimelineUsecase) {
+				mock.EXPECT().GetMyTimeline(gomock.Any()).Return(usecase.TimelineDto{GeneratedAt: generatedAt}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"years":[],"overlaps":[],"undated":[],"generated_at":"2026-10-19T10:00:00Z"}`,
+		},
+		{
+			name: "異常系: ユーザーが設定されていない",
+			setupMock: func(mock *mock_usecase.MockTimelineUsecase) {
+				mock.EXPECT().GetMyTimeline(gomock.Any()).Return(usecase.TimelineDto{}, usecase.ErrUnauthenticated)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/me/timeline", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUsecase := mock_usecase.NewMockTimelineUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			handler := presenter.NewTimelineHandler(mockUsecase)
+			err := handler.Get(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
@@ -4,5 +4,16 @@ export REDIRECT_URL=""
 export ISSUER_URL=""
 export TOKEN_URL=""
 export JWKS_URL=""
+export APP_ENV="development"
+# ログレベル（debug / info / warn / error）と出力形式（json / text）
+export LOG_LEVEL="debug"
+export LOG_FORMAT="text"
+# リクエスト・レスポンスボディのログ出力
+export BODY_DUMP_ENABLED="true"
+export BODY_DUMP_EXCLUDE_URLS="/callback"
+export BODY_DUMP_MASK_PATHS='$.users[*].email,$.card_number:4'
+# トレースのエクスポーター（otlp / stdout / none）
+export OTEL_TRACES_EXPORTER="none"
+export OTEL_EXPORTER_OTLP_ENDPOINT="http://localhost:4318"
 
 echo "環境変数をセットしました"
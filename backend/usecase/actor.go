@@ -0,0 +1,33 @@
+package usecase
+
+import "context"
+
+type actorKey struct{}
+
+// Actor は操作を行った主体です（監査ログの記録に使います）
+type Actor struct {
+	// UserID は JWT の sub です
+	UserID string
+	// RequestID は操作を行ったリクエストのIDです
+	RequestID string
+}
+
+// ContextWithUserID は操作を行うユーザーのIDをコンテキストに設定します
+func ContextWithUserID(ctx context.Context, userID string) context.Context {
+	actor := ActorFromContext(ctx)
+	actor.UserID = userID
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ContextWithRequestID はリクエストIDをコンテキストに設定します
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	actor := ActorFromContext(ctx)
+	actor.RequestID = requestID
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext はコンテキストから操作を行った主体を取得します
+func ActorFromContext(ctx context.Context) Actor {
+	actor, _ := ctx.Value(actorKey{}).(Actor)
+	return actor
+}
@@ -0,0 +1,168 @@
+//go:generate mockgen -source=audit_usecase.go -destination=mock/mock_$GOFILE -package=mock
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"time"
+)
+
+// 監査ログの操作種別
+const (
+	AuditActionCreate = "create"
+	AuditActionUpdate = "update"
+	AuditActionDelete = "delete"
+)
+
+// 監査ログの対象
+const (
+	AuditEntityExperience   = "experience"
+	AuditEntityLanguage     = "language"
+	AuditEntityTool         = "tool"
+	AuditEntityProfile      = "profile"
+	AuditEntityShareLink    = "share_link"
+	AuditEntityOrganization = "organization"
+	AuditEntityTeam         = "team"
+	AuditEntityTeamMember   = "team_member"
+	// 自己評価の ID はユーザーとスキルの組（"user-1/language/Go"）
+	AuditEntitySkillAssessment  = "skill_assessment"
+	AuditEntitySkillEndorsement = "skill_endorsement"
+	AuditEntityCareerGoal       = "career_goal"
+	AuditEntityLearningActivity = "learning_activity"
+)
+
+// 監査ログ検索の取得件数
+const (
+	defaultAuditLogLimit = 100
+	maxAuditLogLimit     = 1000
+)
+
+type AuditLogDto struct {
+	ID         int
+	ActorID    string
+	RequestID  string
+	Action     string
+	EntityType string
+	EntityID   string
+	Before     json.RawMessage
+	After      json.RawMessage
+	CreatedAt  time.Time
+}
+
+// AuditLogQuery は監査ログの検索条件です
+type AuditLogQuery struct {
+	ActorID    string
+	EntityType string
+	EntityID   string
+	Action     string
+	From       time.Time
+	To         time.Time
+	Limit      int
+	Offset     int
+}
+
+type auditUsecase struct {
+	auditLogRepository repository.AuditLogRepository
+	logger             *slog.Logger
+}
+
+// Search implements AuditUsecase.
+func (a *auditUsecase) Search(ctx context.Context, query AuditLogQuery) (_ []AuditLogDto, err error) {
+	ctx, span := tracer.Start(ctx, "AuditUsecase.Search")
+	defer func() { endSpan(span, err) }()
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultAuditLogLimit
+	}
+	if limit > maxAuditLogLimit {
+		limit = maxAuditLogLimit
+	}
+
+	auditLogs, err := a.auditLogRepository.Find(ctx, repository.AuditLogFilter{
+		ActorID:    query.ActorID,
+		EntityType: query.EntityType,
+		EntityID:   query.EntityID,
+		Action:     query.Action,
+		From:       query.From,
+		To:         query.To,
+		Limit:      limit,
+		Offset:     query.Offset,
+	})
+	if err != nil {
+		a.logger.ErrorContext(ctx, "failed to search audit logs", slog.Any("error", err))
+		return nil, err
+	}
+
+	auditLogDtos := make([]AuditLogDto, len(auditLogs))
+	for i, auditLog := range auditLogs {
+		auditLogDtos[i] = AuditLogDto{
+			ID:         auditLog.ID,
+			ActorID:    auditLog.ActorID,
+			RequestID:  auditLog.RequestID,
+			Action:     auditLog.Action,
+			EntityType: auditLog.EntityType,
+			EntityID:   auditLog.EntityID,
+			Before:     auditLog.Before,
+			After:      auditLog.After,
+			CreatedAt:  auditLog.CreatedAt,
+		}
+	}
+	return auditLogDtos, nil
+}
+
+type AuditUsecase interface {
+	Search(ctx context.Context, query AuditLogQuery) ([]AuditLogDto, error)
+}
+
+func NewAuditUsecase(auditLogRepository repository.AuditLogRepository, logger *slog.Logger) AuditUsecase {
+	return &auditUsecase{
+		auditLogRepository: auditLogRepository,
+		logger:             logger.With(slog.String("usecase", "audit")),
+	}
+}
+
+// auditRecorder は各ユースケースの作成・更新・削除を監査ログに記録します
+type auditRecorder struct {
+	auditLogRepository repository.AuditLogRepository
+}
+
+// record は操作前後の値を JSON にして監査ログを記録します（作成時の before、削除時の after は nil）
+// 操作を行ったユーザーとリクエストIDはコンテキストから取得します
+func (r auditRecorder) record(ctx context.Context, action, entityType string, entityID interface{}, before, after interface{}) error {
+	beforeJSON, err := marshalAuditValue(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := marshalAuditValue(after)
+	if err != nil {
+		return err
+	}
+
+	actor := ActorFromContext(ctx)
+	return r.auditLogRepository.Create(ctx, model.AuditLog{
+		ActorID:    actor.UserID,
+		RequestID:  actor.RequestID,
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   fmt.Sprint(entityID),
+		Before:     beforeJSON,
+		After:      afterJSON,
+		CreatedAt:  time.Now(),
+	})
+}
+
+func marshalAuditValue(v interface{}) (json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal audit value: %w", err)
+	}
+	return b, nil
+}
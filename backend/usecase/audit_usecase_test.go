@@ -0,0 +1,103 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"stackies/backend/domain/repository/mock"
+	"stackies/backend/usecase"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditUsecase_Search(t *testing.T) {
+	createdAt := time.Date(2025, 5, 14, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		query     usecase.AuditLogQuery
+		setupMock func(*mock.MockAuditLogRepository)
+		want      []usecase.AuditLogDto
+		wantErr   error
+	}{
+		{
+			name:  "正常系: 条件を指定して監査ログを取得",
+			query: usecase.AuditLogQuery{EntityType: "experience", EntityID: "1"},
+			setupMock: func(m *mock.MockAuditLogRepository) {
+				m.EXPECT().Find(gomock.Any(), repository.AuditLogFilter{
+					EntityType: "experience",
+					EntityID:   "1",
+					Limit:      100,
+				}).Return([]model.AuditLog{
+					{
+						ID:         1,
+						ActorID:    "user-1",
+						RequestID:  "req-1",
+						Action:     "update",
+						EntityType: "experience",
+						EntityID:   "1",
+						Before:     json.RawMessage(`{"title":"Go"}`),
+						After:      json.RawMessage(`{"title":"Go 1.23"}`),
+						CreatedAt:  createdAt,
+					},
+				}, nil)
+			},
+			want: []usecase.AuditLogDto{
+				{
+					ID:         1,
+					ActorID:    "user-1",
+					RequestID:  "req-1",
+					Action:     "update",
+					EntityType: "experience",
+					EntityID:   "1",
+					Before:     json.RawMessage(`{"title":"Go"}`),
+					After:      json.RawMessage(`{"title":"Go 1.23"}`),
+					CreatedAt:  createdAt,
+				},
+			},
+		},
+		{
+			name:  "正常系: 取得件数は上限で丸める",
+			query: usecase.AuditLogQuery{Limit: 5000, Offset: 10},
+			setupMock: func(m *mock.MockAuditLogRepository) {
+				m.EXPECT().Find(gomock.Any(), repository.AuditLogFilter{Limit: 1000, Offset: 10}).Return([]model.AuditLog{}, nil)
+			},
+			want: []usecase.AuditLogDto{},
+		},
+		{
+			name:  "異常系: repository.Findがエラーを返す",
+			query: usecase.AuditLogQuery{},
+			setupMock: func(m *mock.MockAuditLogRepository) {
+				m.EXPECT().Find(gomock.Any(), gomock.Any()).Return(nil, errors.New("DB error"))
+			},
+			wantErr: errors.New("DB error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock.NewMockAuditLogRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			uc := usecase.NewAuditUsecase(mockRepo, discardLogger)
+			got, err := uc.Search(context.Background(), tt.query)
+
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,557 @@
+//go:generate mockgen -source=career_goal_usecase.go -destination=mock/mock_$GOFILE -package=mock
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"time"
+)
+
+// CareerGoalInput は目標の登録・更新の入力値です
+type CareerGoalInput struct {
+	// Kind は role（目標とする役割）または skill（習得したいスキル）です
+	Kind  string
+	Title string
+	// SkillCategory・SkillName・TargetLevel は種類が skill の場合に指定します（TargetLevel の 0 は未設定）
+	SkillCategory string
+	SkillName     string
+	TargetLevel   int
+	TargetMonth   *time.Time
+	// Status は更新の場合だけ指定できます（空は変更しない）
+	Status string
+	Note   string
+}
+
+// LearningActivityInput は学習活動の登録・更新の入力値です
+type LearningActivityInput struct {
+	// Kind は course / certification / side_project のいずれかです
+	Kind  string
+	Title string
+	URL   string
+	// Status は planned / in_progress / completed のいずれかです
+	Status   string
+	Progress int
+	DueMonth *time.Time
+}
+
+// CareerGoalDto はキャリアの目標です
+type CareerGoalDto struct {
+	ID            int
+	Kind          string
+	Title         string
+	SkillCategory string
+	SkillName     string
+	TargetLevel   int
+	// CurrentLevel は目標とするスキルの現在の自己評価です（0 は未評価、種類が role の場合は常に 0）
+	CurrentLevel int
+	TargetMonth  time.Time
+	Status       string
+	Note         string
+	// Progress は学習活動の進捗率の平均です（達成した目標は 100）
+	Progress int
+	// Overdue は進行中の目標が達成予定月を過ぎていることを表します
+	Overdue    bool
+	Activities []LearningActivityDto
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type LearningActivityDto struct {
+	ID        int
+	Kind      string
+	Title     string
+	URL       string
+	Status    string
+	Progress  int
+	DueMonth  *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// MemberCareerGoalsDto はチームのメンバーの目標です
+type MemberCareerGoalsDto struct {
+	Member MemberDto
+	Goals  []CareerGoalDto
+}
+
+type careerGoalUsecase struct {
+	careerGoalRepository      repository.CareerGoalRepository
+	skillAssessmentRepository repository.SkillAssessmentRepository
+	organizationRepository    repository.OrganizationRepository
+	profileRepository         repository.ProfileRepository
+	transactionManager        repository.TransactionManager
+	audit                     auditRecorder
+	logger                    *slog.Logger
+}
+
+// ListMyGoals implements CareerGoalUsecase.
+func (c *careerGoalUsecase) ListMyGoals(ctx context.Context) (_ []CareerGoalDto, err error) {
+	ctx, span := tracer.Start(ctx, "CareerGoalUsecase.ListMyGoals")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return nil, ErrUnauthenticated
+	}
+	goals, err := c.careerGoalRepository.FindByUserIDs(ctx, []string{userID})
+	if err != nil {
+		c.logger.ErrorContext(ctx, "failed to get career goals", slog.Any("error", err))
+		return nil, err
+	}
+	return c.toCareerGoalDtos(ctx, userID, goals, time.Now())
+}
+
+// CreateGoal implements CareerGoalUsecase.
+func (c *careerGoalUsecase) CreateGoal(ctx context.Context, input CareerGoalInput) (_ CareerGoalDto, err error) {
+	ctx, span := tracer.Start(ctx, "CareerGoalUsecase.CreateGoal")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return CareerGoalDto{}, ErrUnauthenticated
+	}
+	if input.Status != "" {
+		return CareerGoalDto{}, fmt.Errorf("%w: status cannot be set when creating a goal", ErrInvalidInput)
+	}
+	goal, err := input.toCareerGoal(userID)
+	if err != nil {
+		return CareerGoalDto{}, err
+	}
+
+	var created model.CareerGoal
+	err = c.transactionManager.Do(ctx, func(ctx context.Context) error {
+		created, err = c.careerGoalRepository.Create(ctx, *goal)
+		if err != nil {
+			c.logger.ErrorContext(ctx, "failed to create career goal", slog.Any("error", err))
+			return err
+		}
+		if err := c.audit.record(ctx, AuditActionCreate, AuditEntityCareerGoal, created.ID, nil, toCareerGoalDto(created, 0, time.Now())); err != nil {
+			c.logger.ErrorContext(ctx, "failed to record audit log", slog.Any("error", err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return CareerGoalDto{}, err
+	}
+	c.logger.InfoContext(ctx, "career goal created", slog.Int("career_goal_id", created.ID))
+	return c.toCareerGoalDto(ctx, userID, created, time.Now())
+}
+
+// UpdateGoal implements CareerGoalUsecase.
+// 他のユーザーの目標は存在しないものとして扱います。学習活動は変更しません
+func (c *careerGoalUsecase) UpdateGoal(ctx context.Context, id int, input CareerGoalInput) (_ CareerGoalDto, err error) {
+	ctx, span := tracer.Start(ctx, "CareerGoalUsecase.UpdateGoal")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return CareerGoalDto{}, ErrUnauthenticated
+	}
+	goal, err := input.toCareerGoal(userID)
+	if err != nil {
+		return CareerGoalDto{}, err
+	}
+
+	var updated model.CareerGoal
+	err = c.transactionManager.Do(ctx, func(ctx context.Context) error {
+		current, err := c.findMyGoal(ctx, userID, id)
+		if err != nil {
+			return err
+		}
+		goal.ID = id
+		goal.Status = current.Status
+		if input.Status != "" {
+			if goal.Status, err = model.ParseCareerGoalStatus(input.Status); err != nil {
+				return fmt.Errorf("%w: %w", ErrInvalidInput, err)
+			}
+		}
+		updated, err = c.careerGoalRepository.Update(ctx, *goal)
+		if err != nil {
+			c.logger.ErrorContext(ctx, "failed to update career goal", slog.Any("error", err))
+			return err
+		}
+		now := time.Now()
+		if err := c.audit.record(ctx, AuditActionUpdate, AuditEntityCareerGoal, id, toCareerGoalDto(current, 0, now), toCareerGoalDto(updated, 0, now)); err != nil {
+			c.logger.ErrorContext(ctx, "failed to record audit log", slog.Any("error", err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return CareerGoalDto{}, err
+	}
+	c.logger.InfoContext(ctx, "career goal updated", slog.Int("career_goal_id", id), slog.String("status", string(updated.Status)))
+	return c.toCareerGoalDto(ctx, userID, updated, time.Now())
+}
+
+// DeleteGoal implements CareerGoalUsecase.
+// 他のユーザーの目標は存在しないものとして扱います
+func (c *careerGoalUsecase) DeleteGoal(ctx context.Context, id int) (err error) {
+	ctx, span := tracer.Start(ctx, "CareerGoalUsecase.DeleteGoal")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return ErrUnauthenticated
+	}
+	err = c.transactionManager.Do(ctx, func(ctx context.Context) error {
+		current, err := c.findMyGoal(ctx, userID, id)
+		if err != nil {
+			return err
+		}
+		if err := c.careerGoalRepository.Delete(ctx, id); err != nil {
+			c.logger.ErrorContext(ctx, "failed to delete career goal", slog.Any("error", err))
+			return err
+		}
+		if err := c.audit.record(ctx, AuditActionDelete, AuditEntityCareerGoal, id, toCareerGoalDto(current, 0, time.Now()), nil); err != nil {
+			c.logger.ErrorContext(ctx, "failed to record audit log", slog.Any("error", err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	c.logger.InfoContext(ctx, "career goal deleted", slog.Int("career_goal_id", id))
+	return nil
+}
+
+// AddActivity implements CareerGoalUsecase.
+func (c *careerGoalUsecase) AddActivity(ctx context.Context, goalID int, input LearningActivityInput) (_ CareerGoalDto, err error) {
+	ctx, span := tracer.Start(ctx, "CareerGoalUsecase.AddActivity")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return CareerGoalDto{}, ErrUnauthenticated
+	}
+	activity, err := model.NewLearningActivity(goalID, input.Kind, input.Title, input.URL, input.Status, input.Progress, input.DueMonth)
+	if err != nil {
+		return CareerGoalDto{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	err = c.transactionManager.Do(ctx, func(ctx context.Context) error {
+		goal, err := c.findMyGoal(ctx, userID, goalID)
+		if err != nil {
+			return err
+		}
+		if len(goal.Activities) >= model.CareerGoalActivitiesMax {
+			return fmt.Errorf("%w: a goal can have at most %d activities", ErrInvalidInput, model.CareerGoalActivitiesMax)
+		}
+		created, err := c.careerGoalRepository.CreateActivity(ctx, *activity)
+		if err != nil {
+			c.logger.ErrorContext(ctx, "failed to create learning activity", slog.Any("error", err))
+			return err
+		}
+		if err := c.audit.record(ctx, AuditActionCreate, AuditEntityLearningActivity, created.ID, nil, toLearningActivityDto(created)); err != nil {
+			c.logger.ErrorContext(ctx, "failed to record audit log", slog.Any("error", err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return CareerGoalDto{}, err
+	}
+	return c.getMyGoal(ctx, userID, goalID)
+}
+
+// UpdateActivity implements CareerGoalUsecase.
+// 目標の学習活動でない場合は存在しないものとして扱います
+func (c *careerGoalUsecase) UpdateActivity(ctx context.Context, goalID, activityID int, input LearningActivityInput) (_ CareerGoalDto, err error) {
+	ctx, span := tracer.Start(ctx, "CareerGoalUsecase.UpdateActivity")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return CareerGoalDto{}, ErrUnauthenticated
+	}
+	activity, err := model.NewLearningActivity(goalID, input.Kind, input.Title, input.URL, input.Status, input.Progress, input.DueMonth)
+	if err != nil {
+		return CareerGoalDto{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	activity.ID = activityID
+
+	err = c.transactionManager.Do(ctx, func(ctx context.Context) error {
+		goal, err := c.findMyGoal(ctx, userID, goalID)
+		if err != nil {
+			return err
+		}
+		current, ok := findLearningActivity(goal, activityID)
+		if !ok {
+			return ErrNotFound
+		}
+		updated, err := c.careerGoalRepository.UpdateActivity(ctx, *activity)
+		if err != nil {
+			c.logger.ErrorContext(ctx, "failed to update learning activity", slog.Any("error", err))
+			return err
+		}
+		if err := c.audit.record(ctx, AuditActionUpdate, AuditEntityLearningActivity, activityID, toLearningActivityDto(current), toLearningActivityDto(updated)); err != nil {
+			c.logger.ErrorContext(ctx, "failed to record audit log", slog.Any("error", err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return CareerGoalDto{}, err
+	}
+	return c.getMyGoal(ctx, userID, goalID)
+}
+
+// DeleteActivity implements CareerGoalUsecase.
+func (c *careerGoalUsecase) DeleteActivity(ctx context.Context, goalID, activityID int) (err error) {
+	ctx, span := tracer.Start(ctx, "CareerGoalUsecase.DeleteActivity")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return ErrUnauthenticated
+	}
+	return c.transactionManager.Do(ctx, func(ctx context.Context) error {
+		goal, err := c.findMyGoal(ctx, userID, goalID)
+		if err != nil {
+			return err
+		}
+		current, ok := findLearningActivity(goal, activityID)
+		if !ok {
+			return ErrNotFound
+		}
+		if err := c.careerGoalRepository.DeleteActivity(ctx, goalID, activityID); err != nil {
+			c.logger.ErrorContext(ctx, "failed to delete learning activity", slog.Any("error", err))
+			return err
+		}
+		if err := c.audit.record(ctx, AuditActionDelete, AuditEntityLearningActivity, activityID, toLearningActivityDto(current), nil); err != nil {
+			c.logger.ErrorContext(ctx, "failed to record audit log", slog.Any("error", err))
+			return err
+		}
+		return nil
+	})
+}
+
+// ListTeamGoals implements CareerGoalUsecase.
+// チームのマネージャーだけが参照できます
+func (c *careerGoalUsecase) ListTeamGoals(ctx context.Context, teamID int) (_ []MemberCareerGoalsDto, err error) {
+	ctx, span := tracer.Start(ctx, "CareerGoalUsecase.ListTeamGoals")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return nil, ErrUnauthenticated
+	}
+	if _, err := c.organizationRepository.FindTeamByID(ctx, teamID); err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			c.logger.ErrorContext(ctx, "failed to get team", slog.Any("error", err))
+		}
+		return nil, err
+	}
+	members, err := c.organizationRepository.FindMembersByTeamID(ctx, teamID)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "failed to get team members", slog.Any("error", err))
+		return nil, err
+	}
+	if manager, ok := findMember(members, userID); !ok || !manager.IsManager() {
+		return nil, ErrForbidden
+	}
+
+	userIDs := make([]string, len(members))
+	for i, member := range members {
+		userIDs[i] = member.UserID
+	}
+	profiles, err := c.profileRepository.FindByUserIDs(ctx, userIDs)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "failed to get profiles", slog.Any("error", err))
+		return nil, err
+	}
+	goals, err := c.careerGoalRepository.FindByUserIDs(ctx, userIDs)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "failed to get career goals", slog.Any("error", err))
+		return nil, err
+	}
+	byUser := make(map[string][]model.CareerGoal)
+	for _, goal := range goals {
+		byUser[goal.UserID] = append(byUser[goal.UserID], goal)
+	}
+
+	now := time.Now()
+	memberDtos := newMemberDtos(userIDs, profiles)
+	result := make([]MemberCareerGoalsDto, len(memberDtos))
+	for i, member := range memberDtos {
+		dtos, err := c.toCareerGoalDtos(ctx, member.UserID, byUser[member.UserID], now)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = MemberCareerGoalsDto{Member: member, Goals: dtos}
+	}
+	return result, nil
+}
+
+// findMyGoal はログイン中のユーザーの目標を返します。他のユーザーの目標は ErrNotFound にします
+func (c *careerGoalUsecase) findMyGoal(ctx context.Context, userID string, id int) (model.CareerGoal, error) {
+	goal, err := c.careerGoalRepository.FindByID(ctx, id)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			c.logger.ErrorContext(ctx, "failed to get career goal", slog.Any("error", err))
+		}
+		return model.CareerGoal{}, err
+	}
+	if goal.UserID != userID {
+		return model.CareerGoal{}, ErrNotFound
+	}
+	return goal, nil
+}
+
+// getMyGoal は学習活動を変更した後の目標を返します
+func (c *careerGoalUsecase) getMyGoal(ctx context.Context, userID string, id int) (CareerGoalDto, error) {
+	goal, err := c.findMyGoal(ctx, userID, id)
+	if err != nil {
+		return CareerGoalDto{}, err
+	}
+	return c.toCareerGoalDto(ctx, userID, goal, time.Now())
+}
+
+// toCareerGoalDtos は目標に、目標とするスキルの現在の自己評価を加えて返します
+// 自己評価はスキルの目標がある場合だけ取得します
+func (c *careerGoalUsecase) toCareerGoalDtos(ctx context.Context, userID string, goals []model.CareerGoal, now time.Time) ([]CareerGoalDto, error) {
+	var levels model.SkillLevels
+	for _, goal := range goals {
+		if goal.Skill == nil {
+			continue
+		}
+		var err error
+		if levels, err = findSkillLevels(ctx, c.skillAssessmentRepository, userID); err != nil {
+			c.logger.ErrorContext(ctx, "failed to get skill levels", slog.Any("error", err))
+			return nil, err
+		}
+		break
+	}
+	dtos := make([]CareerGoalDto, len(goals))
+	for i, goal := range goals {
+		current := 0
+		if goal.Skill != nil {
+			current = int(levels.Of(*goal.Skill).Self)
+		}
+		dtos[i] = toCareerGoalDto(goal, current, now)
+	}
+	return dtos, nil
+}
+
+func (c *careerGoalUsecase) toCareerGoalDto(ctx context.Context, userID string, goal model.CareerGoal, now time.Time) (CareerGoalDto, error) {
+	dtos, err := c.toCareerGoalDtos(ctx, userID, []model.CareerGoal{goal}, now)
+	if err != nil {
+		return CareerGoalDto{}, err
+	}
+	return dtos[0], nil
+}
+
+// toCareerGoal は入力値を検証して進行中の目標に変換します
+func (in CareerGoalInput) toCareerGoal(userID string) (*model.CareerGoal, error) {
+	var skill *model.Skill
+	if in.SkillCategory != "" || in.SkillName != "" {
+		s, err := model.NewSkill(in.SkillCategory, in.SkillName)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+		skill = &s
+	}
+	var targetMonth time.Time
+	if in.TargetMonth != nil {
+		targetMonth = *in.TargetMonth
+	}
+	goal, err := model.NewCareerGoal(userID, in.Kind, in.Title, skill, in.TargetLevel, targetMonth, in.Note)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	return goal, nil
+}
+
+func findLearningActivity(goal model.CareerGoal, id int) (model.LearningActivity, bool) {
+	for _, activity := range goal.Activities {
+		if activity.ID == id {
+			return activity, true
+		}
+	}
+	return model.LearningActivity{}, false
+}
+
+func toCareerGoalDto(goal model.CareerGoal, currentLevel int, now time.Time) CareerGoalDto {
+	dto := CareerGoalDto{
+		ID:           goal.ID,
+		Kind:         string(goal.Kind),
+		Title:        goal.Title,
+		TargetLevel:  int(goal.TargetLevel),
+		CurrentLevel: currentLevel,
+		TargetMonth:  goal.TargetMonth,
+		Status:       string(goal.Status),
+		Note:         goal.Note,
+		Progress:     goal.Progress(),
+		Overdue:      goal.IsOverdue(now),
+		Activities:   make([]LearningActivityDto, len(goal.Activities)),
+		CreatedAt:    goal.CreatedAt,
+		UpdatedAt:    goal.UpdatedAt,
+	}
+	if goal.Skill != nil {
+		dto.SkillCategory = string(goal.Skill.Category)
+		dto.SkillName = goal.Skill.Name
+	}
+	for i, activity := range goal.Activities {
+		dto.Activities[i] = toLearningActivityDto(activity)
+	}
+	return dto
+}
+
+func toLearningActivityDto(activity model.LearningActivity) LearningActivityDto {
+	return LearningActivityDto{
+		ID:        activity.ID,
+		Kind:      string(activity.Kind),
+		Title:     activity.Title,
+		URL:       activity.URL,
+		Status:    string(activity.Status),
+		Progress:  activity.Progress,
+		DueMonth:  activity.DueMonth,
+		CreatedAt: activity.CreatedAt,
+		UpdatedAt: activity.UpdatedAt,
+	}
+}
+
+type CareerGoalUsecase interface {
+	// ListMyGoals はログイン中のユーザーの目標を、学習活動と進捗とともに達成予定月の早い順に返します
+	ListMyGoals(ctx context.Context) ([]CareerGoalDto, error)
+	// CreateGoal は進行中の目標を登録します
+	CreateGoal(ctx context.Context, input CareerGoalInput) (CareerGoalDto, error)
+	// UpdateGoal は目標の内容と状態（達成・断念）を更新します
+	UpdateGoal(ctx context.Context, id int, input CareerGoalInput) (CareerGoalDto, error)
+	// DeleteGoal は目標を学習活動とともに削除します
+	DeleteGoal(ctx context.Context, id int) error
+	// AddActivity は目標に学習活動を登録し、登録後の目標を返します
+	AddActivity(ctx context.Context, goalID int, input LearningActivityInput) (CareerGoalDto, error)
+	// UpdateActivity は学習活動の内容と進捗を更新し、更新後の目標を返します
+	UpdateActivity(ctx context.Context, goalID, activityID int, input LearningActivityInput) (CareerGoalDto, error)
+	// DeleteActivity は学習活動を削除します
+	DeleteActivity(ctx context.Context, goalID, activityID int) error
+	// ListTeamGoals はチームのメンバーごとの目標を返します（チームのマネージャーのみ）
+	ListTeamGoals(ctx context.Context, teamID int) ([]MemberCareerGoalsDto, error)
+}
+
+func NewCareerGoalUsecase(
+	careerGoalRepository repository.CareerGoalRepository,
+	skillAssessmentRepository repository.SkillAssessmentRepository,
+	organizationRepository repository.OrganizationRepository,
+	profileRepository repository.ProfileRepository,
+	auditLogRepository repository.AuditLogRepository,
+	transactionManager repository.TransactionManager,
+	logger *slog.Logger,
+) CareerGoalUsecase {
+	return &careerGoalUsecase{
+		careerGoalRepository:      careerGoalRepository,
+		skillAssessmentRepository: skillAssessmentRepository,
+		organizationRepository:    organizationRepository,
+		profileRepository:         profileRepository,
+		transactionManager:        transactionManager,
+		audit:                     auditRecorder{auditLogRepository: auditLogRepository},
+		logger:                    logger.With(slog.String("usecase", "career_goal")),
+	}
+}
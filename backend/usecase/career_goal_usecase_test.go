@@ -0,0 +1,584 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"stackies/backend/domain/repository/mock"
+	"stackies/backend/usecase"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type careerGoalMocks struct {
+	goal         *mock.MockCareerGoalRepository
+	skill        *mock.MockSkillAssessmentRepository
+	organization *mock.MockOrganizationRepository
+	profile      *mock.MockProfileRepository
+	audit        *mock.MockAuditLogRepository
+}
+
+func newCareerGoalUsecase(ctrl *gomock.Controller) (usecase.CareerGoalUsecase, careerGoalMocks) {
+	m := careerGoalMocks{
+		goal:         mock.NewMockCareerGoalRepository(ctrl),
+		skill:        mock.NewMockSkillAssessmentRepository(ctrl),
+		organization: mock.NewMockOrganizationRepository(ctrl),
+		profile:      mock.NewMockProfileRepository(ctrl),
+		audit:        mock.NewMockAuditLogRepository(ctrl),
+	}
+	uc := usecase.NewCareerGoalUsecase(m.goal, m.skill, m.organization, m.profile, m.audit, newTransactionManager(ctrl), discardLogger)
+	return uc, m
+}
+
+// expectSkillLevels はユーザーの自己評価を設定します（推薦はなし）
+func expectSkillLevels(m careerGoalMocks, userID string, assessments ...model.SkillAssessment) {
+	m.skill.EXPECT().FindByUserID(gomock.Any(), userID).Return(assessments, nil)
+	m.skill.EXPECT().FindEndorsementsByUserID(gomock.Any(), userID).Return(nil, nil)
+}
+
+func TestCareerGoalUsecase_ListMyGoals(t *testing.T) {
+	month := func(year int, m time.Month) time.Time { return time.Date(year, m, 1, 0, 0, 0, 0, time.UTC) }
+	goSkill := model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}
+
+	tests := []struct {
+		name      string
+		userID    string
+		setupMock func(careerGoalMocks)
+		check     func(*testing.T, []usecase.CareerGoalDto)
+		wantErr   error
+	}{
+		{
+			name:   "正常系: 目標に学習活動の進捗と、目標とするスキルの現在の自己評価を加える",
+			userID: "user-1",
+			setupMock: func(m careerGoalMocks) {
+				m.goal.EXPECT().FindByUserIDs(gomock.Any(), []string{"user-1"}).Return([]model.CareerGoal{
+					{
+						ID: 1, UserID: "user-1", Kind: model.CareerGoalKindSkill, Title: "Go で設計できる", Skill: &goSkill, TargetLevel: 4,
+						TargetMonth: month(2020, time.March), Status: model.CareerGoalStatusActive,
+						Activities: []model.LearningActivity{
+							{ID: 1, GoalID: 1, Kind: model.LearningActivityKindCourse, Title: "並行処理の講座", Status: model.LearningActivityStatusCompleted, Progress: 100},
+							{ID: 2, GoalID: 1, Kind: model.LearningActivityKindSideProject, Title: "CLI ツール", Status: model.LearningActivityStatusInProgress, Progress: 40},
+						},
+					},
+					{ID: 2, UserID: "user-1", Kind: model.CareerGoalKindRole, Title: "テックリード", TargetMonth: month(2099, time.March), Status: model.CareerGoalStatusActive},
+				}, nil)
+				expectSkillLevels(m, "user-1", model.SkillAssessment{UserID: "user-1", Skill: goSkill, Level: 3})
+			},
+			check: func(t *testing.T, goals []usecase.CareerGoalDto) {
+				require.Len(t, goals, 2)
+				assert.Equal(t, "language", goals[0].SkillCategory)
+				assert.Equal(t, 4, goals[0].TargetLevel)
+				assert.Equal(t, 3, goals[0].CurrentLevel)
+				assert.Equal(t, 70, goals[0].Progress)
+				assert.True(t, goals[0].Overdue)
+				require.Len(t, goals[0].Activities, 2)
+				assert.Equal(t, "side_project", goals[0].Activities[1].Kind)
+
+				assert.Equal(t, 0, goals[1].CurrentLevel)
+				assert.Equal(t, 0, goals[1].Progress)
+				assert.False(t, goals[1].Overdue)
+			},
+		},
+		{
+			name:   "正常系: スキルの目標がない場合は自己評価を取得しない",
+			userID: "user-1",
+			setupMock: func(m careerGoalMocks) {
+				m.goal.EXPECT().FindByUserIDs(gomock.Any(), []string{"user-1"}).Return(nil, nil)
+			},
+			check: func(t *testing.T, goals []usecase.CareerGoalDto) {
+				assert.Empty(t, goals)
+			},
+		},
+		{
+			name:      "異常系: ユーザーが設定されていない",
+			setupMock: func(m careerGoalMocks) {},
+			wantErr:   usecase.ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newCareerGoalUsecase(ctrl)
+			tt.setupMock(m)
+
+			ctx := context.Background()
+			if tt.userID != "" {
+				ctx = usecase.ContextWithUserID(ctx, tt.userID)
+			}
+			got, err := uc.ListMyGoals(ctx)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, got)
+		})
+	}
+}
+
+func TestCareerGoalUsecase_CreateGoal(t *testing.T) {
+	target := time.Date(2027, time.March, 1, 0, 0, 0, 0, time.UTC)
+	goSkill := model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}
+
+	tests := []struct {
+		name      string
+		input     usecase.CareerGoalInput
+		setupMock func(careerGoalMocks)
+		want      usecase.CareerGoalDto
+		wantErr   error
+	}{
+		{
+			name:  "正常系: スキルの目標を進行中として登録し、監査ログに記録する",
+			input: usecase.CareerGoalInput{Kind: "skill", Title: "Go で設計できる", SkillCategory: "language", SkillName: "Go", TargetLevel: 4, TargetMonth: &target},
+			setupMock: func(m careerGoalMocks) {
+				m.goal.EXPECT().Create(gomock.Any(), model.CareerGoal{
+					UserID: "user-1", Kind: model.CareerGoalKindSkill, Title: "Go で設計できる", Skill: &goSkill, TargetLevel: 4,
+					TargetMonth: target, Status: model.CareerGoalStatusActive,
+				}).DoAndReturn(func(_ context.Context, goal model.CareerGoal) (model.CareerGoal, error) {
+					goal.ID = 1
+					return goal, nil
+				})
+				m.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log model.AuditLog) error {
+					assert.Equal(t, usecase.AuditActionCreate, log.Action)
+					assert.Equal(t, usecase.AuditEntityCareerGoal, log.EntityType)
+					assert.Equal(t, "1", log.EntityID)
+					return nil
+				})
+				expectSkillLevels(m, "user-1")
+			},
+			want: usecase.CareerGoalDto{
+				ID: 1, Kind: "skill", Title: "Go で設計できる", SkillCategory: "language", SkillName: "Go", TargetLevel: 4,
+				TargetMonth: target, Status: "active", Activities: []usecase.LearningActivityDto{},
+			},
+		},
+		{
+			name:      "異常系: 達成予定月がない",
+			input:     usecase.CareerGoalInput{Kind: "role", Title: "テックリード"},
+			setupMock: func(m careerGoalMocks) {},
+			wantErr:   usecase.ErrInvalidInput,
+		},
+		{
+			name:      "異常系: 登録時に状態は指定できない",
+			input:     usecase.CareerGoalInput{Kind: "role", Title: "テックリード", TargetMonth: &target, Status: "achieved"},
+			setupMock: func(m careerGoalMocks) {},
+			wantErr:   usecase.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newCareerGoalUsecase(ctrl)
+			tt.setupMock(m)
+
+			got, err := uc.CreateGoal(usecase.ContextWithUserID(context.Background(), "user-1"), tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCareerGoalUsecase_UpdateGoal(t *testing.T) {
+	target := time.Date(2027, time.March, 1, 0, 0, 0, 0, time.UTC)
+	current := model.CareerGoal{
+		ID: 1, UserID: "user-1", Kind: model.CareerGoalKindRole, Title: "テックリード",
+		TargetMonth: target, Status: model.CareerGoalStatusActive,
+	}
+
+	tests := []struct {
+		name      string
+		input     usecase.CareerGoalInput
+		setupMock func(careerGoalMocks)
+		check     func(*testing.T, usecase.CareerGoalDto)
+		wantErr   error
+	}{
+		{
+			name:  "正常系: 目標を達成にすると進捗率は 100",
+			input: usecase.CareerGoalInput{Kind: "role", Title: "テックリード", TargetMonth: &target, Status: "achieved"},
+			setupMock: func(m careerGoalMocks) {
+				m.goal.EXPECT().FindByID(gomock.Any(), 1).Return(current, nil)
+				m.goal.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, goal model.CareerGoal) (model.CareerGoal, error) {
+					assert.Equal(t, 1, goal.ID)
+					assert.Equal(t, model.CareerGoalStatusAchieved, goal.Status)
+					return goal, nil
+				})
+				m.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log model.AuditLog) error {
+					assert.Equal(t, usecase.AuditActionUpdate, log.Action)
+					assert.Contains(t, string(log.Before), `"Status":"active"`)
+					assert.Contains(t, string(log.After), `"Status":"achieved"`)
+					return nil
+				})
+			},
+			check: func(t *testing.T, goal usecase.CareerGoalDto) {
+				assert.Equal(t, "achieved", goal.Status)
+				assert.Equal(t, 100, goal.Progress)
+			},
+		},
+		{
+			name:  "正常系: 状態を省略した場合は変更しない",
+			input: usecase.CareerGoalInput{Kind: "role", Title: "エンジニアリングマネージャー", TargetMonth: &target},
+			setupMock: func(m careerGoalMocks) {
+				m.goal.EXPECT().FindByID(gomock.Any(), 1).Return(current, nil)
+				m.goal.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, goal model.CareerGoal) (model.CareerGoal, error) {
+					assert.Equal(t, model.CareerGoalStatusActive, goal.Status)
+					return goal, nil
+				})
+				m.audit.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			check: func(t *testing.T, goal usecase.CareerGoalDto) {
+				assert.Equal(t, "エンジニアリングマネージャー", goal.Title)
+				assert.Equal(t, "active", goal.Status)
+			},
+		},
+		{
+			name:  "異常系: 状態が不正",
+			input: usecase.CareerGoalInput{Kind: "role", Title: "テックリード", TargetMonth: &target, Status: "paused"},
+			setupMock: func(m careerGoalMocks) {
+				m.goal.EXPECT().FindByID(gomock.Any(), 1).Return(current, nil)
+			},
+			wantErr: usecase.ErrInvalidInput,
+		},
+		{
+			name:  "異常系: 他のユーザーの目標は存在しないものとして扱う",
+			input: usecase.CareerGoalInput{Kind: "role", Title: "テックリード", TargetMonth: &target},
+			setupMock: func(m careerGoalMocks) {
+				other := current
+				other.UserID = "user-2"
+				m.goal.EXPECT().FindByID(gomock.Any(), 1).Return(other, nil)
+			},
+			wantErr: usecase.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newCareerGoalUsecase(ctrl)
+			tt.setupMock(m)
+
+			got, err := uc.UpdateGoal(usecase.ContextWithUserID(context.Background(), "user-1"), 1, tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, got)
+		})
+	}
+}
+
+func TestCareerGoalUsecase_DeleteGoal(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupMock func(careerGoalMocks)
+		wantErr   error
+	}{
+		{
+			name: "正常系: 目標を削除し、監査ログに記録する",
+			setupMock: func(m careerGoalMocks) {
+				m.goal.EXPECT().FindByID(gomock.Any(), 1).Return(model.CareerGoal{ID: 1, UserID: "user-1"}, nil)
+				m.goal.EXPECT().Delete(gomock.Any(), 1).Return(nil)
+				m.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log model.AuditLog) error {
+					assert.Equal(t, usecase.AuditActionDelete, log.Action)
+					assert.Nil(t, log.After)
+					return nil
+				})
+			},
+		},
+		{
+			name: "異常系: 目標が存在しない",
+			setupMock: func(m careerGoalMocks) {
+				m.goal.EXPECT().FindByID(gomock.Any(), 1).Return(model.CareerGoal{}, repository.ErrNotFound)
+			},
+			wantErr: usecase.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newCareerGoalUsecase(ctrl)
+			tt.setupMock(m)
+
+			err := uc.DeleteGoal(usecase.ContextWithUserID(context.Background(), "user-1"), 1)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCareerGoalUsecase_AddActivity(t *testing.T) {
+	goal := model.CareerGoal{ID: 1, UserID: "user-1", Kind: model.CareerGoalKindRole, Title: "テックリード", Status: model.CareerGoalStatusActive}
+	activity := model.LearningActivity{ID: 5, GoalID: 1, Kind: model.LearningActivityKindCertification, Title: "AWS SAA", Status: model.LearningActivityStatusInProgress, Progress: 30}
+
+	tests := []struct {
+		name      string
+		input     usecase.LearningActivityInput
+		setupMock func(careerGoalMocks)
+		check     func(*testing.T, usecase.CareerGoalDto)
+		wantErr   error
+	}{
+		{
+			name:  "正常系: 学習活動を登録し、登録後の目標を返す",
+			input: usecase.LearningActivityInput{Kind: "certification", Title: "AWS SAA", Status: "in_progress", Progress: 30},
+			setupMock: func(m careerGoalMocks) {
+				m.goal.EXPECT().FindByID(gomock.Any(), 1).Return(goal, nil)
+				m.goal.EXPECT().CreateActivity(gomock.Any(), model.LearningActivity{
+					GoalID: 1, Kind: model.LearningActivityKindCertification, Title: "AWS SAA", Status: model.LearningActivityStatusInProgress, Progress: 30,
+				}).Return(activity, nil)
+				m.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log model.AuditLog) error {
+					assert.Equal(t, usecase.AuditEntityLearningActivity, log.EntityType)
+					assert.Equal(t, "5", log.EntityID)
+					return nil
+				})
+				withActivity := goal
+				withActivity.Activities = []model.LearningActivity{activity}
+				m.goal.EXPECT().FindByID(gomock.Any(), 1).Return(withActivity, nil)
+			},
+			check: func(t *testing.T, goal usecase.CareerGoalDto) {
+				require.Len(t, goal.Activities, 1)
+				assert.Equal(t, 30, goal.Progress)
+			},
+		},
+		{
+			name:  "異常系: 学習活動が上限に達している",
+			input: usecase.LearningActivityInput{Kind: "course", Title: "Go の講座", Status: "planned"},
+			setupMock: func(m careerGoalMocks) {
+				full := goal
+				full.Activities = make([]model.LearningActivity, model.CareerGoalActivitiesMax)
+				m.goal.EXPECT().FindByID(gomock.Any(), 1).Return(full, nil)
+			},
+			wantErr: usecase.ErrInvalidInput,
+		},
+		{
+			name:      "異常系: 進捗率が範囲外",
+			input:     usecase.LearningActivityInput{Kind: "course", Title: "Go の講座", Status: "in_progress", Progress: 101},
+			setupMock: func(m careerGoalMocks) {},
+			wantErr:   usecase.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newCareerGoalUsecase(ctrl)
+			tt.setupMock(m)
+
+			got, err := uc.AddActivity(usecase.ContextWithUserID(context.Background(), "user-1"), 1, tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, got)
+		})
+	}
+}
+
+func TestCareerGoalUsecase_UpdateActivity(t *testing.T) {
+	activity := model.LearningActivity{ID: 5, GoalID: 1, Kind: model.LearningActivityKindCourse, Title: "並行処理の講座", Status: model.LearningActivityStatusInProgress, Progress: 30}
+	goal := model.CareerGoal{ID: 1, UserID: "user-1", Kind: model.CareerGoalKindRole, Title: "テックリード", Status: model.CareerGoalStatusActive, Activities: []model.LearningActivity{activity}}
+
+	tests := []struct {
+		name       string
+		activityID int
+		setupMock  func(careerGoalMocks)
+		check      func(*testing.T, usecase.CareerGoalDto)
+		wantErr    error
+	}{
+		{
+			name:       "正常系: 学習活動を完了にすると進捗率は 100",
+			activityID: 5,
+			setupMock: func(m careerGoalMocks) {
+				m.goal.EXPECT().FindByID(gomock.Any(), 1).Return(goal, nil)
+				completed := activity
+				completed.Status, completed.Progress = model.LearningActivityStatusCompleted, 100
+				m.goal.EXPECT().UpdateActivity(gomock.Any(), completed).Return(completed, nil)
+				m.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log model.AuditLog) error {
+					assert.Contains(t, string(log.Before), `"Progress":30`)
+					assert.Contains(t, string(log.After), `"Progress":100`)
+					return nil
+				})
+				updated := goal
+				updated.Activities = []model.LearningActivity{completed}
+				m.goal.EXPECT().FindByID(gomock.Any(), 1).Return(updated, nil)
+			},
+			check: func(t *testing.T, goal usecase.CareerGoalDto) {
+				assert.Equal(t, 100, goal.Progress)
+				assert.Equal(t, "completed", goal.Activities[0].Status)
+			},
+		},
+		{
+			name:       "異常系: 目標の学習活動ではない",
+			activityID: 9,
+			setupMock: func(m careerGoalMocks) {
+				m.goal.EXPECT().FindByID(gomock.Any(), 1).Return(goal, nil)
+			},
+			wantErr: usecase.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newCareerGoalUsecase(ctrl)
+			tt.setupMock(m)
+
+			input := usecase.LearningActivityInput{Kind: "course", Title: "並行処理の講座", Status: "completed"}
+			got, err := uc.UpdateActivity(usecase.ContextWithUserID(context.Background(), "user-1"), 1, tt.activityID, input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, got)
+		})
+	}
+}
+
+func TestCareerGoalUsecase_DeleteActivity(t *testing.T) {
+	goal := model.CareerGoal{ID: 1, UserID: "user-1", Activities: []model.LearningActivity{{ID: 5, GoalID: 1}}}
+
+	tests := []struct {
+		name       string
+		activityID int
+		setupMock  func(careerGoalMocks)
+		wantErr    error
+	}{
+		{
+			name:       "正常系: 学習活動を削除し、監査ログに記録する",
+			activityID: 5,
+			setupMock: func(m careerGoalMocks) {
+				m.goal.EXPECT().FindByID(gomock.Any(), 1).Return(goal, nil)
+				m.goal.EXPECT().DeleteActivity(gomock.Any(), 1, 5).Return(nil)
+				m.audit.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:       "異常系: 目標の学習活動ではない",
+			activityID: 9,
+			setupMock: func(m careerGoalMocks) {
+				m.goal.EXPECT().FindByID(gomock.Any(), 1).Return(goal, nil)
+			},
+			wantErr: usecase.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newCareerGoalUsecase(ctrl)
+			tt.setupMock(m)
+
+			err := uc.DeleteActivity(usecase.ContextWithUserID(context.Background(), "user-1"), 1, tt.activityID)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCareerGoalUsecase_ListTeamGoals(t *testing.T) {
+	target := time.Date(2027, time.March, 1, 0, 0, 0, 0, time.UTC)
+	goSkill := model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}
+	members := []model.TeamMember{
+		{TeamID: 1, UserID: "manager-1", Role: model.TeamRoleManager},
+		{TeamID: 1, UserID: "user-1", Role: model.TeamRoleMember},
+	}
+
+	tests := []struct {
+		name      string
+		userID    string
+		setupMock func(careerGoalMocks)
+		check     func(*testing.T, []usecase.MemberCareerGoalsDto)
+		wantErr   error
+	}{
+		{
+			name:   "正常系: チームのメンバーごとに目標を返す",
+			userID: "manager-1",
+			setupMock: func(m careerGoalMocks) {
+				m.organization.EXPECT().FindTeamByID(gomock.Any(), 1).Return(model.Team{ID: 1}, nil)
+				m.organization.EXPECT().FindMembersByTeamID(gomock.Any(), 1).Return(members, nil)
+				m.profile.EXPECT().FindByUserIDs(gomock.Any(), []string{"manager-1", "user-1"}).Return([]model.Profile{
+					{UserID: "user-1", DisplayName: "山田 太郎"},
+				}, nil)
+				m.goal.EXPECT().FindByUserIDs(gomock.Any(), []string{"manager-1", "user-1"}).Return([]model.CareerGoal{
+					{ID: 3, UserID: "user-1", Kind: model.CareerGoalKindSkill, Title: "Go で設計できる", Skill: &goSkill, TargetMonth: target, Status: model.CareerGoalStatusActive},
+				}, nil)
+				expectSkillLevels(m, "user-1", model.SkillAssessment{UserID: "user-1", Skill: goSkill, Level: 2})
+			},
+			check: func(t *testing.T, got []usecase.MemberCareerGoalsDto) {
+				require.Len(t, got, 2)
+				assert.Equal(t, "manager-1", got[0].Member.UserID)
+				assert.Empty(t, got[0].Goals)
+				assert.Equal(t, usecase.MemberDto{UserID: "user-1", DisplayName: "山田 太郎"}, got[1].Member)
+				require.Len(t, got[1].Goals, 1)
+				assert.Equal(t, 2, got[1].Goals[0].CurrentLevel)
+			},
+		},
+		{
+			name:   "異常系: チームのマネージャーではない",
+			userID: "user-1",
+			setupMock: func(m careerGoalMocks) {
+				m.organization.EXPECT().FindTeamByID(gomock.Any(), 1).Return(model.Team{ID: 1}, nil)
+				m.organization.EXPECT().FindMembersByTeamID(gomock.Any(), 1).Return(members, nil)
+			},
+			wantErr: usecase.ErrForbidden,
+		},
+		{
+			name:   "異常系: チームが存在しない",
+			userID: "manager-1",
+			setupMock: func(m careerGoalMocks) {
+				m.organization.EXPECT().FindTeamByID(gomock.Any(), 1).Return(model.Team{}, repository.ErrNotFound)
+			},
+			wantErr: usecase.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newCareerGoalUsecase(ctrl)
+			tt.setupMock(m)
+
+			got, err := uc.ListTeamGoals(usecase.ContextWithUserID(context.Background(), tt.userID), 1)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, got)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+//go:generate mockgen -source=catalog_usecase.go -destination=mock/mock_$GOFILE -package=mock
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+)
+
+// CatalogItemDto は言語・ツールのカタログの項目です
+type CatalogItemDto struct {
+	ID       int      `json:"id"`
+	Category string   `json:"category"`
+	Name     string   `json:"name"`
+	Aliases  []string `json:"aliases"`
+	// Global は全テナント共通のカタログの項目であることを表します
+	Global bool `json:"global"`
+}
+
+// CatalogItemInput はテナント独自のカタログの項目の登録の入力値です
+type CatalogItemInput struct {
+	// Category は language または tool です
+	Category string
+	Name     string
+	Aliases  []string
+}
+
+type catalogUsecase struct {
+	catalogRepository  repository.CatalogRepository
+	transactionManager repository.TransactionManager
+	audit              auditRecorder
+	logger             *slog.Logger
+}
+
+// List implements CatalogUsecase.
+func (c *catalogUsecase) List(ctx context.Context) (_ []CatalogItemDto, err error) {
+	ctx, span := tracer.Start(ctx, "CatalogUsecase.List")
+	defer func() { endSpan(span, err) }()
+
+	items, err := c.catalogRepository.GetAll(ctx)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "failed to get catalog", slog.Any("error", err))
+		return nil, err
+	}
+	dtos := make([]CatalogItemDto, len(items))
+	for i, item := range items {
+		dtos[i] = toCatalogItemDto(item)
+	}
+	return dtos, nil
+}
+
+// Create implements CatalogUsecase.
+func (c *catalogUsecase) Create(ctx context.Context, input CatalogItemInput) (_ CatalogItemDto, err error) {
+	ctx, span := tracer.Start(ctx, "CatalogUsecase.Create")
+	defer func() { endSpan(span, err) }()
+
+	item, err := model.NewCatalogItem(input.Category, input.Name, input.Aliases)
+	if err != nil {
+		return CatalogItemDto{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	var created model.CatalogItem
+	err = c.transactionManager.Do(ctx, func(ctx context.Context) error {
+		items, err := c.catalogRepository.GetAll(ctx)
+		if err != nil {
+			c.logger.ErrorContext(ctx, "failed to get catalog", slog.Any("error", err))
+			return err
+		}
+		// 共通の項目と同じ名前は登録でき、テナントではその項目で置き換える
+		for _, existing := range items {
+			if !existing.Global && existing.SameName(*item) {
+				return fmt.Errorf("%w: catalog item %q already exists", ErrConflict, existing.Name)
+			}
+		}
+		created, err = c.catalogRepository.Create(ctx, *item)
+		if err != nil {
+			c.logger.ErrorContext(ctx, "failed to create catalog item", slog.Any("error", err))
+			return err
+		}
+		if err := c.audit.record(ctx, AuditActionCreate, catalogAuditEntity(created.Category), created.ID, nil, toCatalogItemDto(created)); err != nil {
+			c.logger.ErrorContext(ctx, "failed to record audit log", slog.Any("error", err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return CatalogItemDto{}, err
+	}
+	c.logger.InfoContext(ctx, "catalog item created", slog.String("category", string(created.Category)), slog.Int("catalog_item_id", created.ID))
+	return toCatalogItemDto(created), nil
+}
+
+// catalogAuditEntity はカタログの項目の監査ログの対象の種類を返します（言語とツールは別のテーブルです）
+func catalogAuditEntity(category model.SkillCategory) string {
+	if category == model.SkillCategoryTool {
+		return AuditEntityTool
+	}
+	return AuditEntityLanguage
+}
+
+func toCatalogItemDto(item model.CatalogItem) CatalogItemDto {
+	aliases := item.Aliases
+	if aliases == nil {
+		aliases = []string{}
+	}
+	return CatalogItemDto{
+		ID:       item.ID,
+		Category: string(item.Category),
+		Name:     item.Name,
+		Aliases:  aliases,
+		Global:   item.Global,
+	}
+}
+
+type CatalogUsecase interface {
+	// List はテナントの言語・ツールのカタログを返します
+	// テナントが共通のカタログを引き継ぐ場合は、テナント独自の項目に続けて共通の項目を返します
+	List(ctx context.Context) ([]CatalogItemDto, error)
+	// Create はテナント独自の項目を登録します（管理者用）
+	// テナント独自の項目に同じ区分・名前のものがある場合は ErrConflict を返します
+	Create(ctx context.Context, input CatalogItemInput) (CatalogItemDto, error)
+}
+
+func NewCatalogUsecase(
+	catalogRepository repository.CatalogRepository,
+	auditLogRepository repository.AuditLogRepository,
+	transactionManager repository.TransactionManager,
+	logger *slog.Logger,
+) CatalogUsecase {
+	return &catalogUsecase{
+		catalogRepository:  catalogRepository,
+		transactionManager: transactionManager,
+		audit:              auditRecorder{auditLogRepository: auditLogRepository},
+		logger:             logger.With(slog.String("usecase", "catalog")),
+	}
+}
@@ -0,0 +1,89 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"stackies/backend/domain/model"
+	"stackies/backend/domain/repository/mock"
+	"stackies/backend/usecase"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalogUsecase_Create(t *testing.T) {
+	catalog := []model.CatalogItem{
+		{ID: 100, Category: model.SkillCategoryTool, Name: "社内フレームワーク", Aliases: []string{"inhouse-fw"}},
+		{ID: 1, Category: model.SkillCategoryLanguage, Name: "Go", Aliases: []string{"golang"}, Global: true},
+	}
+
+	tests := []struct {
+		name      string
+		input     usecase.CatalogItemInput
+		setupMock func(*mock.MockCatalogRepository, *mock.MockAuditLogRepository)
+		want      usecase.CatalogItemDto
+		wantErr   error
+	}{
+		{
+			name:  "正常系: テナント独自の項目を登録して監査ログに記録する",
+			input: usecase.CatalogItemInput{Category: "tool", Name: " 帳票ツール ", Aliases: []string{"report-kit"}},
+			setupMock: func(c *mock.MockCatalogRepository, a *mock.MockAuditLogRepository) {
+				c.EXPECT().GetAll(gomock.Any()).Return(catalog, nil)
+				c.EXPECT().Create(gomock.Any(), model.CatalogItem{Category: model.SkillCategoryTool, Name: "帳票ツール", Aliases: []string{"report-kit"}}).
+					Return(model.CatalogItem{ID: 101, Category: model.SkillCategoryTool, Name: "帳票ツール", Aliases: []string{"report-kit"}}, nil)
+				a.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log model.AuditLog) error {
+					assert.Equal(t, usecase.AuditEntityTool, log.EntityType)
+					assert.Equal(t, "101", log.EntityID)
+					return nil
+				})
+			},
+			want: usecase.CatalogItemDto{ID: 101, Category: "tool", Name: "帳票ツール", Aliases: []string{"report-kit"}},
+		},
+		{
+			name:  "正常系: 共通の項目と同じ名前はテナント独自の項目として登録できる",
+			input: usecase.CatalogItemInput{Category: "language", Name: "go"},
+			setupMock: func(c *mock.MockCatalogRepository, a *mock.MockAuditLogRepository) {
+				c.EXPECT().GetAll(gomock.Any()).Return(catalog, nil)
+				c.EXPECT().Create(gomock.Any(), gomock.Any()).Return(model.CatalogItem{ID: 102, Category: model.SkillCategoryLanguage, Name: "go"}, nil)
+				a.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			want: usecase.CatalogItemDto{ID: 102, Category: "language", Name: "go", Aliases: []string{}},
+		},
+		{
+			name:  "異常系: テナント独自の項目に同じ名前がある",
+			input: usecase.CatalogItemInput{Category: "tool", Name: "社内 フレームワーク"},
+			setupMock: func(c *mock.MockCatalogRepository, a *mock.MockAuditLogRepository) {
+				c.EXPECT().GetAll(gomock.Any()).Return(catalog, nil)
+			},
+			wantErr: usecase.ErrConflict,
+		},
+		{
+			name:      "異常系: 未知の区分",
+			input:     usecase.CatalogItemInput{Category: "framework", Name: "Rails"},
+			setupMock: func(c *mock.MockCatalogRepository, a *mock.MockAuditLogRepository) {},
+			wantErr:   usecase.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			catalogRepository := mock.NewMockCatalogRepository(ctrl)
+			auditLogRepository := mock.NewMockAuditLogRepository(ctrl)
+			tt.setupMock(catalogRepository, auditLogRepository)
+			uc := usecase.NewCatalogUsecase(catalogRepository, auditLogRepository, newTransactionManager(ctrl), discardLogger)
+
+			got, err := uc.Create(usecase.ContextWithUserID(context.Background(), "admin-1"), tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
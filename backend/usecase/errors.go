@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"stackies/backend/domain/repository"
+)
+
+var (
+	// ErrNotFound は対象が存在しないことを表します
+	ErrNotFound = repository.ErrNotFound
+	// ErrInvalidInput は入力値が不正であることを表します
+	ErrInvalidInput = errors.New("invalid input")
+	// ErrConflict は指定したバージョンが現在のバージョンと一致しないことを表します
+	ErrConflict = repository.ErrConflict
+	// ErrUnauthenticated は操作を行うユーザーがコンテキストに設定されていないことを表します
+	ErrUnauthenticated = errors.New("unauthenticated")
+	// ErrForbidden は操作を行うユーザーに権限がないことを表します
+	ErrForbidden = errors.New("forbidden")
+	// ErrSharePasswordRequired は共有リンクの閲覧用パスワードが未指定または一致しないことを表します
+	ErrSharePasswordRequired = errors.New("share link password is required or incorrect")
+)
+
+// ExperienceConflictError は業務経歴が他の更新で変更されていたことを表します
+// 呼び出し元が差分をマージできるように現在の内容を持ちます
+type ExperienceConflictError struct {
+	Current ExperienceDto
+}
+
+func (e *ExperienceConflictError) Error() string {
+	return fmt.Sprintf("experience %d has been modified (current version %d)", e.Current.ID, e.Current.Version)
+}
+
+func (e *ExperienceConflictError) Unwrap() error {
+	return ErrConflict
+}
+
+// ImportValidationError は取り込む業務経歴に不正なものがあったことを表します
+// 呼び出し元がまとめて直せるように、全ての業務経歴のエラーを持ちます
+type ImportValidationError struct {
+	Errors []ImportRowErrorDto
+}
+
+func (e *ImportValidationError) Error() string {
+	return fmt.Sprintf("%d of the imported experiences are invalid", len(e.Errors))
+}
+
+func (e *ImportValidationError) Unwrap() error {
+	return ErrInvalidInput
+}
@@ -2,45 +2,694 @@
 package usecase
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"sort"
 	"stackies/backend/domain/model"
 	"stackies/backend/domain/repository"
+	"strings"
+	"time"
 )
 
+// 業務経歴の監査ログの操作種別（作成・更新・削除以外）
+const AuditActionRestore = "restore"
+
 type ExperienceDto struct {
-	ID    int
-	Title string
+	ID    int    `json:"id"`
+	Title string `json:"title"`
+	// ClientName は社外秘の顧客名、ClientAlias は顧客名の代わりに公開する名前です（未設定は空文字）
+	ClientName  string `json:"client_name,omitempty"`
+	ClientAlias string `json:"client_alias,omitempty"`
+	// Industry は顧客の業種です（未設定は空文字）
+	Industry string `json:"industry,omitempty"`
+	// StartMonth / EndMonth は参画期間の開始月・終了月の月初です（EndMonth が nil の場合は参画中）
+	StartMonth *time.Time `json:"start_month,omitempty"`
+	EndMonth   *time.Time `json:"end_month,omitempty"`
+	// TeamSize はチーム人数です（0 は未設定）
+	TeamSize int `json:"team_size,omitempty"`
+	// Skills は案件で使用した言語・ツールです
+	Skills []SkillDto `json:"skills,omitempty"`
+	// Phases は担当した工程です（"requirements"、"basic_design" など。上流から順）
+	Phases []string `json:"phases,omitempty"`
+	// Version は楽観的排他制御のためのバージョンです
+	// リビジョンのスナップショットや監査ログの差分には含めません
+	Version int `json:"-"`
+}
+
+// SkillDto は業務経歴で使用した言語・ツールです
+type SkillDto struct {
+	// Category は "language" または "tool" です
+	Category string `json:"category"`
+	Name     string `json:"name"`
+}
+
+// ExperienceInput は業務経歴の作成・更新の入力値です
+type ExperienceInput struct {
+	Title       string
+	ClientName  string
+	ClientAlias string
+	Industry    string
+	StartMonth  *time.Time
+	EndMonth    *time.Time
+	TeamSize    int
+	Skills      []SkillDto
+	Phases      []string
+}
+
+// toExperience は入力値を検証して、userID のユーザーの業務経歴のエンティティに変換します
+func (in ExperienceInput) toExperience(userID string) (*model.Experience, error) {
+	title, err := model.NewExperienceTitle(in.Title)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	client, err := model.NewClient(in.ClientName, in.ClientAlias)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	industry, err := model.NewIndustry(in.Industry)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	var period model.Period
+	if in.StartMonth != nil {
+		if period, err = model.NewPeriod(*in.StartMonth, in.EndMonth); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+	} else if in.EndMonth != nil {
+		return nil, fmt.Errorf("%w: start month is required when end month is set", ErrInvalidInput)
+	}
+	teamSize, err := model.NewTeamSize(in.TeamSize)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	var skills []model.Skill
+	for i, s := range in.Skills {
+		skill, err := model.NewSkill(s.Category, s.Name)
+		if err != nil {
+			return nil, fmt.Errorf("%w: skills[%d]: %w", ErrInvalidInput, i, err)
+		}
+		skills = append(skills, skill)
+	}
+	if err := model.ValidateSkills(skills); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	phases, err := model.NewPhases(in.Phases)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	experience := model.NewExperience(userID, title, period, teamSize, skills, phases)
+	experience.Client = client
+	experience.Industry = industry
+	return experience, nil
+}
+
+// experienceImportMax は一度に取り込める業務経歴の上限です
+const experienceImportMax = 200
+
+// ExperienceImportInput は他のサービスから取り込む業務経歴です
+// Technologies は言語・ツールのカタログに対応付けてスキルにします
+type ExperienceImportInput struct {
+	Title        string
+	ClientName   string
+	ClientAlias  string
+	Industry     string
+	StartMonth   *time.Time
+	EndMonth     *time.Time
+	TeamSize     int
+	Technologies []string
+	Phases       []string
+}
+
+// ImportResultDto は業務経歴の取り込み結果です
+type ImportResultDto struct {
+	// Created は登録した業務経歴です。dry run の場合は登録する内容（ID は 0）を返します
+	Created []ExperienceDto
+	// Unmatched はカタログに対応付けられず、スキルとして登録しなかった技術の名前です
+	Unmatched []UnmatchedTechnologyDto
+}
+
+// ImportRowErrorDto は取り込む業務経歴の不正な内容です
+type ImportRowErrorDto struct {
+	// Index は取り込んだ業務経歴の位置（0 始まり）です
+	Index   int
+	Message string
+}
+
+// UnmatchedTechnologyDto はカタログに対応付けられなかった技術の名前です
+type UnmatchedTechnologyDto struct {
+	// Index は取り込んだ業務経歴の位置（0 始まり）です
+	Index int
+	Name  string
+}
+
+// ExperienceRevisionDto は業務経歴のリビジョンです
+type ExperienceRevisionDto struct {
+	Revision  int
+	Snapshot  ExperienceDto
+	ActorID   string
+	CreatedAt time.Time
+}
+
+// FieldDiffDto はリビジョン間で変更されたフィールドです
+type FieldDiffDto struct {
+	Field string
+	From  interface{}
+	To    interface{}
 }
 
 type experienceUsecase struct {
-	experienceRepository repository.ExperienceRepository
+	experienceRepository         repository.ExperienceRepository
+	experienceRevisionRepository repository.ExperienceRevisionRepository
+	catalogRepository            repository.CatalogRepository
+	transactionManager           repository.TransactionManager
+	audit                        auditRecorder
+	logger                       *slog.Logger
 }
 
 // Create implements ExperienceUsecase.
-func (e *experienceUsecase) Create(title string) error {
-	return e.experienceRepository.Create(*model.NewExperience(title))
+func (e *experienceUsecase) Create(ctx context.Context, input ExperienceInput) (_ ExperienceDto, err error) {
+	ctx, span := tracer.Start(ctx, "ExperienceUsecase.Create")
+	defer func() { endSpan(span, err) }()
+
+	experience, err := input.toExperience(ActorFromContext(ctx).UserID)
+	if err != nil {
+		return ExperienceDto{}, err
+	}
+
+	// 業務経歴・リビジョン・監査ログはまとめて保存する
+	var created ExperienceDto
+	err = e.transactionManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		created, err = e.create(ctx, *experience)
+		return err
+	})
+	if err != nil {
+		return ExperienceDto{}, err
+	}
+	e.logger.InfoContext(ctx, "experience created", slog.Int("experience_id", created.ID))
+	return created, nil
+}
+
+// create は業務経歴を登録し、最初のリビジョンと監査ログを記録します
+// トランザクション内で呼び出してください
+func (e *experienceUsecase) create(ctx context.Context, experience model.Experience) (ExperienceDto, error) {
+	created, err := e.experienceRepository.Create(ctx, experience)
+	if err != nil {
+		e.logger.ErrorContext(ctx, "failed to create experience", slog.Any("error", err))
+		return ExperienceDto{}, err
+	}
+	after := toExperienceDto(created)
+	if err := e.saveRevision(ctx, after); err != nil {
+		return ExperienceDto{}, err
+	}
+	if err := e.audit.record(ctx, AuditActionCreate, AuditEntityExperience, created.ID, nil, after); err != nil {
+		e.logger.ErrorContext(ctx, "failed to record audit log", slog.Any("error", err))
+		return ExperienceDto{}, err
+	}
+	return after, nil
+}
+
+// Import implements ExperienceUsecase.
+// 全ての業務経歴を検証してから1つのトランザクションで登録するため、1件でも不正な場合は何も登録しません
+func (e *experienceUsecase) Import(ctx context.Context, inputs []ExperienceImportInput, dryRun bool) (_ ImportResultDto, err error) {
+	ctx, span := tracer.Start(ctx, "ExperienceUsecase.Import")
+	defer func() { endSpan(span, err) }()
+
+	if len(inputs) == 0 {
+		return ImportResultDto{}, fmt.Errorf("%w: no experiences to import", ErrInvalidInput)
+	}
+	if len(inputs) > experienceImportMax {
+		return ImportResultDto{}, fmt.Errorf("%w: experiences must be at most %d", ErrInvalidInput, experienceImportMax)
+	}
+	items, err := e.catalogRepository.GetAll(ctx)
+	if err != nil {
+		e.logger.ErrorContext(ctx, "failed to get catalog", slog.Any("error", err))
+		return ImportResultDto{}, err
+	}
+	catalog := model.NewCatalog(items)
+
+	userID := ActorFromContext(ctx).UserID
+	result := ImportResultDto{Created: []ExperienceDto{}, Unmatched: []UnmatchedTechnologyDto{}}
+	experiences := make([]*model.Experience, len(inputs))
+	var invalid []ImportRowErrorDto
+	for i, in := range inputs {
+		skills, unmatched := matchTechnologies(catalog, in.Technologies)
+		for _, name := range unmatched {
+			result.Unmatched = append(result.Unmatched, UnmatchedTechnologyDto{Index: i, Name: name})
+		}
+		experiences[i], err = ExperienceInput{
+			Title:       in.Title,
+			ClientName:  in.ClientName,
+			ClientAlias: in.ClientAlias,
+			Industry:    in.Industry,
+			StartMonth:  in.StartMonth,
+			EndMonth:    in.EndMonth,
+			TeamSize:    in.TeamSize,
+			Skills:      skills,
+			Phases:      in.Phases,
+		}.toExperience(userID)
+		if err != nil {
+			invalid = append(invalid, ImportRowErrorDto{Index: i, Message: strings.TrimPrefix(err.Error(), ErrInvalidInput.Error()+": ")})
+		}
+	}
+	if len(invalid) > 0 {
+		return ImportResultDto{}, &ImportValidationError{Errors: invalid}
+	}
+
+	if dryRun {
+		for _, experience := range experiences {
+			result.Created = append(result.Created, toExperienceDto(*experience))
+		}
+		return result, nil
+	}
+
+	err = e.transactionManager.Do(ctx, func(ctx context.Context) error {
+		for _, experience := range experiences {
+			created, err := e.create(ctx, *experience)
+			if err != nil {
+				return err
+			}
+			result.Created = append(result.Created, created)
+		}
+		return nil
+	})
+	if err != nil {
+		return ImportResultDto{}, err
+	}
+	e.logger.InfoContext(ctx, "experiences imported", slog.Int("created", len(result.Created)), slog.Int("unmatched", len(result.Unmatched)))
+	return result, nil
+}
+
+// matchTechnologies は技術の名前をカタログのスキルに対応付けます
+// 同じスキルに対応付けられた名前は1つにまとめ、対応付けられなかった名前は unmatched で返します
+func matchTechnologies(catalog model.Catalog, names []string) (skills []SkillDto, unmatched []string) {
+	seen := make(map[model.Skill]struct{})
+	for _, name := range names {
+		skill, ok := catalog.Match(name)
+		if !ok {
+			if name = strings.TrimSpace(name); name != "" {
+				unmatched = append(unmatched, name)
+			}
+			continue
+		}
+		if _, ok := seen[skill]; ok {
+			continue
+		}
+		seen[skill] = struct{}{}
+		skills = append(skills, SkillDto{Category: string(skill.Category), Name: skill.Name})
+	}
+	return skills, unmatched
 }
 
 // GetAll implements ExperienceUsecase.
-func (e *experienceUsecase) GetAll() ([]ExperienceDto, error) {
-	experiences, err := e.experienceRepository.GetAll()
+// ログイン中のユーザーが登録した業務経歴だけを返します
+func (e *experienceUsecase) GetAll(ctx context.Context) (_ []ExperienceDto, err error) {
+	ctx, span := tracer.Start(ctx, "ExperienceUsecase.GetAll")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return nil, ErrUnauthenticated
+	}
+	experiences, err := e.experienceRepository.FindByUserID(ctx, userID)
 	if err != nil {
+		e.logger.ErrorContext(ctx, "failed to get experiences", slog.Any("error", err))
 		return nil, err
 	}
 	experienceDtos := make([]ExperienceDto, len(experiences))
 	for i, experience := range experiences {
-		experienceDtos[i] = ExperienceDto{
-			ID:    experience.ID,
-			Title: experience.Title,
-		}
+		experienceDtos[i] = toExperienceDto(experience)
 	}
 	return experienceDtos, nil
 }
 
+// Get implements ExperienceUsecase.
+func (e *experienceUsecase) Get(ctx context.Context, id int) (_ ExperienceDto, err error) {
+	ctx, span := tracer.Start(ctx, "ExperienceUsecase.Get")
+	defer func() { endSpan(span, err) }()
+
+	experience, err := e.findMyExperience(ctx, id)
+	if err != nil {
+		return ExperienceDto{}, err
+	}
+	return toExperienceDto(experience), nil
+}
+
+// Update implements ExperienceUsecase.
+func (e *experienceUsecase) Update(ctx context.Context, id int, version int, input ExperienceInput) (_ ExperienceDto, err error) {
+	ctx, span := tracer.Start(ctx, "ExperienceUsecase.Update")
+	defer func() { endSpan(span, err) }()
+
+	var updated ExperienceDto
+	err = e.transactionManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		updated, err = e.update(ctx, id, version, input)
+		return err
+	})
+	if err != nil {
+		return ExperienceDto{}, err
+	}
+	e.logger.InfoContext(ctx, "experience updated", slog.Int("experience_id", id))
+	return updated, nil
+}
+
+// update は業務経歴を更新し、リビジョンと監査ログを記録します
+// トランザクション内で呼び出してください
+func (e *experienceUsecase) update(ctx context.Context, id int, version int, input ExperienceInput) (ExperienceDto, error) {
+	changed, err := input.toExperience(ActorFromContext(ctx).UserID)
+	if err != nil {
+		return ExperienceDto{}, err
+	}
+
+	current, err := e.findMyExperience(ctx, id)
+	if err != nil {
+		return ExperienceDto{}, err
+	}
+	before := toExperienceDto(current)
+	if current.Version != version {
+		return ExperienceDto{}, &ExperienceConflictError{Current: before}
+	}
+
+	current.Edit(*changed)
+	updated, err := e.experienceRepository.Update(ctx, current)
+	if err != nil {
+		return ExperienceDto{}, e.handleWriteError(ctx, id, "failed to update experience", err)
+	}
+	after := toExperienceDto(updated)
+	if err := e.saveRevision(ctx, after); err != nil {
+		return ExperienceDto{}, err
+	}
+	if err := e.audit.record(ctx, AuditActionUpdate, AuditEntityExperience, id, before, after); err != nil {
+		e.logger.ErrorContext(ctx, "failed to record audit log", slog.Any("error", err))
+		return ExperienceDto{}, err
+	}
+	return after, nil
+}
+
+// Delete implements ExperienceUsecase.
+func (e *experienceUsecase) Delete(ctx context.Context, id int, version int) (err error) {
+	ctx, span := tracer.Start(ctx, "ExperienceUsecase.Delete")
+	defer func() { endSpan(span, err) }()
+
+	err = e.transactionManager.Do(ctx, func(ctx context.Context) error {
+		current, err := e.findMyExperience(ctx, id)
+		if err != nil {
+			return err
+		}
+		if current.Version != version {
+			return &ExperienceConflictError{Current: toExperienceDto(current)}
+		}
+		if err := e.experienceRepository.Delete(ctx, id, version); err != nil {
+			return e.handleWriteError(ctx, id, "failed to delete experience", err)
+		}
+		if err := e.audit.record(ctx, AuditActionDelete, AuditEntityExperience, id, toExperienceDto(current), nil); err != nil {
+			e.logger.ErrorContext(ctx, "failed to record audit log", slog.Any("error", err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	e.logger.InfoContext(ctx, "experience deleted", slog.Int("experience_id", id))
+	return nil
+}
+
+// Restore implements ExperienceUsecase.
+func (e *experienceUsecase) Restore(ctx context.Context, id int) (_ ExperienceDto, err error) {
+	ctx, span := tracer.Start(ctx, "ExperienceUsecase.Restore")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return ExperienceDto{}, ErrUnauthenticated
+	}
+	var after ExperienceDto
+	err = e.transactionManager.Do(ctx, func(ctx context.Context) error {
+		restored, err := e.experienceRepository.Restore(ctx, id, userID)
+		if err != nil {
+			return err
+		}
+		after = toExperienceDto(restored)
+		// 復元した内容もリビジョンとして残し、履歴の最新と現在の内容を一致させる
+		if err := e.saveRevision(ctx, after); err != nil {
+			return err
+		}
+		if err := e.audit.record(ctx, AuditActionRestore, AuditEntityExperience, id, nil, after); err != nil {
+			e.logger.ErrorContext(ctx, "failed to record audit log", slog.Any("error", err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return ExperienceDto{}, err
+	}
+	e.logger.InfoContext(ctx, "experience restored", slog.Int("experience_id", id))
+	return after, nil
+}
+
+// ListRevisions implements ExperienceUsecase.
+func (e *experienceUsecase) ListRevisions(ctx context.Context, id int) (_ []ExperienceRevisionDto, err error) {
+	ctx, span := tracer.Start(ctx, "ExperienceUsecase.ListRevisions")
+	defer func() { endSpan(span, err) }()
+
+	if _, err := e.findMyExperience(ctx, id); err != nil {
+		return nil, err
+	}
+	revisions, err := e.experienceRevisionRepository.FindByExperienceID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	revisionDtos := make([]ExperienceRevisionDto, len(revisions))
+	for i, revision := range revisions {
+		revisionDtos[i], err = toExperienceRevisionDto(revision)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return revisionDtos, nil
+}
+
+// DiffRevisions implements ExperienceUsecase.
+func (e *experienceUsecase) DiffRevisions(ctx context.Context, id int, from int, to int) (_ []FieldDiffDto, err error) {
+	ctx, span := tracer.Start(ctx, "ExperienceUsecase.DiffRevisions")
+	defer func() { endSpan(span, err) }()
+
+	if _, err := e.findMyExperience(ctx, id); err != nil {
+		return nil, err
+	}
+	fromRevision, err := e.experienceRevisionRepository.FindByRevision(ctx, id, from)
+	if err != nil {
+		return nil, err
+	}
+	toRevision, err := e.experienceRevisionRepository.FindByRevision(ctx, id, to)
+	if err != nil {
+		return nil, err
+	}
+	return diffSnapshots(fromRevision.Snapshot, toRevision.Snapshot)
+}
+
+// RestoreRevision implements ExperienceUsecase.
+// 指定したリビジョンの内容で更新するため、復元自体も新しいリビジョンとして記録されます
+func (e *experienceUsecase) RestoreRevision(ctx context.Context, id int, revision int, version int) (_ ExperienceDto, err error) {
+	ctx, span := tracer.Start(ctx, "ExperienceUsecase.RestoreRevision")
+	defer func() { endSpan(span, err) }()
+
+	if _, err := e.findMyExperience(ctx, id); err != nil {
+		return ExperienceDto{}, err
+	}
+	experienceRevision, err := e.experienceRevisionRepository.FindByRevision(ctx, id, revision)
+	if err != nil {
+		return ExperienceDto{}, err
+	}
+	var snapshot ExperienceDto
+	if err := json.Unmarshal(experienceRevision.Snapshot, &snapshot); err != nil {
+		return ExperienceDto{}, fmt.Errorf("failed to unmarshal revision snapshot: %w", err)
+	}
+
+	var updated ExperienceDto
+	err = e.transactionManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		updated, err = e.update(ctx, id, version, ExperienceInput{
+			Title:       snapshot.Title,
+			ClientName:  snapshot.ClientName,
+			ClientAlias: snapshot.ClientAlias,
+			Industry:    snapshot.Industry,
+			StartMonth:  snapshot.StartMonth,
+			EndMonth:    snapshot.EndMonth,
+			TeamSize:    snapshot.TeamSize,
+			Skills:      snapshot.Skills,
+			Phases:      snapshot.Phases,
+		})
+		return err
+	})
+	if err != nil {
+		return ExperienceDto{}, err
+	}
+	e.logger.InfoContext(ctx, "experience revision restored", slog.Int("experience_id", id), slog.Int("revision", revision))
+	return updated, nil
+}
+
+// findMyExperience はログイン中のユーザーが登録した業務経歴を返します
+// 他のユーザーの業務経歴は、マネージャーが閲覧できるメンバーの業務経歴も含めて ErrNotFound にします
+func (e *experienceUsecase) findMyExperience(ctx context.Context, id int) (model.Experience, error) {
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return model.Experience{}, ErrUnauthenticated
+	}
+	experience, err := e.experienceRepository.FindByID(ctx, id)
+	if err != nil {
+		return model.Experience{}, err
+	}
+	if experience.UserID != userID {
+		return model.Experience{}, ErrNotFound
+	}
+	return experience, nil
+}
+
+// handleWriteError は更新・削除のエラーを処理します
+// 確認後に他の更新が割り込んでバージョンが一致しなかった場合は、現在の内容を取得して返します
+func (e *experienceUsecase) handleWriteError(ctx context.Context, id int, msg string, err error) error {
+	if !errors.Is(err, ErrConflict) {
+		e.logger.ErrorContext(ctx, msg, slog.Any("error", err))
+		return err
+	}
+	current, findErr := e.experienceRepository.FindByID(ctx, id)
+	if findErr != nil {
+		return findErr
+	}
+	return &ExperienceConflictError{Current: toExperienceDto(current)}
+}
+
+// saveRevision は現在の内容を新しいリビジョンとして保存します
+func (e *experienceUsecase) saveRevision(ctx context.Context, experience ExperienceDto) error {
+	snapshot, err := json.Marshal(experience)
+	if err != nil {
+		return fmt.Errorf("failed to marshal revision snapshot: %w", err)
+	}
+	if _, err := e.experienceRevisionRepository.Create(ctx, model.ExperienceRevision{
+		ExperienceID: experience.ID,
+		Snapshot:     snapshot,
+		ActorID:      ActorFromContext(ctx).UserID,
+		CreatedAt:    time.Now(),
+	}); err != nil {
+		e.logger.ErrorContext(ctx, "failed to save experience revision", slog.Any("error", err))
+		return err
+	}
+	return nil
+}
+
+func toExperienceDto(experience model.Experience) ExperienceDto {
+	dto := ExperienceDto{
+		ID:          experience.ID,
+		Title:       experience.Title.String(),
+		ClientName:  experience.Client.Name(),
+		ClientAlias: experience.Client.Alias(),
+		Industry:    experience.Industry.String(),
+		TeamSize:    experience.TeamSize.Int(),
+		Version:     experience.Version,
+	}
+	if !experience.Period.IsZero() {
+		start := experience.Period.Start()
+		dto.StartMonth = &start
+		if end, ok := experience.Period.End(); ok {
+			dto.EndMonth = &end
+		}
+	}
+	for _, skill := range experience.Skills {
+		dto.Skills = append(dto.Skills, SkillDto{Category: string(skill.Category), Name: skill.Name})
+	}
+	for _, phase := range experience.Phases {
+		dto.Phases = append(dto.Phases, string(phase))
+	}
+	return dto
+}
+
+func toExperienceRevisionDto(revision model.ExperienceRevision) (ExperienceRevisionDto, error) {
+	var snapshot ExperienceDto
+	if err := json.Unmarshal(revision.Snapshot, &snapshot); err != nil {
+		return ExperienceRevisionDto{}, fmt.Errorf("failed to unmarshal revision snapshot: %w", err)
+	}
+	return ExperienceRevisionDto{
+		Revision:  revision.Revision,
+		Snapshot:  snapshot,
+		ActorID:   revision.ActorID,
+		CreatedAt: revision.CreatedAt,
+	}, nil
+}
+
+// diffSnapshots は2つのスナップショットで値が異なるフィールドをフィールド名順に返します
+func diffSnapshots(from, to json.RawMessage) ([]FieldDiffDto, error) {
+	var fromFields, toFields map[string]interface{}
+	if err := json.Unmarshal(from, &fromFields); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal revision snapshot: %w", err)
+	}
+	if err := json.Unmarshal(to, &toFields); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal revision snapshot: %w", err)
+	}
+
+	fields := make(map[string]struct{})
+	for field := range fromFields {
+		fields[field] = struct{}{}
+	}
+	for field := range toFields {
+		fields[field] = struct{}{}
+	}
+	names := make([]string, 0, len(fields))
+	for field := range fields {
+		names = append(names, field)
+	}
+	sort.Strings(names)
+
+	diffs := []FieldDiffDto{}
+	for _, field := range names {
+		if !reflect.DeepEqual(fromFields[field], toFields[field]) {
+			diffs = append(diffs, FieldDiffDto{Field: field, From: fromFields[field], To: toFields[field]})
+		}
+	}
+	return diffs, nil
+}
+
 type ExperienceUsecase interface {
-	Create(title string) error
-	GetAll() ([]ExperienceDto, error)
+	// Create は業務経歴を登録し、登録した内容（ID・バージョンを含む）を返します
+	Create(ctx context.Context, input ExperienceInput) (ExperienceDto, error)
+	GetAll(ctx context.Context) ([]ExperienceDto, error)
+	Get(ctx context.Context, id int) (ExperienceDto, error)
+	// Update は version が現在のバージョンと一致する場合のみ更新します
+	// 一致しない場合は現在の内容を持つ *ExperienceConflictError を返します
+	Update(ctx context.Context, id int, version int, input ExperienceInput) (ExperienceDto, error)
+	// Delete は version が現在のバージョンと一致する場合のみ論理削除します
+	Delete(ctx context.Context, id int, version int) error
+	Restore(ctx context.Context, id int) (ExperienceDto, error)
+	ListRevisions(ctx context.Context, id int) ([]ExperienceRevisionDto, error)
+	DiffRevisions(ctx context.Context, id int, from int, to int) ([]FieldDiffDto, error)
+	RestoreRevision(ctx context.Context, id int, revision int, version int) (ExperienceDto, error)
+	// Import はログイン中のユーザーの業務経歴として一括で登録します
+	// 技術の名前は言語・ツールのカタログに対応付け、対応付けられなかった名前は結果で返します
+	// 不正な業務経歴がある場合は全てのエラーを持つ *ImportValidationError を返します
+	// dryRun の場合は検証と対応付けだけを行い、登録しません
+	Import(ctx context.Context, inputs []ExperienceImportInput, dryRun bool) (ImportResultDto, error)
 }
 
-func NewExperienceUsecase(experienceRepository repository.ExperienceRepository) ExperienceUsecase {
-	return &experienceUsecase{experienceRepository: experienceRepository}
+func NewExperienceUsecase(
+	experienceRepository repository.ExperienceRepository,
+	experienceRevisionRepository repository.ExperienceRevisionRepository,
+	catalogRepository repository.CatalogRepository,
+	auditLogRepository repository.AuditLogRepository,
+	transactionManager repository.TransactionManager,
+	logger *slog.Logger,
+) ExperienceUsecase {
+	return &experienceUsecase{
+		experienceRepository:         experienceRepository,
+		experienceRevisionRepository: experienceRevisionRepository,
+		catalogRepository:            catalogRepository,
+		transactionManager:           transactionManager,
+		audit:                        auditRecorder{auditLogRepository: auditLogRepository},
+		logger:                       logger.With(slog.String("usecase", "experience")),
+	}
 }
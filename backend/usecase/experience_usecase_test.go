@@ -1,7 +1,12 @@
 package usecase_test
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
+	"io"
+	"log/slog"
+	"strings"
 	"testing"
 
 	"stackies/backend/domain/model"
@@ -10,31 +15,96 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
+var discardLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// newExperience はテスト用の業務経歴エンティティを作成します
+func newExperience(t *testing.T, id int, title string, version int) model.Experience {
+	t.Helper()
+	experienceTitle, err := model.NewExperienceTitle(title)
+	require.NoError(t, err)
+	return model.Experience{ID: id, Title: experienceTitle, Version: version}
+}
+
+// ownedBy は業務経歴を登録したユーザーを設定します
+func ownedBy(experience model.Experience, userID string) model.Experience {
+	experience.UserID = userID
+	return experience
+}
+
+// newTransactionManager は fn をそのまま実行するトランザクション管理のモックを作成します
+func newTransactionManager(ctrl *gomock.Controller) *mock.MockTransactionManager {
+	m := mock.NewMockTransactionManager(ctrl)
+	m.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}).AnyTimes()
+	return m
+}
+
 func TestExperienceUsecase_Create(t *testing.T) {
 	tests := []struct {
 		name      string
 		title     string
-		setupMock func(*mock.MockExperienceRepository)
+		setupMock func(*mock.MockExperienceRepository, *mock.MockExperienceRevisionRepository, *mock.MockAuditLogRepository)
+		want      usecase.ExperienceDto
 		wantErr   error
 	}{
 		{
-			name:  "正常系: 体験作成に成功",
+			name:  "正常系: 体験作成に成功し、リビジョン1と監査ログを記録して作成した内容を返す",
 			title: "テスト体験",
-			setupMock: func(m *mock.MockExperienceRepository) {
-				m.EXPECT().Create(model.Experience{Title: "テスト体験"}).Return(nil)
+			setupMock: func(m *mock.MockExperienceRepository, r *mock.MockExperienceRevisionRepository, a *mock.MockAuditLogRepository) {
+				m.EXPECT().Create(gomock.Any(), ownedBy(newExperience(t, 0, "テスト体験", 0), "user-1")).Return(newExperience(t, 1, "テスト体験", 1), nil)
+				r.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, revision model.ExperienceRevision) (model.ExperienceRevision, error) {
+					assert.Equal(t, 1, revision.ExperienceID)
+					assert.Equal(t, "user-1", revision.ActorID)
+					assert.JSONEq(t, `{"id":1,"title":"テスト体験"}`, string(revision.Snapshot))
+					revision.Revision = 1
+					return revision, nil
+				})
+				a.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, auditLog model.AuditLog) error {
+					assert.Equal(t, "user-1", auditLog.ActorID)
+					assert.Equal(t, "req-1", auditLog.RequestID)
+					assert.Equal(t, usecase.AuditActionCreate, auditLog.Action)
+					assert.Equal(t, usecase.AuditEntityExperience, auditLog.EntityType)
+					assert.Equal(t, "1", auditLog.EntityID)
+					assert.Nil(t, auditLog.Before)
+					assert.JSONEq(t, `{"id":1,"title":"テスト体験"}`, string(auditLog.After))
+					return nil
+				})
 			},
-			wantErr: nil,
+			want: usecase.ExperienceDto{ID: 1, Title: "テスト体験", Version: 1},
+		},
+		{
+			name:  "異常系: タイトルが空",
+			title: " ",
+			setupMock: func(m *mock.MockExperienceRepository, r *mock.MockExperienceRevisionRepository, a *mock.MockAuditLogRepository) {
+			},
+			wantErr: usecase.ErrInvalidInput,
 		},
 		{
 			name:  "異常系: repository.Createがエラーを返す",
 			title: "テスト体験",
-			setupMock: func(m *mock.MockExperienceRepository) {
-				m.EXPECT().Create(model.Experience{Title: "テスト体験"}).Return(errors.New("DB error"))
+			setupMock: func(m *mock.MockExperienceRepository, r *mock.MockExperienceRevisionRepository, a *mock.MockAuditLogRepository) {
+				m.EXPECT().Create(gomock.Any(), ownedBy(newExperience(t, 0, "テスト体験", 0), "user-1")).Return(model.Experience{}, errors.New("DB error"))
 			},
 			wantErr: errors.New("DB error"),
 		},
+		{
+			name:  "異常系: 監査ログの記録に失敗",
+			title: "テスト体験",
+			setupMock: func(m *mock.MockExperienceRepository, r *mock.MockExperienceRevisionRepository, a *mock.MockAuditLogRepository) {
+				m.EXPECT().Create(gomock.Any(), ownedBy(newExperience(t, 0, "テスト体験", 0), "user-1")).Return(newExperience(t, 1, "テスト体験", 0), nil)
+				r.EXPECT().Create(gomock.Any(), gomock.Any()).Return(model.ExperienceRevision{Revision: 1}, nil)
+				a.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("audit error"))
+			},
+			wantErr: errors.New("audit error"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -43,20 +113,299 @@ func TestExperienceUsecase_Create(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockRepo := mock.NewMockExperienceRepository(ctrl)
-			tt.setupMock(mockRepo)
+			mockRevisionRepo := mock.NewMockExperienceRevisionRepository(ctrl)
+			mockAuditRepo := mock.NewMockAuditLogRepository(ctrl)
+			tt.setupMock(mockRepo, mockRevisionRepo, mockAuditRepo)
 
-			uc := usecase.NewExperienceUsecase(mockRepo)
-			err := uc.Create(tt.title)
+			ctx := usecase.ContextWithUserID(context.Background(), "user-1")
+			ctx = usecase.ContextWithRequestID(ctx, "req-1")
 
-			if tt.wantErr != nil {
+			uc := usecase.NewExperienceUsecase(mockRepo, mockRevisionRepo, mock.NewMockCatalogRepository(ctrl), mockAuditRepo, newTransactionManager(ctrl), discardLogger)
+			got, err := uc.Create(ctx, usecase.ExperienceInput{Title: tt.title})
+
+			if errors.Is(tt.wantErr, usecase.ErrInvalidInput) {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else if tt.wantErr != nil {
 				assert.EqualError(t, err, tt.wantErr.Error())
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+// txContextKey はトランザクション内のコンテキストであることを示すテスト用のキーです
+type txContextKey struct{}
+
+// inTransaction はトランザクション内のコンテキストに一致する gomock.Matcher です
+type inTransaction struct{}
+
+func (inTransaction) Matches(x interface{}) bool {
+	ctx, ok := x.(context.Context)
+	return ok && ctx.Value(txContextKey{}) != nil
+}
+
+func (inTransaction) String() string {
+	return "is context in transaction"
+}
+
+func TestExperienceUsecase_Create_Skills(t *testing.T) {
+	tests := []struct {
+		name    string
+		skills  []usecase.SkillDto
+		want    []model.Skill
+		wantErr error
+	}{
+		{
+			name:   "正常系: スキルを登録順に保存し、スナップショットに含める",
+			skills: []usecase.SkillDto{{Category: "language", Name: " Go "}, {Category: "tool", Name: "AWS"}},
+			want: []model.Skill{
+				{Category: model.SkillCategoryLanguage, Name: "Go"},
+				{Category: model.SkillCategoryTool, Name: "AWS"},
+			},
+		},
+		{
+			name:    "異常系: スキルの区分が不正",
+			skills:  []usecase.SkillDto{{Category: "framework", Name: "Echo"}},
+			wantErr: usecase.ErrInvalidInput,
+		},
+		{
+			name:    "異常系: スキルが重複している",
+			skills:  []usecase.SkillDto{{Category: "language", Name: "Go"}, {Category: "language", Name: "go"}},
+			wantErr: usecase.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock.NewMockExperienceRepository(ctrl)
+			mockRevisionRepo := mock.NewMockExperienceRevisionRepository(ctrl)
+			mockAuditRepo := mock.NewMockAuditLogRepository(ctrl)
+			if tt.wantErr == nil {
+				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, experience model.Experience) (model.Experience, error) {
+					assert.Equal(t, tt.want, experience.Skills)
+					experience.ID = 1
+					return experience, nil
+				})
+				mockRevisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, revision model.ExperienceRevision) (model.ExperienceRevision, error) {
+					assert.JSONEq(t, `{"id":1,"title":"テスト体験","skills":[{"category":"language","name":"Go"},{"category":"tool","name":"AWS"}]}`, string(revision.Snapshot))
+					return revision, nil
+				})
+				mockAuditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			uc := usecase.NewExperienceUsecase(mockRepo, mockRevisionRepo, mock.NewMockCatalogRepository(ctrl), mockAuditRepo, newTransactionManager(ctrl), discardLogger)
+			_, err := uc.Create(context.Background(), usecase.ExperienceInput{Title: "テスト体験", Skills: tt.skills})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestExperienceUsecase_Create_Phases(t *testing.T) {
+	tests := []struct {
+		name    string
+		phases  []string
+		want    []model.Phase
+		wantErr error
+	}{
+		{
+			name:   "正常系: 担当工程を上流から順に並べ替えて保存する",
+			phases: []string{"testing", "basic_design", "testing"},
+			want:   []model.Phase{model.PhaseBasicDesign, model.PhaseTesting},
+		},
+		{
+			name:    "異常系: 担当工程が不正",
+			phases:  []string{"coding"},
+			wantErr: usecase.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock.NewMockExperienceRepository(ctrl)
+			mockRevisionRepo := mock.NewMockExperienceRevisionRepository(ctrl)
+			mockAuditRepo := mock.NewMockAuditLogRepository(ctrl)
+			if tt.wantErr == nil {
+				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, experience model.Experience) (model.Experience, error) {
+					assert.Equal(t, tt.want, experience.Phases)
+					experience.ID = 1
+					return experience, nil
+				})
+				mockRevisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(model.ExperienceRevision{Revision: 1}, nil)
+				mockAuditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
 			}
+
+			uc := usecase.NewExperienceUsecase(mockRepo, mockRevisionRepo, mock.NewMockCatalogRepository(ctrl), mockAuditRepo, newTransactionManager(ctrl), discardLogger)
+			_, err := uc.Create(context.Background(), usecase.ExperienceInput{Title: "テスト体験", Phases: tt.phases})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
 		})
 	}
 }
 
+func TestExperienceUsecase_Import(t *testing.T) {
+	catalog := []model.CatalogItem{
+		{ID: 1, Category: model.SkillCategoryLanguage, Name: "Go", Aliases: []string{"golang"}},
+		{ID: 1, Category: model.SkillCategoryTool, Name: "AWS"},
+	}
+
+	tests := []struct {
+		name          string
+		inputs        []usecase.ExperienceImportInput
+		dryRun        bool
+		setupMock     func(m *mock.MockExperienceRepository, r *mock.MockExperienceRevisionRepository, c *mock.MockCatalogRepository, a *mock.MockAuditLogRepository)
+		wantIDs       []int
+		wantSkills    [][]model.Skill
+		wantUnmatched []usecase.UnmatchedTechnologyDto
+		wantInvalid   []int
+		wantErr       error
+	}{
+		{
+			name: "正常系: 技術をカタログに対応付けて登録し、対応付けられなかった名前を返す",
+			inputs: []usecase.ExperienceImportInput{
+				{Title: "決済基盤の開発", Technologies: []string{"golang", "Go", "aws", "gRPC"}},
+				{Title: "社内ツールの保守", Technologies: []string{"VBA"}},
+			},
+			setupMock: func(m *mock.MockExperienceRepository, r *mock.MockExperienceRevisionRepository, c *mock.MockCatalogRepository, a *mock.MockAuditLogRepository) {
+				c.EXPECT().GetAll(gomock.Any()).Return(catalog, nil)
+				id := 0
+				m.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, experience model.Experience) (model.Experience, error) {
+					assert.Equal(t, "user-1", experience.UserID)
+					id++
+					experience.ID = id
+					return experience, nil
+				}).Times(2)
+				r.EXPECT().Create(gomock.Any(), gomock.Any()).Return(model.ExperienceRevision{Revision: 1}, nil).Times(2)
+				a.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+			},
+			wantIDs: []int{1, 2},
+			wantSkills: [][]model.Skill{
+				{{Category: model.SkillCategoryLanguage, Name: "Go"}, {Category: model.SkillCategoryTool, Name: "AWS"}},
+				nil,
+			},
+			wantUnmatched: []usecase.UnmatchedTechnologyDto{{Index: 0, Name: "gRPC"}, {Index: 1, Name: "VBA"}},
+		},
+		{
+			name: "正常系: dry run では検証と対応付けだけを行い、登録しない",
+			inputs: []usecase.ExperienceImportInput{
+				{Title: "決済基盤の開発", Technologies: []string{"golang"}},
+			},
+			dryRun: true,
+			setupMock: func(m *mock.MockExperienceRepository, r *mock.MockExperienceRevisionRepository, c *mock.MockCatalogRepository, a *mock.MockAuditLogRepository) {
+				c.EXPECT().GetAll(gomock.Any()).Return(catalog, nil)
+			},
+			wantIDs:       []int{0},
+			wantSkills:    [][]model.Skill{{{Category: model.SkillCategoryLanguage, Name: "Go"}}},
+			wantUnmatched: []usecase.UnmatchedTechnologyDto{},
+		},
+		{
+			name: "異常系: 不正な業務経歴が含まれる場合は全てのエラーを返し、1件も登録しない",
+			inputs: []usecase.ExperienceImportInput{
+				{Title: " "},
+				{Title: "決済基盤の開発"},
+				{Title: "社内ツールの保守", TeamSize: -1},
+			},
+			setupMock: func(m *mock.MockExperienceRepository, r *mock.MockExperienceRevisionRepository, c *mock.MockCatalogRepository, a *mock.MockAuditLogRepository) {
+				c.EXPECT().GetAll(gomock.Any()).Return(catalog, nil)
+			},
+			wantInvalid: []int{0, 2},
+			wantErr:     usecase.ErrInvalidInput,
+		},
+		{
+			name:   "異常系: 取り込む業務経歴がない",
+			inputs: nil,
+			setupMock: func(m *mock.MockExperienceRepository, r *mock.MockExperienceRevisionRepository, c *mock.MockCatalogRepository, a *mock.MockAuditLogRepository) {
+			},
+			wantErr: usecase.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock.NewMockExperienceRepository(ctrl)
+			mockRevisionRepo := mock.NewMockExperienceRevisionRepository(ctrl)
+			mockCatalogRepo := mock.NewMockCatalogRepository(ctrl)
+			mockAuditRepo := mock.NewMockAuditLogRepository(ctrl)
+			tt.setupMock(mockRepo, mockRevisionRepo, mockCatalogRepo, mockAuditRepo)
+
+			ctx := usecase.ContextWithUserID(context.Background(), "user-1")
+			uc := usecase.NewExperienceUsecase(mockRepo, mockRevisionRepo, mockCatalogRepo, mockAuditRepo, newTransactionManager(ctrl), discardLogger)
+			got, err := uc.Import(ctx, tt.inputs, tt.dryRun)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				if tt.wantInvalid != nil {
+					var validation *usecase.ImportValidationError
+					require.ErrorAs(t, err, &validation)
+					var indexes []int
+					for _, rowErr := range validation.Errors {
+						indexes = append(indexes, rowErr.Index)
+						assert.NotEmpty(t, rowErr.Message)
+					}
+					assert.Equal(t, tt.wantInvalid, indexes)
+				}
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, got.Created, len(tt.wantSkills))
+			for i, want := range tt.wantSkills {
+				assert.Equal(t, tt.wantIDs[i], got.Created[i].ID)
+				var skills []model.Skill
+				for _, s := range got.Created[i].Skills {
+					skills = append(skills, model.Skill{Category: model.SkillCategory(s.Category), Name: s.Name})
+				}
+				assert.Equal(t, want, skills)
+			}
+			assert.Equal(t, tt.wantUnmatched, got.Unmatched)
+		})
+	}
+}
+
+func TestExperienceUsecase_Create_Transaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockExperienceRepository(ctrl)
+	mockRevisionRepo := mock.NewMockExperienceRevisionRepository(ctrl)
+	mockAuditRepo := mock.NewMockAuditLogRepository(ctrl)
+	mockTx := mock.NewMockTransactionManager(ctrl)
+
+	// トランザクションのコンテキストで全てのリポジトリを呼び出すこと
+	inTx := inTransaction{}
+	rollback := errors.New("audit error")
+	mockTx.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(context.WithValue(ctx, txContextKey{}, true))
+	})
+	mockRepo.EXPECT().Create(inTx, gomock.Any()).Return(newExperience(t, 1, "テスト体験", 0), nil)
+	mockRevisionRepo.EXPECT().Create(inTx, gomock.Any()).Return(model.ExperienceRevision{Revision: 1}, nil)
+	mockAuditRepo.EXPECT().Create(inTx, gomock.Any()).Return(rollback)
+
+	uc := usecase.NewExperienceUsecase(mockRepo, mockRevisionRepo, mock.NewMockCatalogRepository(ctrl), mockAuditRepo, mockTx, discardLogger)
+	_, err := uc.Create(context.Background(), usecase.ExperienceInput{Title: "テスト体験"})
+
+	// 監査ログの記録に失敗した場合はトランザクションにエラーを返してロールバックさせること
+	assert.ErrorIs(t, err, rollback)
+}
+
 func TestExperienceUsecase_GetAll(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -65,11 +414,11 @@ func TestExperienceUsecase_GetAll(t *testing.T) {
 		wantErr   error
 	}{
 		{
-			name: "正常系: 体験一覧を取得",
+			name: "正常系: ログイン中のユーザーの体験一覧を取得",
 			setupMock: func(m *mock.MockExperienceRepository) {
-				m.EXPECT().GetAll().Return([]model.Experience{
-					{ID: 1, Title: "体験1"},
-					{ID: 2, Title: "体験2"},
+				m.EXPECT().FindByUserID(gomock.Any(), "user-1").Return([]model.Experience{
+					ownedBy(newExperience(t, 1, "体験1", 0), "user-1"),
+					ownedBy(newExperience(t, 2, "体験2", 0), "user-1"),
 				}, nil)
 			},
 			want: []usecase.ExperienceDto{
@@ -81,15 +430,15 @@ func TestExperienceUsecase_GetAll(t *testing.T) {
 		{
 			name: "正常系: 体験が0件",
 			setupMock: func(m *mock.MockExperienceRepository) {
-				m.EXPECT().GetAll().Return([]model.Experience{}, nil)
+				m.EXPECT().FindByUserID(gomock.Any(), "user-1").Return([]model.Experience{}, nil)
 			},
 			want:    []usecase.ExperienceDto{},
 			wantErr: nil,
 		},
 		{
-			name: "異常系: repository.GetAllがエラーを返す",
+			name: "異常系: repository.FindByUserIDがエラーを返す",
 			setupMock: func(m *mock.MockExperienceRepository) {
-				m.EXPECT().GetAll().Return(nil, errors.New("DB error"))
+				m.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(nil, errors.New("DB error"))
 			},
 			want:    nil,
 			wantErr: errors.New("DB error"),
@@ -104,8 +453,8 @@ func TestExperienceUsecase_GetAll(t *testing.T) {
 			mockRepo := mock.NewMockExperienceRepository(ctrl)
 			tt.setupMock(mockRepo)
 
-			uc := usecase.NewExperienceUsecase(mockRepo)
-			got, err := uc.GetAll()
+			uc := usecase.NewExperienceUsecase(mockRepo, mock.NewMockExperienceRevisionRepository(ctrl), mock.NewMockCatalogRepository(ctrl), mock.NewMockAuditLogRepository(ctrl), newTransactionManager(ctrl), discardLogger)
+			got, err := uc.GetAll(usecase.ContextWithUserID(context.Background(), "user-1"))
 
 			if tt.wantErr != nil {
 				assert.EqualError(t, err, tt.wantErr.Error())
@@ -117,3 +466,386 @@ func TestExperienceUsecase_GetAll(t *testing.T) {
 		})
 	}
 }
+
+func TestExperienceUsecase_Update(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        int
+		version   int
+		title     string
+		setupMock func(*mock.MockExperienceRepository, *mock.MockExperienceRevisionRepository, *mock.MockAuditLogRepository)
+		want      usecase.ExperienceDto
+		wantErr   error
+	}{
+		{
+			name:    "正常系: 更新内容を新しいリビジョンとして記録する",
+			id:      1,
+			version: 1,
+			title:   "更新後",
+			setupMock: func(m *mock.MockExperienceRepository, r *mock.MockExperienceRevisionRepository, a *mock.MockAuditLogRepository) {
+				m.EXPECT().FindByID(gomock.Any(), 1).Return(ownedBy(newExperience(t, 1, "更新前", 1), "user-1"), nil)
+				m.EXPECT().Update(gomock.Any(), ownedBy(newExperience(t, 1, "更新後", 1), "user-1")).Return(ownedBy(newExperience(t, 1, "更新後", 2), "user-1"), nil)
+				r.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, revision model.ExperienceRevision) (model.ExperienceRevision, error) {
+					assert.JSONEq(t, `{"id":1,"title":"更新後"}`, string(revision.Snapshot))
+					return revision, nil
+				})
+				a.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, auditLog model.AuditLog) error {
+					assert.Equal(t, usecase.AuditActionUpdate, auditLog.Action)
+					assert.JSONEq(t, `{"id":1,"title":"更新前"}`, string(auditLog.Before))
+					assert.JSONEq(t, `{"id":1,"title":"更新後"}`, string(auditLog.After))
+					return nil
+				})
+			},
+			want: usecase.ExperienceDto{ID: 1, Title: "更新後", Version: 2},
+		},
+		{
+			name:    "異常系: バージョンが一致しない",
+			id:      1,
+			version: 1,
+			title:   "更新後",
+			setupMock: func(m *mock.MockExperienceRepository, r *mock.MockExperienceRevisionRepository, a *mock.MockAuditLogRepository) {
+				m.EXPECT().FindByID(gomock.Any(), 1).Return(ownedBy(newExperience(t, 1, "他の人の更新", 2), "user-1"), nil)
+			},
+			wantErr: usecase.ErrConflict,
+		},
+		{
+			name:    "異常系: 確認後に他の更新が割り込んだ",
+			id:      1,
+			version: 1,
+			title:   "更新後",
+			setupMock: func(m *mock.MockExperienceRepository, r *mock.MockExperienceRevisionRepository, a *mock.MockAuditLogRepository) {
+				m.EXPECT().FindByID(gomock.Any(), 1).Return(ownedBy(newExperience(t, 1, "更新前", 1), "user-1"), nil)
+				m.EXPECT().Update(gomock.Any(), gomock.Any()).Return(model.Experience{}, usecase.ErrConflict)
+				m.EXPECT().FindByID(gomock.Any(), 1).Return(ownedBy(newExperience(t, 1, "他の人の更新", 2), "user-1"), nil)
+			},
+			wantErr: usecase.ErrConflict,
+		},
+		{
+			name:    "異常系: 業務経歴が存在しない",
+			id:      99,
+			version: 1,
+			title:   "更新後",
+			setupMock: func(m *mock.MockExperienceRepository, r *mock.MockExperienceRevisionRepository, a *mock.MockAuditLogRepository) {
+				m.EXPECT().FindByID(gomock.Any(), 99).Return(model.Experience{}, usecase.ErrNotFound)
+			},
+			wantErr: usecase.ErrNotFound,
+		},
+		{
+			name:    "異常系: タイトルが空",
+			id:      1,
+			version: 1,
+			title:   "",
+			setupMock: func(m *mock.MockExperienceRepository, r *mock.MockExperienceRevisionRepository, a *mock.MockAuditLogRepository) {
+			},
+			wantErr: usecase.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock.NewMockExperienceRepository(ctrl)
+			mockRevisionRepo := mock.NewMockExperienceRevisionRepository(ctrl)
+			mockAuditRepo := mock.NewMockAuditLogRepository(ctrl)
+			tt.setupMock(mockRepo, mockRevisionRepo, mockAuditRepo)
+
+			uc := usecase.NewExperienceUsecase(mockRepo, mockRevisionRepo, mock.NewMockCatalogRepository(ctrl), mockAuditRepo, newTransactionManager(ctrl), discardLogger)
+			got, err := uc.Update(usecase.ContextWithUserID(context.Background(), "user-1"), tt.id, tt.version, usecase.ExperienceInput{Title: tt.title})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				// バージョン不一致の場合は現在の内容を返すこと
+				var conflict *usecase.ExperienceConflictError
+				if errors.As(err, &conflict) {
+					assert.Equal(t, usecase.ExperienceDto{ID: 1, Title: "他の人の更新", Version: 2}, conflict.Current)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestExperienceUsecase_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockExperienceRepository(ctrl)
+	mockAuditRepo := mock.NewMockAuditLogRepository(ctrl)
+	mockRepo.EXPECT().FindByID(gomock.Any(), 1).Return(ownedBy(newExperience(t, 1, "体験1", 3), "user-1"), nil)
+	mockRepo.EXPECT().Delete(gomock.Any(), 1, 3).Return(nil)
+	mockAuditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, auditLog model.AuditLog) error {
+		assert.Equal(t, usecase.AuditActionDelete, auditLog.Action)
+		assert.JSONEq(t, `{"id":1,"title":"体験1"}`, string(auditLog.Before))
+		assert.Nil(t, auditLog.After)
+		return nil
+	})
+
+	uc := usecase.NewExperienceUsecase(mockRepo, mock.NewMockExperienceRevisionRepository(ctrl), mock.NewMockCatalogRepository(ctrl), mockAuditRepo, newTransactionManager(ctrl), discardLogger)
+	assert.NoError(t, uc.Delete(usecase.ContextWithUserID(context.Background(), "user-1"), 1, 3))
+}
+
+func TestExperienceUsecase_DiffRevisions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockExperienceRepository(ctrl)
+	mockRevisionRepo := mock.NewMockExperienceRevisionRepository(ctrl)
+	mockRepo.EXPECT().FindByID(gomock.Any(), 1).Return(ownedBy(newExperience(t, 1, "更新後", 2), "user-1"), nil)
+	mockRevisionRepo.EXPECT().FindByRevision(gomock.Any(), 1, 1).Return(model.ExperienceRevision{
+		Revision: 1,
+		Snapshot: json.RawMessage(`{"id":1,"title":"更新前"}`),
+	}, nil)
+	mockRevisionRepo.EXPECT().FindByRevision(gomock.Any(), 1, 2).Return(model.ExperienceRevision{
+		Revision: 2,
+		Snapshot: json.RawMessage(`{"id":1,"title":"更新後"}`),
+	}, nil)
+
+	uc := usecase.NewExperienceUsecase(mockRepo, mockRevisionRepo, mock.NewMockCatalogRepository(ctrl), mock.NewMockAuditLogRepository(ctrl), newTransactionManager(ctrl), discardLogger)
+	got, err := uc.DiffRevisions(usecase.ContextWithUserID(context.Background(), "user-1"), 1, 1, 2)
+
+	// 変更のあったフィールドのみ返すこと
+	assert.NoError(t, err)
+	assert.Equal(t, []usecase.FieldDiffDto{{Field: "title", From: "更新前", To: "更新後"}}, got)
+}
+
+func TestExperienceUsecase_RestoreRevision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockExperienceRepository(ctrl)
+	mockRevisionRepo := mock.NewMockExperienceRevisionRepository(ctrl)
+	mockAuditRepo := mock.NewMockAuditLogRepository(ctrl)
+	mockRevisionRepo.EXPECT().FindByRevision(gomock.Any(), 1, 1).Return(model.ExperienceRevision{
+		Revision: 1,
+		Snapshot: json.RawMessage(`{"id":1,"title":"更新前"}`),
+	}, nil)
+	mockRepo.EXPECT().FindByID(gomock.Any(), 1).Return(ownedBy(newExperience(t, 1, "更新後", 2), "user-1"), nil).Times(2)
+	mockRepo.EXPECT().Update(gomock.Any(), ownedBy(newExperience(t, 1, "更新前", 2), "user-1")).Return(ownedBy(newExperience(t, 1, "更新前", 3), "user-1"), nil)
+	// 復元も新しいリビジョンとして記録されること
+	mockRevisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(model.ExperienceRevision{Revision: 3}, nil)
+	mockAuditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	uc := usecase.NewExperienceUsecase(mockRepo, mockRevisionRepo, mock.NewMockCatalogRepository(ctrl), mockAuditRepo, newTransactionManager(ctrl), discardLogger)
+	got, err := uc.RestoreRevision(usecase.ContextWithUserID(context.Background(), "user-1"), 1, 1, 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, usecase.ExperienceDto{ID: 1, Title: "更新前", Version: 3}, got)
+}
+
+func TestExperienceUsecase_Restore(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupMock func(*mock.MockExperienceRepository, *mock.MockExperienceRevisionRepository, *mock.MockAuditLogRepository)
+		want      usecase.ExperienceDto
+		wantErr   error
+	}{
+		{
+			name: "正常系: 自分の業務経歴を復元し、リビジョンと監査ログを記録する",
+			setupMock: func(m *mock.MockExperienceRepository, r *mock.MockExperienceRevisionRepository, a *mock.MockAuditLogRepository) {
+				m.EXPECT().Restore(inTransaction{}, 1, "user-1").Return(ownedBy(newExperience(t, 1, "体験1", 3), "user-1"), nil)
+				r.EXPECT().Create(inTransaction{}, gomock.Any()).DoAndReturn(func(_ context.Context, revision model.ExperienceRevision) (model.ExperienceRevision, error) {
+					assert.Equal(t, 1, revision.ExperienceID)
+					assert.Equal(t, "user-1", revision.ActorID)
+					assert.JSONEq(t, `{"id":1,"title":"体験1"}`, string(revision.Snapshot))
+					return revision, nil
+				})
+				a.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, auditLog model.AuditLog) error {
+					assert.Equal(t, usecase.AuditActionRestore, auditLog.Action)
+					assert.Nil(t, auditLog.Before)
+					assert.JSONEq(t, `{"id":1,"title":"体験1"}`, string(auditLog.After))
+					return nil
+				})
+			},
+			want: usecase.ExperienceDto{ID: 1, Title: "体験1", Version: 3},
+		},
+		{
+			name: "異常系: 他のユーザーの業務経歴は復元できない",
+			setupMock: func(m *mock.MockExperienceRepository, r *mock.MockExperienceRevisionRepository, a *mock.MockAuditLogRepository) {
+				m.EXPECT().Restore(gomock.Any(), 1, "user-1").Return(model.Experience{}, usecase.ErrNotFound)
+			},
+			wantErr: usecase.ErrNotFound,
+		},
+		{
+			name: "異常系: リビジョンの保存に失敗した場合は監査ログを記録しない",
+			setupMock: func(m *mock.MockExperienceRepository, r *mock.MockExperienceRevisionRepository, a *mock.MockAuditLogRepository) {
+				m.EXPECT().Restore(gomock.Any(), 1, "user-1").Return(ownedBy(newExperience(t, 1, "体験1", 3), "user-1"), nil)
+				r.EXPECT().Create(gomock.Any(), gomock.Any()).Return(model.ExperienceRevision{}, errors.New("DB error"))
+			},
+			wantErr: errors.New("DB error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock.NewMockExperienceRepository(ctrl)
+			mockRevisionRepo := mock.NewMockExperienceRevisionRepository(ctrl)
+			mockAuditRepo := mock.NewMockAuditLogRepository(ctrl)
+			mockTx := mock.NewMockTransactionManager(ctrl)
+			mockTx.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(context.WithValue(ctx, txContextKey{}, true))
+			})
+			tt.setupMock(mockRepo, mockRevisionRepo, mockAuditRepo)
+
+			uc := usecase.NewExperienceUsecase(mockRepo, mockRevisionRepo, mock.NewMockCatalogRepository(ctrl), mockAuditRepo, mockTx, discardLogger)
+			got, err := uc.Restore(usecase.ContextWithUserID(context.Background(), "user-1"), 1)
+
+			if tt.wantErr != nil {
+				if errors.Is(tt.wantErr, usecase.ErrNotFound) {
+					assert.ErrorIs(t, err, tt.wantErr)
+				} else {
+					assert.EqualError(t, err, tt.wantErr.Error())
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExperienceUsecase_NotOwner(t *testing.T) {
+	// 他のユーザー（マネージャーが閲覧できるメンバーを含む）の業務経歴は存在しないものとして扱うこと
+	tests := []struct {
+		name string
+		call func(context.Context, usecase.ExperienceUsecase) error
+	}{
+		{
+			name: "異常系: 他のユーザーの業務経歴を取得できない",
+			call: func(ctx context.Context, uc usecase.ExperienceUsecase) error {
+				_, err := uc.Get(ctx, 1)
+				return err
+			},
+		},
+		{
+			name: "異常系: 他のユーザーの業務経歴を更新できない",
+			call: func(ctx context.Context, uc usecase.ExperienceUsecase) error {
+				_, err := uc.Update(ctx, 1, 1, usecase.ExperienceInput{Title: "上書き"})
+				return err
+			},
+		},
+		{
+			name: "異常系: 他のユーザーの業務経歴を削除できない",
+			call: func(ctx context.Context, uc usecase.ExperienceUsecase) error {
+				return uc.Delete(ctx, 1, 1)
+			},
+		},
+		{
+			name: "異常系: 他のユーザーの業務経歴のリビジョンを一覧できない",
+			call: func(ctx context.Context, uc usecase.ExperienceUsecase) error {
+				_, err := uc.ListRevisions(ctx, 1)
+				return err
+			},
+		},
+		{
+			name: "異常系: 他のユーザーの業務経歴のリビジョンを比較できない",
+			call: func(ctx context.Context, uc usecase.ExperienceUsecase) error {
+				_, err := uc.DiffRevisions(ctx, 1, 1, 2)
+				return err
+			},
+		},
+		{
+			name: "異常系: 他のユーザーの業務経歴をリビジョンの内容に戻せない",
+			call: func(ctx context.Context, uc usecase.ExperienceUsecase) error {
+				_, err := uc.RestoreRevision(ctx, 1, 1, 1)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// 業務経歴の読み込み以外のリポジトリは呼び出さないこと
+			mockRepo := mock.NewMockExperienceRepository(ctrl)
+			mockRepo.EXPECT().FindByID(gomock.Any(), 1).Return(ownedBy(newExperience(t, 1, "体験1", 1), "user-2"), nil)
+
+			uc := usecase.NewExperienceUsecase(mockRepo, mock.NewMockExperienceRevisionRepository(ctrl), mock.NewMockCatalogRepository(ctrl), mock.NewMockAuditLogRepository(ctrl), newTransactionManager(ctrl), discardLogger)
+			err := tt.call(usecase.ContextWithUserID(context.Background(), "user-1"), uc)
+
+			assert.ErrorIs(t, err, usecase.ErrNotFound)
+		})
+	}
+}
+
+func TestExperienceUsecase_Span(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockExperienceRepository(ctrl)
+	mockRepo.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(nil, errors.New("DB error"))
+
+	uc := usecase.NewExperienceUsecase(mockRepo, mock.NewMockExperienceRevisionRepository(ctrl), mock.NewMockCatalogRepository(ctrl), mock.NewMockAuditLogRepository(ctrl), newTransactionManager(ctrl), discardLogger)
+	_, err := uc.GetAll(usecase.ContextWithUserID(context.Background(), "user-1"))
+	assert.Error(t, err)
+
+	spans := recorder.Ended()
+	if assert.Len(t, spans, 1) {
+		assert.Equal(t, "ExperienceUsecase.GetAll", spans[0].Name())
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+		assert.Equal(t, "DB error", spans[0].Status().Description)
+	}
+}
+
+func TestExperienceUsecase_Create_Industry(t *testing.T) {
+	tests := []struct {
+		name     string
+		industry string
+		want     string
+		wantErr  error
+	}{
+		{
+			name:     "正常系: 業種の前後の空白を除いて保存し、スナップショットに含める",
+			industry: " 金融 ",
+			want:     "金融",
+		},
+		{
+			name:     "異常系: 業種が上限を超える",
+			industry: strings.Repeat("あ", 51),
+			wantErr:  usecase.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock.NewMockExperienceRepository(ctrl)
+			mockRevisionRepo := mock.NewMockExperienceRevisionRepository(ctrl)
+			mockAuditRepo := mock.NewMockAuditLogRepository(ctrl)
+			if tt.wantErr == nil {
+				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, experience model.Experience) (model.Experience, error) {
+					assert.Equal(t, tt.want, experience.Industry.String())
+					experience.ID = 1
+					return experience, nil
+				})
+				mockRevisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, revision model.ExperienceRevision) (model.ExperienceRevision, error) {
+					assert.JSONEq(t, `{"id":1,"title":"テスト体験","industry":"金融"}`, string(revision.Snapshot))
+					return revision, nil
+				})
+				mockAuditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			uc := usecase.NewExperienceUsecase(mockRepo, mockRevisionRepo, mock.NewMockCatalogRepository(ctrl), mockAuditRepo, newTransactionManager(ctrl), discardLogger)
+			_, err := uc.Create(context.Background(), usecase.ExperienceInput{Title: "テスト体験", Industry: tt.industry})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
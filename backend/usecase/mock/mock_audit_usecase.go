@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: audit_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	usecase "stackies/backend/usecase"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuditUsecase is a mock of AuditUsecase interface.
+type MockAuditUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditUsecaseMockRecorder
+}
+
+// MockAuditUsecaseMockRecorder is the mock recorder for MockAuditUsecase.
+type MockAuditUsecaseMockRecorder struct {
+	mock *MockAuditUsecase
+}
+
+// NewMockAuditUsecase creates a new mock instance.
+func NewMockAuditUsecase(ctrl *gomock.Controller) *MockAuditUsecase {
+	mock := &MockAuditUsecase{ctrl: ctrl}
+	mock.recorder = &MockAuditUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditUsecase) EXPECT() *MockAuditUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockAuditUsecase) Search(ctx context.Context, query usecase.AuditLogQuery) ([]usecase.AuditLogDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, query)
+	ret0, _ := ret[0].([]usecase.AuditLogDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockAuditUsecaseMockRecorder) Search(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockAuditUsecase)(nil).Search), ctx, query)
+}
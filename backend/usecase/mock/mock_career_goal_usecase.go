@@ -0,0 +1,154 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: career_goal_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	usecase "stackies/backend/usecase"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCareerGoalUsecase is a mock of CareerGoalUsecase interface.
+type MockCareerGoalUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCareerGoalUsecaseMockRecorder
+}
+
+// MockCareerGoalUsecaseMockRecorder is the mock recorder for MockCareerGoalUsecase.
+type MockCareerGoalUsecaseMockRecorder struct {
+	mock *MockCareerGoalUsecase
+}
+
+// NewMockCareerGoalUsecase creates a new mock instance.
+func NewMockCareerGoalUsecase(ctrl *gomock.Controller) *MockCareerGoalUsecase {
+	mock := &MockCareerGoalUsecase{ctrl: ctrl}
+	mock.recorder = &MockCareerGoalUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCareerGoalUsecase) EXPECT() *MockCareerGoalUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AddActivity mocks base method.
+func (m *MockCareerGoalUsecase) AddActivity(ctx context.Context, goalID int, input usecase.LearningActivityInput) (usecase.CareerGoalDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddActivity", ctx, goalID, input)
+	ret0, _ := ret[0].(usecase.CareerGoalDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddActivity indicates an expected call of AddActivity.
+func (mr *MockCareerGoalUsecaseMockRecorder) AddActivity(ctx, goalID, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActivity", reflect.TypeOf((*MockCareerGoalUsecase)(nil).AddActivity), ctx, goalID, input)
+}
+
+// CreateGoal mocks base method.
+func (m *MockCareerGoalUsecase) CreateGoal(ctx context.Context, input usecase.CareerGoalInput) (usecase.CareerGoalDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGoal", ctx, input)
+	ret0, _ := ret[0].(usecase.CareerGoalDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGoal indicates an expected call of CreateGoal.
+func (mr *MockCareerGoalUsecaseMockRecorder) CreateGoal(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGoal", reflect.TypeOf((*MockCareerGoalUsecase)(nil).CreateGoal), ctx, input)
+}
+
+// DeleteActivity mocks base method.
+func (m *MockCareerGoalUsecase) DeleteActivity(ctx context.Context, goalID, activityID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteActivity", ctx, goalID, activityID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteActivity indicates an expected call of DeleteActivity.
+func (mr *MockCareerGoalUsecaseMockRecorder) DeleteActivity(ctx, goalID, activityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteActivity", reflect.TypeOf((*MockCareerGoalUsecase)(nil).DeleteActivity), ctx, goalID, activityID)
+}
+
+// DeleteGoal mocks base method.
+func (m *MockCareerGoalUsecase) DeleteGoal(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGoal", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGoal indicates an expected call of DeleteGoal.
+func (mr *MockCareerGoalUsecaseMockRecorder) DeleteGoal(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGoal", reflect.TypeOf((*MockCareerGoalUsecase)(nil).DeleteGoal), ctx, id)
+}
+
+// ListMyGoals mocks base method.
+func (m *MockCareerGoalUsecase) ListMyGoals(ctx context.Context) ([]usecase.CareerGoalDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMyGoals", ctx)
+	ret0, _ := ret[0].([]usecase.CareerGoalDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMyGoals indicates an expected call of ListMyGoals.
+func (mr *MockCareerGoalUsecaseMockRecorder) ListMyGoals(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMyGoals", reflect.TypeOf((*MockCareerGoalUsecase)(nil).ListMyGoals), ctx)
+}
+
+// ListTeamGoals mocks base method.
+func (m *MockCareerGoalUsecase) ListTeamGoals(ctx context.Context, teamID int) ([]usecase.MemberCareerGoalsDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTeamGoals", ctx, teamID)
+	ret0, _ := ret[0].([]usecase.MemberCareerGoalsDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTeamGoals indicates an expected call of ListTeamGoals.
+func (mr *MockCareerGoalUsecaseMockRecorder) ListTeamGoals(ctx, teamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeamGoals", reflect.TypeOf((*MockCareerGoalUsecase)(nil).ListTeamGoals), ctx, teamID)
+}
+
+// UpdateActivity mocks base method.
+func (m *MockCareerGoalUsecase) UpdateActivity(ctx context.Context, goalID, activityID int, input usecase.LearningActivityInput) (usecase.CareerGoalDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActivity", ctx, goalID, activityID, input)
+	ret0, _ := ret[0].(usecase.CareerGoalDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActivity indicates an expected call of UpdateActivity.
+func (mr *MockCareerGoalUsecaseMockRecorder) UpdateActivity(ctx, goalID, activityID, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivity", reflect.TypeOf((*MockCareerGoalUsecase)(nil).UpdateActivity), ctx, goalID, activityID, input)
+}
+
+// UpdateGoal mocks base method.
+func (m *MockCareerGoalUsecase) UpdateGoal(ctx context.Context, id int, input usecase.CareerGoalInput) (usecase.CareerGoalDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGoal", ctx, id, input)
+	ret0, _ := ret[0].(usecase.CareerGoalDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGoal indicates an expected call of UpdateGoal.
+func (mr *MockCareerGoalUsecaseMockRecorder) UpdateGoal(ctx, id, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGoal", reflect.TypeOf((*MockCareerGoalUsecase)(nil).UpdateGoal), ctx, id, input)
+}
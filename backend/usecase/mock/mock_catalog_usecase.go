@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: catalog_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	usecase "stackies/backend/usecase"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCatalogUsecase is a mock of CatalogUsecase interface.
+type MockCatalogUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCatalogUsecaseMockRecorder
+}
+
+// MockCatalogUsecaseMockRecorder is the mock recorder for MockCatalogUsecase.
+type MockCatalogUsecaseMockRecorder struct {
+	mock *MockCatalogUsecase
+}
+
+// NewMockCatalogUsecase creates a new mock instance.
+func NewMockCatalogUsecase(ctrl *gomock.Controller) *MockCatalogUsecase {
+	mock := &MockCatalogUsecase{ctrl: ctrl}
+	mock.recorder = &MockCatalogUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCatalogUsecase) EXPECT() *MockCatalogUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCatalogUsecase) Create(ctx context.Context, input usecase.CatalogItemInput) (usecase.CatalogItemDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, input)
+	ret0, _ := ret[0].(usecase.CatalogItemDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCatalogUsecaseMockRecorder) Create(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCatalogUsecase)(nil).Create), ctx, input)
+}
+
+// List mocks base method.
+func (m *MockCatalogUsecase) List(ctx context.Context) ([]usecase.CatalogItemDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]usecase.CatalogItemDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockCatalogUsecaseMockRecorder) List(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCatalogUsecase)(nil).List), ctx)
+}
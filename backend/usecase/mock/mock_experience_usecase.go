@@ -5,6 +5,7 @@
 package mock
 
 import (
+	context "context"
 	reflect "reflect"
 	usecase "stackies/backend/usecase"
 
@@ -35,30 +36,150 @@ func (m *MockExperienceUsecase) EXPECT() *MockExperienceUsecaseMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockExperienceUsecase) Create(title string) error {
+func (m *MockExperienceUsecase) Create(ctx context.Context, input usecase.ExperienceInput) (usecase.ExperienceDto, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", title)
+	ret := m.ctrl.Call(m, "Create", ctx, input)
+	ret0, _ := ret[0].(usecase.ExperienceDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockExperienceUsecaseMockRecorder) Create(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockExperienceUsecase)(nil).Create), ctx, input)
+}
+
+// Delete mocks base method.
+func (m *MockExperienceUsecase) Delete(ctx context.Context, id, version int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Create indicates an expected call of Create.
-func (mr *MockExperienceUsecaseMockRecorder) Create(title interface{}) *gomock.Call {
+// Delete indicates an expected call of Delete.
+func (mr *MockExperienceUsecaseMockRecorder) Delete(ctx, id, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockExperienceUsecase)(nil).Create), title)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockExperienceUsecase)(nil).Delete), ctx, id, version)
+}
+
+// DiffRevisions mocks base method.
+func (m *MockExperienceUsecase) DiffRevisions(ctx context.Context, id, from, to int) ([]usecase.FieldDiffDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffRevisions", ctx, id, from, to)
+	ret0, _ := ret[0].([]usecase.FieldDiffDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffRevisions indicates an expected call of DiffRevisions.
+func (mr *MockExperienceUsecaseMockRecorder) DiffRevisions(ctx, id, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffRevisions", reflect.TypeOf((*MockExperienceUsecase)(nil).DiffRevisions), ctx, id, from, to)
+}
+
+// Get mocks base method.
+func (m *MockExperienceUsecase) Get(ctx context.Context, id int) (usecase.ExperienceDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(usecase.ExperienceDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockExperienceUsecaseMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockExperienceUsecase)(nil).Get), ctx, id)
 }
 
 // GetAll mocks base method.
-func (m *MockExperienceUsecase) GetAll() ([]usecase.ExperienceDto, error) {
+func (m *MockExperienceUsecase) GetAll(ctx context.Context) ([]usecase.ExperienceDto, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll")
+	ret := m.ctrl.Call(m, "GetAll", ctx)
 	ret0, _ := ret[0].([]usecase.ExperienceDto)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockExperienceUsecaseMockRecorder) GetAll() *gomock.Call {
+func (mr *MockExperienceUsecaseMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockExperienceUsecase)(nil).GetAll), ctx)
+}
+
+// Import mocks base method.
+func (m *MockExperienceUsecase) Import(ctx context.Context, inputs []usecase.ExperienceImportInput, dryRun bool) (usecase.ImportResultDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, inputs, dryRun)
+	ret0, _ := ret[0].(usecase.ImportResultDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockExperienceUsecaseMockRecorder) Import(ctx, inputs, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockExperienceUsecase)(nil).Import), ctx, inputs, dryRun)
+}
+
+// ListRevisions mocks base method.
+func (m *MockExperienceUsecase) ListRevisions(ctx context.Context, id int) ([]usecase.ExperienceRevisionDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevisions", ctx, id)
+	ret0, _ := ret[0].([]usecase.ExperienceRevisionDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevisions indicates an expected call of ListRevisions.
+func (mr *MockExperienceUsecaseMockRecorder) ListRevisions(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevisions", reflect.TypeOf((*MockExperienceUsecase)(nil).ListRevisions), ctx, id)
+}
+
+// Restore mocks base method.
+func (m *MockExperienceUsecase) Restore(ctx context.Context, id int) (usecase.ExperienceDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(usecase.ExperienceDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockExperienceUsecaseMockRecorder) Restore(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockExperienceUsecase)(nil).Restore), ctx, id)
+}
+
+// RestoreRevision mocks base method.
+func (m *MockExperienceUsecase) RestoreRevision(ctx context.Context, id, revision, version int) (usecase.ExperienceDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreRevision", ctx, id, revision, version)
+	ret0, _ := ret[0].(usecase.ExperienceDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreRevision indicates an expected call of RestoreRevision.
+func (mr *MockExperienceUsecaseMockRecorder) RestoreRevision(ctx, id, revision, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRevision", reflect.TypeOf((*MockExperienceUsecase)(nil).RestoreRevision), ctx, id, revision, version)
+}
+
+// Update mocks base method.
+func (m *MockExperienceUsecase) Update(ctx context.Context, id, version int, input usecase.ExperienceInput) (usecase.ExperienceDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, version, input)
+	ret0, _ := ret[0].(usecase.ExperienceDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockExperienceUsecaseMockRecorder) Update(ctx, id, version, input interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockExperienceUsecase)(nil).GetAll))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockExperienceUsecase)(nil).Update), ctx, id, version, input)
 }
@@ -0,0 +1,170 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: organization_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	usecase "stackies/backend/usecase"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOrganizationUsecase is a mock of OrganizationUsecase interface.
+type MockOrganizationUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizationUsecaseMockRecorder
+}
+
+// MockOrganizationUsecaseMockRecorder is the mock recorder for MockOrganizationUsecase.
+type MockOrganizationUsecaseMockRecorder struct {
+	mock *MockOrganizationUsecase
+}
+
+// NewMockOrganizationUsecase creates a new mock instance.
+func NewMockOrganizationUsecase(ctrl *gomock.Controller) *MockOrganizationUsecase {
+	mock := &MockOrganizationUsecase{ctrl: ctrl}
+	mock.recorder = &MockOrganizationUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizationUsecase) EXPECT() *MockOrganizationUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AnalyzeSkillGaps mocks base method.
+func (m *MockOrganizationUsecase) AnalyzeSkillGaps(ctx context.Context, input usecase.SkillGapInput) (usecase.SkillGapAnalysisDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnalyzeSkillGaps", ctx, input)
+	ret0, _ := ret[0].(usecase.SkillGapAnalysisDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnalyzeSkillGaps indicates an expected call of AnalyzeSkillGaps.
+func (mr *MockOrganizationUsecaseMockRecorder) AnalyzeSkillGaps(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnalyzeSkillGaps", reflect.TypeOf((*MockOrganizationUsecase)(nil).AnalyzeSkillGaps), ctx, input)
+}
+
+// CreateOrganization mocks base method.
+func (m *MockOrganizationUsecase) CreateOrganization(ctx context.Context, input usecase.OrganizationInput) (usecase.OrganizationDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganization", ctx, input)
+	ret0, _ := ret[0].(usecase.OrganizationDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganization indicates an expected call of CreateOrganization.
+func (mr *MockOrganizationUsecaseMockRecorder) CreateOrganization(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*MockOrganizationUsecase)(nil).CreateOrganization), ctx, input)
+}
+
+// CreateTeam mocks base method.
+func (m *MockOrganizationUsecase) CreateTeam(ctx context.Context, organizationID int, input usecase.TeamInput) (usecase.TeamDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTeam", ctx, organizationID, input)
+	ret0, _ := ret[0].(usecase.TeamDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTeam indicates an expected call of CreateTeam.
+func (mr *MockOrganizationUsecaseMockRecorder) CreateTeam(ctx, organizationID, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeam", reflect.TypeOf((*MockOrganizationUsecase)(nil).CreateTeam), ctx, organizationID, input)
+}
+
+// GetMemberResume mocks base method.
+func (m *MockOrganizationUsecase) GetMemberResume(ctx context.Context, teamID int, memberID string) (usecase.ResumeDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberResume", ctx, teamID, memberID)
+	ret0, _ := ret[0].(usecase.ResumeDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberResume indicates an expected call of GetMemberResume.
+func (mr *MockOrganizationUsecaseMockRecorder) GetMemberResume(ctx, teamID, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberResume", reflect.TypeOf((*MockOrganizationUsecase)(nil).GetMemberResume), ctx, teamID, memberID)
+}
+
+// GetOrganizationSkills mocks base method.
+func (m *MockOrganizationUsecase) GetOrganizationSkills(ctx context.Context, organizationID int) (usecase.SkillMatrixDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationSkills", ctx, organizationID)
+	ret0, _ := ret[0].(usecase.SkillMatrixDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationSkills indicates an expected call of GetOrganizationSkills.
+func (mr *MockOrganizationUsecaseMockRecorder) GetOrganizationSkills(ctx, organizationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationSkills", reflect.TypeOf((*MockOrganizationUsecase)(nil).GetOrganizationSkills), ctx, organizationID)
+}
+
+// ListMyTeams mocks base method.
+func (m *MockOrganizationUsecase) ListMyTeams(ctx context.Context) ([]usecase.MembershipDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMyTeams", ctx)
+	ret0, _ := ret[0].([]usecase.MembershipDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMyTeams indicates an expected call of ListMyTeams.
+func (mr *MockOrganizationUsecaseMockRecorder) ListMyTeams(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMyTeams", reflect.TypeOf((*MockOrganizationUsecase)(nil).ListMyTeams), ctx)
+}
+
+// ListTeamMembers mocks base method.
+func (m *MockOrganizationUsecase) ListTeamMembers(ctx context.Context, teamID int) ([]usecase.TeamMemberDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTeamMembers", ctx, teamID)
+	ret0, _ := ret[0].([]usecase.TeamMemberDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTeamMembers indicates an expected call of ListTeamMembers.
+func (mr *MockOrganizationUsecaseMockRecorder) ListTeamMembers(ctx, teamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeamMembers", reflect.TypeOf((*MockOrganizationUsecase)(nil).ListTeamMembers), ctx, teamID)
+}
+
+// RemoveTeamMember mocks base method.
+func (m *MockOrganizationUsecase) RemoveTeamMember(ctx context.Context, teamID int, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTeamMember", ctx, teamID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTeamMember indicates an expected call of RemoveTeamMember.
+func (mr *MockOrganizationUsecaseMockRecorder) RemoveTeamMember(ctx, teamID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTeamMember", reflect.TypeOf((*MockOrganizationUsecase)(nil).RemoveTeamMember), ctx, teamID, userID)
+}
+
+// SaveTeamMember mocks base method.
+func (m *MockOrganizationUsecase) SaveTeamMember(ctx context.Context, teamID int, userID string, input usecase.TeamMemberInput) (usecase.TeamMemberDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTeamMember", ctx, teamID, userID, input)
+	ret0, _ := ret[0].(usecase.TeamMemberDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveTeamMember indicates an expected call of SaveTeamMember.
+func (mr *MockOrganizationUsecaseMockRecorder) SaveTeamMember(ctx, teamID, userID, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTeamMember", reflect.TypeOf((*MockOrganizationUsecase)(nil).SaveTeamMember), ctx, teamID, userID, input)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: profile_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	usecase "stackies/backend/usecase"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockProfileUsecase is a mock of ProfileUsecase interface.
+type MockProfileUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockProfileUsecaseMockRecorder
+}
+
+// MockProfileUsecaseMockRecorder is the mock recorder for MockProfileUsecase.
+type MockProfileUsecaseMockRecorder struct {
+	mock *MockProfileUsecase
+}
+
+// NewMockProfileUsecase creates a new mock instance.
+func NewMockProfileUsecase(ctrl *gomock.Controller) *MockProfileUsecase {
+	mock := &MockProfileUsecase{ctrl: ctrl}
+	mock.recorder = &MockProfileUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProfileUsecase) EXPECT() *MockProfileUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockProfileUsecase) Get(ctx context.Context) (usecase.ProfileDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].(usecase.ProfileDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockProfileUsecaseMockRecorder) Get(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProfileUsecase)(nil).Get), ctx)
+}
+
+// Put mocks base method.
+func (m *MockProfileUsecase) Put(ctx context.Context, input usecase.ProfileInput) (usecase.ProfileDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, input)
+	ret0, _ := ret[0].(usecase.ProfileDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockProfileUsecaseMockRecorder) Put(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockProfileUsecase)(nil).Put), ctx, input)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: resume_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	usecase "stackies/backend/usecase"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockResumeUsecase is a mock of ResumeUsecase interface.
+type MockResumeUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockResumeUsecaseMockRecorder
+}
+
+// MockResumeUsecaseMockRecorder is the mock recorder for MockResumeUsecase.
+type MockResumeUsecaseMockRecorder struct {
+	mock *MockResumeUsecase
+}
+
+// NewMockResumeUsecase creates a new mock instance.
+func NewMockResumeUsecase(ctrl *gomock.Controller) *MockResumeUsecase {
+	mock := &MockResumeUsecase{ctrl: ctrl}
+	mock.recorder = &MockResumeUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResumeUsecase) EXPECT() *MockResumeUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockResumeUsecase) Get(ctx context.Context, options usecase.ResumeOptions) (usecase.ResumeDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, options)
+	ret0, _ := ret[0].(usecase.ResumeDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockResumeUsecaseMockRecorder) Get(ctx, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockResumeUsecase)(nil).Get), ctx, options)
+}
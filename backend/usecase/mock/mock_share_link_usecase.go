@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: share_link_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	usecase "stackies/backend/usecase"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockShareLinkUsecase is a mock of ShareLinkUsecase interface.
+type MockShareLinkUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockShareLinkUsecaseMockRecorder
+}
+
+// MockShareLinkUsecaseMockRecorder is the mock recorder for MockShareLinkUsecase.
+type MockShareLinkUsecaseMockRecorder struct {
+	mock *MockShareLinkUsecase
+}
+
+// NewMockShareLinkUsecase creates a new mock instance.
+func NewMockShareLinkUsecase(ctrl *gomock.Controller) *MockShareLinkUsecase {
+	mock := &MockShareLinkUsecase{ctrl: ctrl}
+	mock.recorder = &MockShareLinkUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShareLinkUsecase) EXPECT() *MockShareLinkUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockShareLinkUsecase) Create(ctx context.Context, input usecase.ShareLinkInput) (usecase.CreatedShareLinkDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, input)
+	ret0, _ := ret[0].(usecase.CreatedShareLinkDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockShareLinkUsecaseMockRecorder) Create(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockShareLinkUsecase)(nil).Create), ctx, input)
+}
+
+// List mocks base method.
+func (m *MockShareLinkUsecase) List(ctx context.Context) ([]usecase.ShareLinkDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]usecase.ShareLinkDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockShareLinkUsecaseMockRecorder) List(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockShareLinkUsecase)(nil).List), ctx)
+}
+
+// Revoke mocks base method.
+func (m *MockShareLinkUsecase) Revoke(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockShareLinkUsecaseMockRecorder) Revoke(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockShareLinkUsecase)(nil).Revoke), ctx, id)
+}
+
+// View mocks base method.
+func (m *MockShareLinkUsecase) View(ctx context.Context, token, password string) (usecase.SharedResumeDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "View", ctx, token, password)
+	ret0, _ := ret[0].(usecase.SharedResumeDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// View indicates an expected call of View.
+func (mr *MockShareLinkUsecaseMockRecorder) View(ctx, token, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "View", reflect.TypeOf((*MockShareLinkUsecase)(nil).View), ctx, token, password)
+}
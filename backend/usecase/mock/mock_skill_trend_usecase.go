@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: skill_trend_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	usecase "stackies/backend/usecase"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSkillTrendUsecase is a mock of SkillTrendUsecase interface.
+type MockSkillTrendUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSkillTrendUsecaseMockRecorder
+}
+
+// MockSkillTrendUsecaseMockRecorder is the mock recorder for MockSkillTrendUsecase.
+type MockSkillTrendUsecaseMockRecorder struct {
+	mock *MockSkillTrendUsecase
+}
+
+// NewMockSkillTrendUsecase creates a new mock instance.
+func NewMockSkillTrendUsecase(ctrl *gomock.Controller) *MockSkillTrendUsecase {
+	mock := &MockSkillTrendUsecase{ctrl: ctrl}
+	mock.recorder = &MockSkillTrendUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSkillTrendUsecase) EXPECT() *MockSkillTrendUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetOrganizationTrends mocks base method.
+func (m *MockSkillTrendUsecase) GetOrganizationTrends(ctx context.Context, organizationID int, input usecase.SkillTrendInput) (usecase.SkillTrendReportDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationTrends", ctx, organizationID, input)
+	ret0, _ := ret[0].(usecase.SkillTrendReportDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationTrends indicates an expected call of GetOrganizationTrends.
+func (mr *MockSkillTrendUsecaseMockRecorder) GetOrganizationTrends(ctx, organizationID, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTrends", reflect.TypeOf((*MockSkillTrendUsecase)(nil).GetOrganizationTrends), ctx, organizationID, input)
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: skill_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	usecase "stackies/backend/usecase"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSkillUsecase is a mock of SkillUsecase interface.
+type MockSkillUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSkillUsecaseMockRecorder
+}
+
+// MockSkillUsecaseMockRecorder is the mock recorder for MockSkillUsecase.
+type MockSkillUsecaseMockRecorder struct {
+	mock *MockSkillUsecase
+}
+
+// NewMockSkillUsecase creates a new mock instance.
+func NewMockSkillUsecase(ctrl *gomock.Controller) *MockSkillUsecase {
+	mock := &MockSkillUsecase{ctrl: ctrl}
+	mock.recorder = &MockSkillUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSkillUsecase) EXPECT() *MockSkillUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Endorse mocks base method.
+func (m *MockSkillUsecase) Endorse(ctx context.Context, userID string, input usecase.SkillEndorsementInput) (usecase.SkillEndorsementDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Endorse", ctx, userID, input)
+	ret0, _ := ret[0].(usecase.SkillEndorsementDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Endorse indicates an expected call of Endorse.
+func (mr *MockSkillUsecaseMockRecorder) Endorse(ctx, userID, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Endorse", reflect.TypeOf((*MockSkillUsecase)(nil).Endorse), ctx, userID, input)
+}
+
+// ListEndorsements mocks base method.
+func (m *MockSkillUsecase) ListEndorsements(ctx context.Context, userID string) ([]usecase.SkillEndorsementDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEndorsements", ctx, userID)
+	ret0, _ := ret[0].([]usecase.SkillEndorsementDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEndorsements indicates an expected call of ListEndorsements.
+func (mr *MockSkillUsecaseMockRecorder) ListEndorsements(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEndorsements", reflect.TypeOf((*MockSkillUsecase)(nil).ListEndorsements), ctx, userID)
+}
+
+// ListMySkills mocks base method.
+func (m *MockSkillUsecase) ListMySkills(ctx context.Context) ([]usecase.SkillSummaryDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMySkills", ctx)
+	ret0, _ := ret[0].([]usecase.SkillSummaryDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMySkills indicates an expected call of ListMySkills.
+func (mr *MockSkillUsecaseMockRecorder) ListMySkills(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMySkills", reflect.TypeOf((*MockSkillUsecase)(nil).ListMySkills), ctx)
+}
+
+// SetLevel mocks base method.
+func (m *MockSkillUsecase) SetLevel(ctx context.Context, input usecase.SkillLevelInput) (usecase.SkillAssessmentDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLevel", ctx, input)
+	ret0, _ := ret[0].(usecase.SkillAssessmentDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLevel indicates an expected call of SetLevel.
+func (mr *MockSkillUsecaseMockRecorder) SetLevel(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLevel", reflect.TypeOf((*MockSkillUsecase)(nil).SetLevel), ctx, input)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: staffing_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	usecase "stackies/backend/usecase"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStaffingUsecase is a mock of StaffingUsecase interface.
+type MockStaffingUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockStaffingUsecaseMockRecorder
+}
+
+// MockStaffingUsecaseMockRecorder is the mock recorder for MockStaffingUsecase.
+type MockStaffingUsecaseMockRecorder struct {
+	mock *MockStaffingUsecase
+}
+
+// NewMockStaffingUsecase creates a new mock instance.
+func NewMockStaffingUsecase(ctrl *gomock.Controller) *MockStaffingUsecase {
+	mock := &MockStaffingUsecase{ctrl: ctrl}
+	mock.recorder = &MockStaffingUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStaffingUsecase) EXPECT() *MockStaffingUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockStaffingUsecase) Search(ctx context.Context, input usecase.StaffingSearchInput) ([]usecase.StaffingCandidateDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, input)
+	ret0, _ := ret[0].([]usecase.StaffingCandidateDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockStaffingUsecaseMockRecorder) Search(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockStaffingUsecase)(nil).Search), ctx, input)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tenant_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTenantUsecase is a mock of TenantUsecase interface.
+type MockTenantUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockTenantUsecaseMockRecorder
+}
+
+// MockTenantUsecaseMockRecorder is the mock recorder for MockTenantUsecase.
+type MockTenantUsecaseMockRecorder struct {
+	mock *MockTenantUsecase
+}
+
+// NewMockTenantUsecase creates a new mock instance.
+func NewMockTenantUsecase(ctrl *gomock.Controller) *MockTenantUsecase {
+	mock := &MockTenantUsecase{ctrl: ctrl}
+	mock.recorder = &MockTenantUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTenantUsecase) EXPECT() *MockTenantUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ContextWithShareLinkTenant mocks base method.
+func (m *MockTenantUsecase) ContextWithShareLinkTenant(ctx context.Context, token string) (context.Context, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContextWithShareLinkTenant", ctx, token)
+	ret0, _ := ret[0].(context.Context)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContextWithShareLinkTenant indicates an expected call of ContextWithShareLinkTenant.
+func (mr *MockTenantUsecaseMockRecorder) ContextWithShareLinkTenant(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContextWithShareLinkTenant", reflect.TypeOf((*MockTenantUsecase)(nil).ContextWithShareLinkTenant), ctx, token)
+}
+
+// ContextWithTenant mocks base method.
+func (m *MockTenantUsecase) ContextWithTenant(ctx context.Context, tenantID string) (context.Context, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContextWithTenant", ctx, tenantID)
+	ret0, _ := ret[0].(context.Context)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContextWithTenant indicates an expected call of ContextWithTenant.
+func (mr *MockTenantUsecaseMockRecorder) ContextWithTenant(ctx, tenantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContextWithTenant", reflect.TypeOf((*MockTenantUsecase)(nil).ContextWithTenant), ctx, tenantID)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: timeline_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	usecase "stackies/backend/usecase"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTimelineUsecase is a mock of TimelineUsecase interface.
+type MockTimelineUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimelineUsecaseMockRecorder
+}
+
+// MockTimelineUsecaseMockRecorder is the mock recorder for MockTimelineUsecase.
+type MockTimelineUsecaseMockRecorder struct {
+	mock *MockTimelineUsecase
+}
+
+// NewMockTimelineUsecase creates a new mock instance.
+func NewMockTimelineUsecase(ctrl *gomock.Controller) *MockTimelineUsecase {
+	mock := &MockTimelineUsecase{ctrl: ctrl}
+	mock.recorder = &MockTimelineUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimelineUsecase) EXPECT() *MockTimelineUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetMyTimeline mocks base method.
+func (m *MockTimelineUsecase) GetMyTimeline(ctx context.Context) (usecase.TimelineDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMyTimeline", ctx)
+	ret0, _ := ret[0].(usecase.TimelineDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMyTimeline indicates an expected call of GetMyTimeline.
+func (mr *MockTimelineUsecaseMockRecorder) GetMyTimeline(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMyTimeline", reflect.TypeOf((*MockTimelineUsecase)(nil).GetMyTimeline), ctx)
+}
@@ -0,0 +1,567 @@
+//go:generate mockgen -source=organization_usecase.go -destination=mock/mock_$GOFILE -package=mock
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"time"
+)
+
+type OrganizationDto struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type TeamDto struct {
+	ID             int       `json:"id"`
+	OrganizationID int       `json:"organization_id"`
+	Name           string    `json:"name"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// TeamMemberDto はチームへの所属です
+type TeamMemberDto struct {
+	TeamID int    `json:"team_id"`
+	UserID string `json:"user_id"`
+	// DisplayName はプロフィールの表示名です（プロフィールが未登録の場合は空文字）
+	DisplayName string    `json:"display_name"`
+	Role        string    `json:"role"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// MembershipDto はログイン中のユーザーが所属するチームと役割です
+type MembershipDto struct {
+	Team TeamDto
+	Role string
+}
+
+// OrganizationInput は組織の作成の入力値です
+type OrganizationInput struct {
+	Name string
+}
+
+// TeamInput はチームの作成の入力値です
+type TeamInput struct {
+	Name string
+}
+
+// TeamMemberInput はチームへの所属の入力値です
+type TeamMemberInput struct {
+	// Role は member または manager です（空は member）
+	Role string
+}
+
+// skillGapUsersMax はギャップ分析で指定できるユーザーの上限です
+const skillGapUsersMax = 200
+
+// SkillGapInput はスキルのギャップ分析の対象と必要なスキルです
+// 対象は TeamID（チームのメンバー全員）と UserIDs のいずれか一方で指定します
+type SkillGapInput struct {
+	TeamID       int
+	UserIDs      []string
+	Requirements []SkillRequirementInput
+}
+
+type organizationUsecase struct {
+	organizationRepository repository.OrganizationRepository
+	profileRepository      repository.ProfileRepository
+	experienceRepository   repository.ExperienceRepository
+	// skillAssessmentRepository はメンバーのスキルの自己評価・推薦を参照するためのものです
+	skillAssessmentRepository repository.SkillAssessmentRepository
+	transactionManager        repository.TransactionManager
+	audit                     auditRecorder
+	logger                    *slog.Logger
+}
+
+// CreateOrganization implements OrganizationUsecase.
+func (o *organizationUsecase) CreateOrganization(ctx context.Context, input OrganizationInput) (_ OrganizationDto, err error) {
+	ctx, span := tracer.Start(ctx, "OrganizationUsecase.CreateOrganization")
+	defer func() { endSpan(span, err) }()
+
+	organization, err := model.NewOrganization(input.Name)
+	if err != nil {
+		return OrganizationDto{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	var created model.Organization
+	err = o.transactionManager.Do(ctx, func(ctx context.Context) error {
+		created, err = o.organizationRepository.Create(ctx, *organization)
+		if err != nil {
+			o.logger.ErrorContext(ctx, "failed to create organization", slog.Any("error", err))
+			return err
+		}
+		if err := o.audit.record(ctx, AuditActionCreate, AuditEntityOrganization, created.ID, nil, toOrganizationDto(created)); err != nil {
+			o.logger.ErrorContext(ctx, "failed to record audit log", slog.Any("error", err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return OrganizationDto{}, err
+	}
+	o.logger.InfoContext(ctx, "organization created", slog.Int("organization_id", created.ID))
+	return toOrganizationDto(created), nil
+}
+
+// CreateTeam implements OrganizationUsecase.
+func (o *organizationUsecase) CreateTeam(ctx context.Context, organizationID int, input TeamInput) (_ TeamDto, err error) {
+	ctx, span := tracer.Start(ctx, "OrganizationUsecase.CreateTeam")
+	defer func() { endSpan(span, err) }()
+
+	team, err := model.NewTeam(organizationID, input.Name)
+	if err != nil {
+		return TeamDto{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	var created model.Team
+	err = o.transactionManager.Do(ctx, func(ctx context.Context) error {
+		if _, err := o.organizationRepository.FindByID(ctx, organizationID); err != nil {
+			return err
+		}
+		created, err = o.organizationRepository.CreateTeam(ctx, *team)
+		if err != nil {
+			o.logger.ErrorContext(ctx, "failed to create team", slog.Any("error", err))
+			return err
+		}
+		if err := o.audit.record(ctx, AuditActionCreate, AuditEntityTeam, created.ID, nil, toTeamDto(created)); err != nil {
+			o.logger.ErrorContext(ctx, "failed to record audit log", slog.Any("error", err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return TeamDto{}, err
+	}
+	o.logger.InfoContext(ctx, "team created", slog.Int("team_id", created.ID))
+	return toTeamDto(created), nil
+}
+
+// SaveTeamMember implements OrganizationUsecase.
+func (o *organizationUsecase) SaveTeamMember(ctx context.Context, teamID int, userID string, input TeamMemberInput) (_ TeamMemberDto, err error) {
+	ctx, span := tracer.Start(ctx, "OrganizationUsecase.SaveTeamMember")
+	defer func() { endSpan(span, err) }()
+
+	role, err := model.NewTeamRole(input.Role)
+	if err != nil {
+		return TeamMemberDto{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	member, err := model.NewTeamMember(teamID, userID, role)
+	if err != nil {
+		return TeamMemberDto{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	var saved model.TeamMember
+	err = o.transactionManager.Do(ctx, func(ctx context.Context) error {
+		if _, err := o.organizationRepository.FindTeamByID(ctx, teamID); err != nil {
+			return err
+		}
+		// 監査ログの before には変更前の所属を記録する（未所属の場合は nil）
+		var before interface{}
+		members, err := o.organizationRepository.FindMembersByTeamID(ctx, teamID)
+		if err != nil {
+			o.logger.ErrorContext(ctx, "failed to get team members", slog.Any("error", err))
+			return err
+		}
+		for _, m := range members {
+			if m.UserID == userID {
+				before = toTeamMemberDto(m, "")
+			}
+		}
+
+		saved, err = o.organizationRepository.SaveMember(ctx, *member)
+		if err != nil {
+			o.logger.ErrorContext(ctx, "failed to save team member", slog.Any("error", err))
+			return err
+		}
+		action := AuditActionUpdate
+		if before == nil {
+			action = AuditActionCreate
+		}
+		if err := o.audit.record(ctx, action, AuditEntityTeamMember, teamMemberAuditID(teamID, userID), before, toTeamMemberDto(saved, "")); err != nil {
+			o.logger.ErrorContext(ctx, "failed to record audit log", slog.Any("error", err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return TeamMemberDto{}, err
+	}
+	o.logger.InfoContext(ctx, "team member saved", slog.Int("team_id", teamID), slog.String("member_id", userID))
+	return toTeamMemberDto(saved, ""), nil
+}
+
+// RemoveTeamMember implements OrganizationUsecase.
+func (o *organizationUsecase) RemoveTeamMember(ctx context.Context, teamID int, userID string) (err error) {
+	ctx, span := tracer.Start(ctx, "OrganizationUsecase.RemoveTeamMember")
+	defer func() { endSpan(span, err) }()
+
+	err = o.transactionManager.Do(ctx, func(ctx context.Context) error {
+		// 監査ログの before には解除する所属と役割を記録する
+		members, err := o.organizationRepository.FindMembersByTeamID(ctx, teamID)
+		if err != nil {
+			o.logger.ErrorContext(ctx, "failed to get team members", slog.Any("error", err))
+			return err
+		}
+		member, ok := findMember(members, userID)
+		if !ok {
+			return ErrNotFound
+		}
+		if err := o.organizationRepository.DeleteMember(ctx, teamID, userID); err != nil {
+			if !errors.Is(err, repository.ErrNotFound) {
+				o.logger.ErrorContext(ctx, "failed to delete team member", slog.Any("error", err))
+			}
+			return err
+		}
+		if err := o.audit.record(ctx, AuditActionDelete, AuditEntityTeamMember, teamMemberAuditID(teamID, userID), toTeamMemberDto(member, ""), nil); err != nil {
+			o.logger.ErrorContext(ctx, "failed to record audit log", slog.Any("error", err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	o.logger.InfoContext(ctx, "team member removed", slog.Int("team_id", teamID), slog.String("member_id", userID))
+	return nil
+}
+
+// ListMyTeams implements OrganizationUsecase.
+func (o *organizationUsecase) ListMyTeams(ctx context.Context) (_ []MembershipDto, err error) {
+	ctx, span := tracer.Start(ctx, "OrganizationUsecase.ListMyTeams")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return nil, ErrUnauthenticated
+	}
+	memberships, err := o.organizationRepository.FindMembershipsByUserID(ctx, userID)
+	if err != nil {
+		o.logger.ErrorContext(ctx, "failed to get memberships", slog.Any("error", err))
+		return nil, err
+	}
+	dtos := make([]MembershipDto, len(memberships))
+	for i, membership := range memberships {
+		team, err := o.organizationRepository.FindTeamByID(ctx, membership.TeamID)
+		if err != nil {
+			o.logger.ErrorContext(ctx, "failed to get team", slog.Any("error", err))
+			return nil, err
+		}
+		dtos[i] = MembershipDto{Team: toTeamDto(team), Role: string(membership.Role)}
+	}
+	return dtos, nil
+}
+
+// ListTeamMembers implements OrganizationUsecase.
+// チームのメンバーであれば役割に関わらず一覧を参照できます
+func (o *organizationUsecase) ListTeamMembers(ctx context.Context, teamID int) (_ []TeamMemberDto, err error) {
+	ctx, span := tracer.Start(ctx, "OrganizationUsecase.ListTeamMembers")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return nil, ErrUnauthenticated
+	}
+	members, err := o.findTeamMembers(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := findMember(members, userID); !ok {
+		return nil, ErrForbidden
+	}
+
+	userIDs := make([]string, len(members))
+	for i, member := range members {
+		userIDs[i] = member.UserID
+	}
+	profiles, err := o.profileRepository.FindByUserIDs(ctx, userIDs)
+	if err != nil {
+		o.logger.ErrorContext(ctx, "failed to get profiles", slog.Any("error", err))
+		return nil, err
+	}
+	names := displayNames(profiles)
+	dtos := make([]TeamMemberDto, len(members))
+	for i, member := range members {
+		dtos[i] = toTeamMemberDto(member, names[member.UserID])
+	}
+	return dtos, nil
+}
+
+// GetMemberResume implements OrganizationUsecase.
+// チームのマネージャーだけが参照できます
+func (o *organizationUsecase) GetMemberResume(ctx context.Context, teamID int, memberID string) (_ ResumeDto, err error) {
+	ctx, span := tracer.Start(ctx, "OrganizationUsecase.GetMemberResume")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return ResumeDto{}, ErrUnauthenticated
+	}
+	members, err := o.findTeamMembers(ctx, teamID)
+	if err != nil {
+		return ResumeDto{}, err
+	}
+	if manager, ok := findMember(members, userID); !ok || !manager.IsManager() {
+		return ResumeDto{}, ErrForbidden
+	}
+	if _, ok := findMember(members, memberID); !ok {
+		return ResumeDto{}, ErrNotFound
+	}
+
+	profile, err := findProfile(ctx, o.profileRepository, memberID)
+	if err != nil {
+		o.logger.ErrorContext(ctx, "failed to get profile", slog.Any("error", err))
+		return ResumeDto{}, err
+	}
+	experiences, err := o.experienceRepository.FindByUserID(ctx, memberID)
+	if err != nil {
+		o.logger.ErrorContext(ctx, "failed to get experiences", slog.Any("error", err))
+		return ResumeDto{}, err
+	}
+	levels, err := findSkillLevels(ctx, o.skillAssessmentRepository, memberID)
+	if err != nil {
+		o.logger.ErrorContext(ctx, "failed to get skill levels", slog.Any("error", err))
+		return ResumeDto{}, err
+	}
+	resume := newResumeDto(profile, experiences, time.Now())
+	resume.Skills = withSkillLevels(resume.Skills, levels)
+	return resume, nil
+}
+
+// GetOrganizationSkills implements OrganizationUsecase.
+// 組織のいずれかのチームのマネージャーだけが参照できます
+func (o *organizationUsecase) GetOrganizationSkills(ctx context.Context, organizationID int) (_ SkillMatrixDto, err error) {
+	ctx, span := tracer.Start(ctx, "OrganizationUsecase.GetOrganizationSkills")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return SkillMatrixDto{}, ErrUnauthenticated
+	}
+	if _, err := o.organizationRepository.FindByID(ctx, organizationID); err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			o.logger.ErrorContext(ctx, "failed to get organization", slog.Any("error", err))
+		}
+		return SkillMatrixDto{}, err
+	}
+	members, err := o.organizationRepository.FindMembersByOrganizationID(ctx, organizationID)
+	if err != nil {
+		o.logger.ErrorContext(ctx, "failed to get organization members", slog.Any("error", err))
+		return SkillMatrixDto{}, err
+	}
+	isManager := false
+	userIDs := make([]string, len(members))
+	for i, member := range members {
+		userIDs[i] = member.UserID
+		if member.UserID == userID && member.IsManager() {
+			isManager = true
+		}
+	}
+	if !isManager {
+		return SkillMatrixDto{}, ErrForbidden
+	}
+
+	profiles, err := o.profileRepository.FindByUserIDs(ctx, userIDs)
+	if err != nil {
+		o.logger.ErrorContext(ctx, "failed to get profiles", slog.Any("error", err))
+		return SkillMatrixDto{}, err
+	}
+	memberDtos := newMemberDtos(userIDs, profiles)
+	experiences, err := o.experienceRepository.FindByUserIDs(ctx, memberUserIDs(memberDtos))
+	if err != nil {
+		o.logger.ErrorContext(ctx, "failed to get experiences", slog.Any("error", err))
+		return SkillMatrixDto{}, err
+	}
+	return newSkillMatrixDto(memberDtos, experiences, time.Now()), nil
+}
+
+// AnalyzeSkillGaps implements OrganizationUsecase.
+// チームを指定した場合はチームのマネージャーだけが、ユーザーを指定した場合はユーザー全員がいずれかの管理しているチームのメンバーである場合だけ参照できます
+func (o *organizationUsecase) AnalyzeSkillGaps(ctx context.Context, input SkillGapInput) (_ SkillGapAnalysisDto, err error) {
+	ctx, span := tracer.Start(ctx, "OrganizationUsecase.AnalyzeSkillGaps")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return SkillGapAnalysisDto{}, ErrUnauthenticated
+	}
+	if (input.TeamID == 0) == (len(input.UserIDs) == 0) {
+		return SkillGapAnalysisDto{}, fmt.Errorf("%w: either team id or user ids is required", ErrInvalidInput)
+	}
+	if len(input.UserIDs) > skillGapUsersMax {
+		return SkillGapAnalysisDto{}, fmt.Errorf("%w: user ids must be at most %d", ErrInvalidInput, skillGapUsersMax)
+	}
+	requirements, err := toSkillRequirements(input.Requirements)
+	if err != nil {
+		return SkillGapAnalysisDto{}, err
+	}
+	if err := model.ValidateSkillRequirements(requirements); err != nil {
+		return SkillGapAnalysisDto{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	userIDs := input.UserIDs
+	if input.TeamID != 0 {
+		members, err := o.findTeamMembers(ctx, input.TeamID)
+		if err != nil {
+			return SkillGapAnalysisDto{}, err
+		}
+		if manager, ok := findMember(members, userID); !ok || !manager.IsManager() {
+			return SkillGapAnalysisDto{}, ErrForbidden
+		}
+		userIDs = make([]string, len(members))
+		for i, member := range members {
+			userIDs[i] = member.UserID
+		}
+	} else {
+		managed, err := o.managedUserIDs(ctx, userID)
+		if err != nil {
+			return SkillGapAnalysisDto{}, err
+		}
+		for _, id := range userIDs {
+			if !managed[id] {
+				return SkillGapAnalysisDto{}, ErrForbidden
+			}
+		}
+	}
+
+	profiles, err := o.profileRepository.FindByUserIDs(ctx, userIDs)
+	if err != nil {
+		o.logger.ErrorContext(ctx, "failed to get profiles", slog.Any("error", err))
+		return SkillGapAnalysisDto{}, err
+	}
+	memberDtos := newMemberDtos(userIDs, profiles)
+	experiences, err := o.experienceRepository.FindByUserIDs(ctx, memberUserIDs(memberDtos))
+	if err != nil {
+		o.logger.ErrorContext(ctx, "failed to get experiences", slog.Any("error", err))
+		return SkillGapAnalysisDto{}, err
+	}
+	return newSkillGapAnalysisDto(newSkillMatrixDto(memberDtos, experiences, time.Now()), requirements), nil
+}
+
+// managedUserIDs はユーザーがマネージャーとして所属するチームのメンバーを返します
+func (o *organizationUsecase) managedUserIDs(ctx context.Context, managerID string) (map[string]bool, error) {
+	memberships, err := o.organizationRepository.FindMembershipsByUserID(ctx, managerID)
+	if err != nil {
+		o.logger.ErrorContext(ctx, "failed to get memberships", slog.Any("error", err))
+		return nil, err
+	}
+	managed := make(map[string]bool)
+	for _, membership := range memberships {
+		if !membership.IsManager() {
+			continue
+		}
+		members, err := o.organizationRepository.FindMembersByTeamID(ctx, membership.TeamID)
+		if err != nil {
+			o.logger.ErrorContext(ctx, "failed to get team members", slog.Any("error", err))
+			return nil, err
+		}
+		for _, member := range members {
+			managed[member.UserID] = true
+		}
+	}
+	return managed, nil
+}
+
+// findTeamMembers はチームのメンバーを返します。チームが存在しない場合は ErrNotFound を返します
+func (o *organizationUsecase) findTeamMembers(ctx context.Context, teamID int) ([]model.TeamMember, error) {
+	if _, err := o.organizationRepository.FindTeamByID(ctx, teamID); err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			o.logger.ErrorContext(ctx, "failed to get team", slog.Any("error", err))
+		}
+		return nil, err
+	}
+	members, err := o.organizationRepository.FindMembersByTeamID(ctx, teamID)
+	if err != nil {
+		o.logger.ErrorContext(ctx, "failed to get team members", slog.Any("error", err))
+		return nil, err
+	}
+	return members, nil
+}
+
+func findMember(members []model.TeamMember, userID string) (model.TeamMember, bool) {
+	for _, member := range members {
+		if member.UserID == userID {
+			return member, true
+		}
+	}
+	return model.TeamMember{}, false
+}
+
+func memberUserIDs(members []MemberDto) []string {
+	userIDs := make([]string, len(members))
+	for i, member := range members {
+		userIDs[i] = member.UserID
+	}
+	return userIDs
+}
+
+// teamMemberAuditID は監査ログに記録するチームへの所属の ID（"チームID/ユーザーID"）です
+func teamMemberAuditID(teamID int, userID string) string {
+	return fmt.Sprintf("%d/%s", teamID, userID)
+}
+
+func toOrganizationDto(organization model.Organization) OrganizationDto {
+	return OrganizationDto{ID: organization.ID, Name: organization.Name, CreatedAt: organization.CreatedAt}
+}
+
+func toTeamDto(team model.Team) TeamDto {
+	return TeamDto{ID: team.ID, OrganizationID: team.OrganizationID, Name: team.Name, CreatedAt: team.CreatedAt}
+}
+
+func toTeamMemberDto(member model.TeamMember, displayName string) TeamMemberDto {
+	return TeamMemberDto{
+		TeamID:      member.TeamID,
+		UserID:      member.UserID,
+		DisplayName: displayName,
+		Role:        string(member.Role),
+		CreatedAt:   member.CreatedAt,
+	}
+}
+
+type OrganizationUsecase interface {
+	// CreateOrganization は組織を作成します（管理者用）
+	CreateOrganization(ctx context.Context, input OrganizationInput) (OrganizationDto, error)
+	// CreateTeam は組織にチームを作成します（管理者用）
+	CreateTeam(ctx context.Context, organizationID int, input TeamInput) (TeamDto, error)
+	// SaveTeamMember はユーザーをチームに所属させます。所属済みの場合は役割を変更します（管理者用）
+	SaveTeamMember(ctx context.Context, teamID int, userID string, input TeamMemberInput) (TeamMemberDto, error)
+	// RemoveTeamMember はユーザーをチームから外します（管理者用）
+	RemoveTeamMember(ctx context.Context, teamID int, userID string) error
+	// ListMyTeams はログイン中のユーザーが所属するチームを返します
+	ListMyTeams(ctx context.Context) ([]MembershipDto, error)
+	// ListTeamMembers はチームのメンバーを返します。チームのメンバー以外は ErrForbidden を返します
+	ListTeamMembers(ctx context.Context, teamID int) ([]TeamMemberDto, error)
+	// GetMemberResume はチームのメンバーの業務経歴とスキル（自己評価・他者評価の習熟度を含む）を返します
+	// チームのマネージャー以外は ErrForbidden を返します
+	GetMemberResume(ctx context.Context, teamID int, memberID string) (ResumeDto, error)
+	// GetOrganizationSkills は組織のメンバー全員のスキルの表を返します
+	// 組織のいずれかのチームのマネージャー以外は ErrForbidden を返します
+	GetOrganizationSkills(ctx context.Context, organizationID int) (SkillMatrixDto, error)
+	// AnalyzeSkillGaps はチームまたはユーザーのスキルの表と、必要なスキルに対する不足を返します
+	// 管理しているチームのメンバー以外を含む場合は ErrForbidden を返します
+	AnalyzeSkillGaps(ctx context.Context, input SkillGapInput) (SkillGapAnalysisDto, error)
+}
+
+func NewOrganizationUsecase(
+	organizationRepository repository.OrganizationRepository,
+	profileRepository repository.ProfileRepository,
+	experienceRepository repository.ExperienceRepository,
+	skillAssessmentRepository repository.SkillAssessmentRepository,
+	auditLogRepository repository.AuditLogRepository,
+	transactionManager repository.TransactionManager,
+	logger *slog.Logger,
+) OrganizationUsecase {
+	return &organizationUsecase{
+		organizationRepository:    organizationRepository,
+		profileRepository:         profileRepository,
+		experienceRepository:      experienceRepository,
+		skillAssessmentRepository: skillAssessmentRepository,
+		transactionManager:        transactionManager,
+		audit:                     auditRecorder{auditLogRepository: auditLogRepository},
+		logger:                    logger.With(slog.String("usecase", "organization")),
+	}
+}
@@ -0,0 +1,523 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"stackies/backend/domain/repository/mock"
+	"stackies/backend/usecase"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type organizationMocks struct {
+	organization *mock.MockOrganizationRepository
+	profile      *mock.MockProfileRepository
+	experience   *mock.MockExperienceRepository
+	skill        *mock.MockSkillAssessmentRepository
+	audit        *mock.MockAuditLogRepository
+}
+
+func newOrganizationUsecase(ctrl *gomock.Controller) (usecase.OrganizationUsecase, organizationMocks) {
+	m := organizationMocks{
+		organization: mock.NewMockOrganizationRepository(ctrl),
+		profile:      mock.NewMockProfileRepository(ctrl),
+		experience:   mock.NewMockExperienceRepository(ctrl),
+		skill:        mock.NewMockSkillAssessmentRepository(ctrl),
+		audit:        mock.NewMockAuditLogRepository(ctrl),
+	}
+	uc := usecase.NewOrganizationUsecase(m.organization, m.profile, m.experience, m.skill, m.audit, newTransactionManager(ctrl), discardLogger)
+	return uc, m
+}
+
+// newUserExperience はテスト用のユーザーの業務経歴エンティティを作成します
+func newUserExperience(t *testing.T, id int, userID string, start, end time.Time, skills ...model.Skill) model.Experience {
+	t.Helper()
+	experience := newExperienceInPeriod(t, id, "案件", start, end, skills...)
+	experience.UserID = userID
+	return experience
+}
+
+func TestOrganizationUsecase_SaveTeamMember(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      usecase.TeamMemberInput
+		setupMock  func(organizationMocks)
+		wantRole   string
+		wantErr    error
+		wantAction string
+	}{
+		{
+			name:  "正常系: 未所属のユーザーをメンバーとして所属させる",
+			input: usecase.TeamMemberInput{},
+			setupMock: func(m organizationMocks) {
+				m.organization.EXPECT().FindTeamByID(gomock.Any(), 1).Return(model.Team{ID: 1, OrganizationID: 1, Name: "決済チーム"}, nil)
+				m.organization.EXPECT().FindMembersByTeamID(gomock.Any(), 1).Return(nil, nil)
+				m.organization.EXPECT().SaveMember(gomock.Any(), model.TeamMember{TeamID: 1, UserID: "user-2", Role: model.TeamRoleMember}).
+					Return(model.TeamMember{TeamID: 1, UserID: "user-2", Role: model.TeamRoleMember}, nil)
+				m.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log model.AuditLog) error {
+					assert.Equal(t, usecase.AuditActionCreate, log.Action)
+					assert.Equal(t, "1/user-2", log.EntityID)
+					return nil
+				})
+			},
+			wantRole: "member",
+		},
+		{
+			name:  "正常系: 所属済みのユーザーの役割を変更する",
+			input: usecase.TeamMemberInput{Role: "manager"},
+			setupMock: func(m organizationMocks) {
+				m.organization.EXPECT().FindTeamByID(gomock.Any(), 1).Return(model.Team{ID: 1, OrganizationID: 1, Name: "決済チーム"}, nil)
+				m.organization.EXPECT().FindMembersByTeamID(gomock.Any(), 1).Return([]model.TeamMember{{TeamID: 1, UserID: "user-2", Role: model.TeamRoleMember}}, nil)
+				m.organization.EXPECT().SaveMember(gomock.Any(), gomock.Any()).Return(model.TeamMember{TeamID: 1, UserID: "user-2", Role: model.TeamRoleManager}, nil)
+				m.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log model.AuditLog) error {
+					assert.Equal(t, usecase.AuditActionUpdate, log.Action)
+					return nil
+				})
+			},
+			wantRole: "manager",
+		},
+		{
+			name:      "異常系: 未知の役割",
+			input:     usecase.TeamMemberInput{Role: "owner"},
+			setupMock: func(m organizationMocks) {},
+			wantErr:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "異常系: チームが存在しない",
+			input: usecase.TeamMemberInput{},
+			setupMock: func(m organizationMocks) {
+				m.organization.EXPECT().FindTeamByID(gomock.Any(), 1).Return(model.Team{}, repository.ErrNotFound)
+			},
+			wantErr: usecase.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newOrganizationUsecase(ctrl)
+			tt.setupMock(m)
+
+			got, err := uc.SaveTeamMember(usecase.ContextWithUserID(context.Background(), "admin-1"), 1, "user-2", tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRole, got.Role)
+		})
+	}
+}
+
+func TestOrganizationUsecase_RemoveTeamMember(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupMock func(organizationMocks)
+		wantErr   error
+	}{
+		{
+			name: "正常系: 所属を解除し、解除した所属と役割を監査ログに記録する",
+			setupMock: func(m organizationMocks) {
+				m.organization.EXPECT().FindMembersByTeamID(gomock.Any(), 1).Return([]model.TeamMember{
+					{TeamID: 1, UserID: "user-1", Role: model.TeamRoleMember},
+					{TeamID: 1, UserID: "user-2", Role: model.TeamRoleManager},
+				}, nil)
+				m.organization.EXPECT().DeleteMember(gomock.Any(), 1, "user-2").Return(nil)
+				m.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log model.AuditLog) error {
+					assert.Equal(t, usecase.AuditActionDelete, log.Action)
+					assert.Equal(t, usecase.AuditEntityTeamMember, log.EntityType)
+					assert.Equal(t, "1/user-2", log.EntityID)
+					assert.Contains(t, string(log.Before), `"user_id":"user-2"`)
+					assert.Contains(t, string(log.Before), `"role":"manager"`)
+					assert.Nil(t, log.After)
+					return nil
+				})
+			},
+		},
+		{
+			name: "異常系: チームに所属していない",
+			setupMock: func(m organizationMocks) {
+				m.organization.EXPECT().FindMembersByTeamID(gomock.Any(), 1).Return([]model.TeamMember{{TeamID: 1, UserID: "user-1", Role: model.TeamRoleMember}}, nil)
+			},
+			wantErr: usecase.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newOrganizationUsecase(ctrl)
+			tt.setupMock(m)
+
+			err := uc.RemoveTeamMember(usecase.ContextWithUserID(context.Background(), "admin-1"), 1, "user-2")
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestOrganizationUsecase_GetMemberResume(t *testing.T) {
+	month := func(year int, m time.Month) time.Time { return time.Date(year, m, 1, 0, 0, 0, 0, time.UTC) }
+	goSkill := model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}
+	team := model.Team{ID: 1, OrganizationID: 1, Name: "決済チーム"}
+	members := []model.TeamMember{
+		{TeamID: 1, UserID: "manager-1", Role: model.TeamRoleManager},
+		{TeamID: 1, UserID: "user-1", Role: model.TeamRoleMember},
+		{TeamID: 1, UserID: "user-2", Role: model.TeamRoleMember},
+	}
+
+	tests := []struct {
+		name      string
+		userID    string
+		memberID  string
+		setupMock func(organizationMocks)
+		check     func(*testing.T, usecase.ResumeDto)
+		wantErr   error
+	}{
+		{
+			name:     "正常系: マネージャーはメンバーの業務経歴とスキルを参照できる",
+			userID:   "manager-1",
+			memberID: "user-1",
+			setupMock: func(m organizationMocks) {
+				m.organization.EXPECT().FindTeamByID(gomock.Any(), 1).Return(team, nil)
+				m.organization.EXPECT().FindMembersByTeamID(gomock.Any(), 1).Return(members, nil)
+				m.profile.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(model.Profile{UserID: "user-1", DisplayName: "山田 太郎"}, nil)
+				m.experience.EXPECT().FindByUserID(gomock.Any(), "user-1").Return([]model.Experience{
+					newUserExperience(t, 1, "user-1", month(2024, time.April), month(2025, time.March), goSkill),
+				}, nil)
+				m.skill.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(nil, nil)
+				m.skill.EXPECT().FindEndorsementsByUserID(gomock.Any(), "user-1").Return(nil, nil)
+			},
+			check: func(t *testing.T, resume usecase.ResumeDto) {
+				assert.Equal(t, "山田 太郎", resume.Profile.DisplayName)
+				require.Len(t, resume.Skills, 1)
+				assert.Equal(t, 12, resume.Skills[0].Months)
+				assert.Zero(t, resume.Skills[0].SelfLevel)
+				assert.Len(t, resume.Experiences, 1)
+			},
+		},
+		{
+			name:     "正常系: スキルには自己評価と他者評価の習熟度を含む",
+			userID:   "manager-1",
+			memberID: "user-1",
+			setupMock: func(m organizationMocks) {
+				m.organization.EXPECT().FindTeamByID(gomock.Any(), 1).Return(team, nil)
+				m.organization.EXPECT().FindMembersByTeamID(gomock.Any(), 1).Return(members, nil)
+				m.profile.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(model.Profile{UserID: "user-1"}, nil)
+				m.experience.EXPECT().FindByUserID(gomock.Any(), "user-1").Return([]model.Experience{
+					newUserExperience(t, 1, "user-1", month(2024, time.April), month(2025, time.March), goSkill),
+				}, nil)
+				m.skill.EXPECT().FindByUserID(gomock.Any(), "user-1").Return([]model.SkillAssessment{
+					{UserID: "user-1", Skill: goSkill, Level: 4},
+				}, nil)
+				m.skill.EXPECT().FindEndorsementsByUserID(gomock.Any(), "user-1").Return([]model.SkillEndorsement{
+					{ID: 1, UserID: "user-1", EndorserID: "user-2", Skill: goSkill, Level: 3},
+				}, nil)
+			},
+			check: func(t *testing.T, resume usecase.ResumeDto) {
+				require.Len(t, resume.Skills, 1)
+				assert.Equal(t, 12, resume.Skills[0].Months)
+				assert.Equal(t, 4, resume.Skills[0].SelfLevel)
+				assert.Equal(t, 3.0, resume.Skills[0].PeerLevel)
+				assert.Equal(t, 1, resume.Skills[0].EndorsementCount)
+			},
+		},
+		{
+			name:     "異常系: マネージャーでないメンバーは参照できない",
+			userID:   "user-2",
+			memberID: "user-1",
+			setupMock: func(m organizationMocks) {
+				m.organization.EXPECT().FindTeamByID(gomock.Any(), 1).Return(team, nil)
+				m.organization.EXPECT().FindMembersByTeamID(gomock.Any(), 1).Return(members, nil)
+			},
+			wantErr: usecase.ErrForbidden,
+		},
+		{
+			name:     "異常系: チームに所属していないユーザーは参照できない",
+			userID:   "manager-1",
+			memberID: "user-9",
+			setupMock: func(m organizationMocks) {
+				m.organization.EXPECT().FindTeamByID(gomock.Any(), 1).Return(team, nil)
+				m.organization.EXPECT().FindMembersByTeamID(gomock.Any(), 1).Return(members, nil)
+			},
+			wantErr: usecase.ErrNotFound,
+		},
+		{
+			name:      "異常系: ユーザーが設定されていない",
+			memberID:  "user-1",
+			setupMock: func(m organizationMocks) {},
+			wantErr:   usecase.ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newOrganizationUsecase(ctrl)
+			tt.setupMock(m)
+
+			ctx := context.Background()
+			if tt.userID != "" {
+				ctx = usecase.ContextWithUserID(ctx, tt.userID)
+			}
+			got, err := uc.GetMemberResume(ctx, 1, tt.memberID)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, got)
+		})
+	}
+}
+
+func TestOrganizationUsecase_GetOrganizationSkills(t *testing.T) {
+	month := func(year int, m time.Month) time.Time { return time.Date(year, m, 1, 0, 0, 0, 0, time.UTC) }
+	goSkill := model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}
+	awsSkill := model.Skill{Category: model.SkillCategoryTool, Name: "AWS"}
+	// manager-1 は2つのチームに所属している
+	members := []model.TeamMember{
+		{TeamID: 1, UserID: "manager-1", Role: model.TeamRoleManager},
+		{TeamID: 1, UserID: "user-1", Role: model.TeamRoleMember},
+		{TeamID: 2, UserID: "manager-1", Role: model.TeamRoleMember},
+		{TeamID: 2, UserID: "user-2", Role: model.TeamRoleMember},
+	}
+
+	tests := []struct {
+		name      string
+		userID    string
+		setupMock func(organizationMocks)
+		check     func(*testing.T, usecase.SkillMatrixDto)
+		wantErr   error
+	}{
+		{
+			name:   "正常系: 組織のメンバー全員のスキルを経験のある人数の多い順に返す",
+			userID: "manager-1",
+			setupMock: func(m organizationMocks) {
+				m.organization.EXPECT().FindByID(gomock.Any(), 1).Return(model.Organization{ID: 1, Name: "株式会社スタッキーズ"}, nil)
+				m.organization.EXPECT().FindMembersByOrganizationID(gomock.Any(), 1).Return(members, nil)
+				m.profile.EXPECT().FindByUserIDs(gomock.Any(), []string{"manager-1", "user-1", "manager-1", "user-2"}).
+					Return([]model.Profile{{UserID: "user-1", DisplayName: "山田 太郎"}}, nil)
+				m.experience.EXPECT().FindByUserIDs(gomock.Any(), []string{"manager-1", "user-1", "user-2"}).Return([]model.Experience{
+					newUserExperience(t, 1, "user-1", month(2023, time.April), month(2024, time.March), goSkill, awsSkill),
+					newUserExperience(t, 2, "user-2", month(2024, time.April), month(2024, time.September), goSkill),
+				}, nil)
+			},
+			check: func(t *testing.T, matrix usecase.SkillMatrixDto) {
+				assert.Equal(t, []usecase.MemberDto{
+					{UserID: "manager-1"},
+					{UserID: "user-1", DisplayName: "山田 太郎"},
+					{UserID: "user-2"},
+				}, matrix.Members)
+				require.Len(t, matrix.Skills, 2)
+				assert.Equal(t, "Go", matrix.Skills[0].Name)
+				assert.Equal(t, 18, matrix.Skills[0].TotalMonths)
+				require.Len(t, matrix.Skills[0].Members, 2)
+				assert.Equal(t, "user-1", matrix.Skills[0].Members[0].UserID)
+				assert.Equal(t, 12, matrix.Skills[0].Members[0].Months)
+				assert.Equal(t, "AWS", matrix.Skills[1].Name)
+				assert.Len(t, matrix.Skills[1].Members, 1)
+			},
+		},
+		{
+			name:   "異常系: マネージャーでないメンバーは参照できない",
+			userID: "user-1",
+			setupMock: func(m organizationMocks) {
+				m.organization.EXPECT().FindByID(gomock.Any(), 1).Return(model.Organization{ID: 1}, nil)
+				m.organization.EXPECT().FindMembersByOrganizationID(gomock.Any(), 1).Return(members, nil)
+			},
+			wantErr: usecase.ErrForbidden,
+		},
+		{
+			name:   "異常系: 組織が存在しない",
+			userID: "manager-1",
+			setupMock: func(m organizationMocks) {
+				m.organization.EXPECT().FindByID(gomock.Any(), 1).Return(model.Organization{}, repository.ErrNotFound)
+			},
+			wantErr: usecase.ErrNotFound,
+		},
+		{
+			name:   "異常系: 業務経歴の取得に失敗",
+			userID: "manager-1",
+			setupMock: func(m organizationMocks) {
+				m.organization.EXPECT().FindByID(gomock.Any(), 1).Return(model.Organization{ID: 1}, nil)
+				m.organization.EXPECT().FindMembersByOrganizationID(gomock.Any(), 1).Return(members, nil)
+				m.profile.EXPECT().FindByUserIDs(gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.experience.EXPECT().FindByUserIDs(gomock.Any(), gomock.Any()).Return(nil, errors.New("DB error"))
+			},
+			wantErr: errors.New("DB error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newOrganizationUsecase(ctrl)
+			tt.setupMock(m)
+
+			got, err := uc.GetOrganizationSkills(usecase.ContextWithUserID(context.Background(), tt.userID), 1)
+			if tt.wantErr != nil {
+				if errors.Is(tt.wantErr, usecase.ErrNotFound) || errors.Is(tt.wantErr, usecase.ErrForbidden) {
+					assert.ErrorIs(t, err, tt.wantErr)
+				} else {
+					assert.EqualError(t, err, tt.wantErr.Error())
+				}
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, got)
+		})
+	}
+}
+
+func TestOrganizationUsecase_AnalyzeSkillGaps(t *testing.T) {
+	month := func(year int, m time.Month) time.Time { return time.Date(year, m, 1, 0, 0, 0, 0, time.UTC) }
+	goSkill := model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}
+	awsSkill := model.Skill{Category: model.SkillCategoryTool, Name: "AWS"}
+	teamMembers := []model.TeamMember{
+		{TeamID: 1, UserID: "manager-1", Role: model.TeamRoleManager},
+		{TeamID: 1, UserID: "user-1", Role: model.TeamRoleMember},
+		{TeamID: 1, UserID: "user-2", Role: model.TeamRoleMember},
+	}
+	// Go 36か月・AWS 12か月の user-1 と、Go 6か月の user-2
+	experiences := []model.Experience{
+		newUserExperience(t, 1, "user-1", month(2021, time.April), month(2024, time.March), goSkill),
+		newUserExperience(t, 2, "user-1", month(2023, time.April), month(2024, time.March), awsSkill),
+		newUserExperience(t, 3, "user-2", month(2024, time.April), month(2024, time.September), goSkill),
+	}
+	requirements := []usecase.SkillRequirementInput{
+		{Category: "language", Name: "go", MinMonths: 24, MinPeople: 2},
+		{Category: "tool", Name: "AWS", MinMonths: 12},
+	}
+
+	tests := []struct {
+		name      string
+		userID    string
+		input     usecase.SkillGapInput
+		setupMock func(organizationMocks)
+		check     func(*testing.T, usecase.SkillGapAnalysisDto)
+		wantErr   error
+	}{
+		{
+			name:   "正常系: チームのメンバーのスキルと不足している人数を返す",
+			userID: "manager-1",
+			input:  usecase.SkillGapInput{TeamID: 1, Requirements: requirements},
+			setupMock: func(m organizationMocks) {
+				m.organization.EXPECT().FindTeamByID(gomock.Any(), 1).Return(model.Team{ID: 1, OrganizationID: 1}, nil)
+				m.organization.EXPECT().FindMembersByTeamID(gomock.Any(), 1).Return(teamMembers, nil)
+				m.profile.EXPECT().FindByUserIDs(gomock.Any(), []string{"manager-1", "user-1", "user-2"}).Return(nil, nil)
+				m.experience.EXPECT().FindByUserIDs(gomock.Any(), []string{"manager-1", "user-1", "user-2"}).Return(experiences, nil)
+			},
+			check: func(t *testing.T, analysis usecase.SkillGapAnalysisDto) {
+				assert.Len(t, analysis.Members, 3)
+				assert.Len(t, analysis.Skills, 2)
+				assert.False(t, analysis.Satisfied)
+				require.Len(t, analysis.Gaps, 2)
+
+				goGap := analysis.Gaps[0]
+				assert.Equal(t, "go", goGap.Name)
+				assert.Equal(t, 2, goGap.MinPeople)
+				require.Len(t, goGap.Qualified, 1)
+				assert.Equal(t, "user-1", goGap.Qualified[0].UserID)
+				assert.Equal(t, 36, goGap.Qualified[0].Months)
+				require.Len(t, goGap.Candidates, 1)
+				assert.Equal(t, "user-2", goGap.Candidates[0].UserID)
+				assert.Equal(t, 1, goGap.Shortage)
+
+				awsGap := analysis.Gaps[1]
+				assert.Equal(t, 1, awsGap.MinPeople)
+				assert.Len(t, awsGap.Qualified, 1)
+				assert.Empty(t, awsGap.Candidates)
+				assert.Zero(t, awsGap.Shortage)
+			},
+		},
+		{
+			name:   "正常系: 管理しているチームのメンバーを指定する",
+			userID: "manager-1",
+			input:  usecase.SkillGapInput{UserIDs: []string{"user-1"}, Requirements: requirements[1:]},
+			setupMock: func(m organizationMocks) {
+				m.organization.EXPECT().FindMembershipsByUserID(gomock.Any(), "manager-1").Return(teamMembers[:1], nil)
+				m.organization.EXPECT().FindMembersByTeamID(gomock.Any(), 1).Return(teamMembers, nil)
+				m.profile.EXPECT().FindByUserIDs(gomock.Any(), []string{"user-1"}).Return(nil, nil)
+				m.experience.EXPECT().FindByUserIDs(gomock.Any(), []string{"user-1"}).Return(experiences[:2], nil)
+			},
+			check: func(t *testing.T, analysis usecase.SkillGapAnalysisDto) {
+				assert.True(t, analysis.Satisfied)
+				require.Len(t, analysis.Gaps, 1)
+				assert.Zero(t, analysis.Gaps[0].Shortage)
+			},
+		},
+		{
+			name:   "異常系: 管理していないユーザーを含む",
+			userID: "manager-1",
+			input:  usecase.SkillGapInput{UserIDs: []string{"user-1", "user-9"}},
+			setupMock: func(m organizationMocks) {
+				m.organization.EXPECT().FindMembershipsByUserID(gomock.Any(), "manager-1").Return(teamMembers[:1], nil)
+				m.organization.EXPECT().FindMembersByTeamID(gomock.Any(), 1).Return(teamMembers, nil)
+			},
+			wantErr: usecase.ErrForbidden,
+		},
+		{
+			name:   "異常系: チームのマネージャーでない",
+			userID: "user-1",
+			input:  usecase.SkillGapInput{TeamID: 1},
+			setupMock: func(m organizationMocks) {
+				m.organization.EXPECT().FindTeamByID(gomock.Any(), 1).Return(model.Team{ID: 1, OrganizationID: 1}, nil)
+				m.organization.EXPECT().FindMembersByTeamID(gomock.Any(), 1).Return(teamMembers, nil)
+			},
+			wantErr: usecase.ErrForbidden,
+		},
+		{
+			name:      "異常系: チームとユーザーを同時に指定する",
+			userID:    "manager-1",
+			input:     usecase.SkillGapInput{TeamID: 1, UserIDs: []string{"user-1"}},
+			setupMock: func(m organizationMocks) {},
+			wantErr:   usecase.ErrInvalidInput,
+		},
+		{
+			name:   "異常系: 同じスキルを重複して指定する",
+			userID: "manager-1",
+			input: usecase.SkillGapInput{TeamID: 1, Requirements: []usecase.SkillRequirementInput{
+				{Category: "language", Name: "Go"}, {Category: "language", Name: "GO", MinMonths: 12},
+			}},
+			setupMock: func(m organizationMocks) {},
+			wantErr:   usecase.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newOrganizationUsecase(ctrl)
+			tt.setupMock(m)
+
+			got, err := uc.AnalyzeSkillGaps(usecase.ContextWithUserID(context.Background(), tt.userID), tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, got)
+		})
+	}
+}
@@ -0,0 +1,240 @@
+//go:generate mockgen -source=profile_usecase.go -destination=mock/mock_$GOFILE -package=mock
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"strings"
+	"time"
+)
+
+type ProfileDto struct {
+	DisplayName        string             `json:"display_name"`
+	Initials           string             `json:"initials"`
+	Headline           string             `json:"headline"`
+	SelfPR             string             `json:"self_pr"`
+	NearestStation     string             `json:"nearest_station"`
+	WorkStyle          string             `json:"work_style"`
+	PreferredLocations []string           `json:"preferred_locations"`
+	Certifications     []CertificationDto `json:"certifications"`
+	Educations         []EducationDto     `json:"educations"`
+	// UpdatedAt はプロフィールが未登録の場合はゼロ値です
+	UpdatedAt time.Time `json:"-"`
+}
+
+type CertificationDto struct {
+	Name   string `json:"name"`
+	Issuer string `json:"issuer"`
+	// AcquiredMonth は取得月の月初です（nil は未設定）
+	AcquiredMonth *time.Time `json:"acquired_month,omitempty"`
+}
+
+type EducationDto struct {
+	SchoolName string `json:"school_name"`
+	Faculty    string `json:"faculty"`
+	Degree     string `json:"degree"`
+	// StartMonth / EndMonth は在学期間の開始月・終了月の月初です（EndMonth が nil の場合は在学中）
+	StartMonth *time.Time `json:"start_month,omitempty"`
+	EndMonth   *time.Time `json:"end_month,omitempty"`
+}
+
+// ProfileInput はプロフィールの更新の入力値です
+// 資格・学歴は指定した内容で全て置き換えます
+type ProfileInput struct {
+	DisplayName        string
+	Initials           string
+	Headline           string
+	SelfPR             string
+	NearestStation     string
+	WorkStyle          string
+	PreferredLocations []string
+	Certifications     []CertificationDto
+	Educations         []EducationDto
+}
+
+// toProfile は入力値を検証してプロフィールのエンティティに変換します
+func (in ProfileInput) toProfile(userID string) (*model.Profile, error) {
+	profile := model.NewProfile(userID)
+	profile.DisplayName = in.DisplayName
+	profile.Initials = strings.TrimSpace(in.Initials)
+	profile.Headline = in.Headline
+	profile.SelfPR = in.SelfPR
+	profile.NearestStation = in.NearestStation
+	profile.PreferredLocations = in.PreferredLocations
+
+	var err error
+	if profile.WorkStyle, err = model.ParseWorkStyle(in.WorkStyle); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	profile.Certifications = make([]model.Certification, len(in.Certifications))
+	for i, c := range in.Certifications {
+		var acquiredMonth time.Time
+		if c.AcquiredMonth != nil {
+			acquiredMonth = *c.AcquiredMonth
+		}
+		if profile.Certifications[i], err = model.NewCertification(c.Name, c.Issuer, acquiredMonth); err != nil {
+			return nil, fmt.Errorf("%w: certifications[%d]: %w", ErrInvalidInput, i, err)
+		}
+	}
+	profile.Educations = make([]model.Education, len(in.Educations))
+	for i, e := range in.Educations {
+		var period model.Period
+		if e.StartMonth != nil {
+			if period, err = model.NewPeriod(*e.StartMonth, e.EndMonth); err != nil {
+				return nil, fmt.Errorf("%w: educations[%d]: %w", ErrInvalidInput, i, err)
+			}
+		} else if e.EndMonth != nil {
+			return nil, fmt.Errorf("%w: educations[%d]: start month is required when end month is set", ErrInvalidInput, i)
+		}
+		if profile.Educations[i], err = model.NewEducation(e.SchoolName, e.Faculty, e.Degree, period); err != nil {
+			return nil, fmt.Errorf("%w: educations[%d]: %w", ErrInvalidInput, i, err)
+		}
+	}
+
+	if err := profile.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	return profile, nil
+}
+
+type profileUsecase struct {
+	profileRepository  repository.ProfileRepository
+	transactionManager repository.TransactionManager
+	audit              auditRecorder
+	logger             *slog.Logger
+}
+
+// Get implements ProfileUsecase.
+func (p *profileUsecase) Get(ctx context.Context) (_ ProfileDto, err error) {
+	ctx, span := tracer.Start(ctx, "ProfileUsecase.Get")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return ProfileDto{}, ErrUnauthenticated
+	}
+
+	profile, err := findProfile(ctx, p.profileRepository, userID)
+	if err != nil {
+		p.logger.ErrorContext(ctx, "failed to get profile", slog.Any("error", err))
+		return ProfileDto{}, err
+	}
+	return toProfileDto(profile), nil
+}
+
+// findProfile はユーザーのプロフィールを取得します。未登録の場合は空のプロフィールを返します
+func findProfile(ctx context.Context, profileRepository repository.ProfileRepository, userID string) (model.Profile, error) {
+	profile, err := profileRepository.FindByUserID(ctx, userID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return *model.NewProfile(userID), nil
+	}
+	return profile, err
+}
+
+// Put implements ProfileUsecase.
+func (p *profileUsecase) Put(ctx context.Context, input ProfileInput) (_ ProfileDto, err error) {
+	ctx, span := tracer.Start(ctx, "ProfileUsecase.Put")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return ProfileDto{}, ErrUnauthenticated
+	}
+	profile, err := input.toProfile(userID)
+	if err != nil {
+		return ProfileDto{}, err
+	}
+
+	var saved model.Profile
+	err = p.transactionManager.Do(ctx, func(ctx context.Context) error {
+		// 監査ログの before には更新前の内容を記録する（未登録の場合は nil）
+		var before interface{}
+		current, err := p.profileRepository.FindByUserID(ctx, userID)
+		switch {
+		case err == nil:
+			before = toProfileDto(current)
+		case !errors.Is(err, repository.ErrNotFound):
+			p.logger.ErrorContext(ctx, "failed to get profile", slog.Any("error", err))
+			return err
+		}
+
+		saved, err = p.profileRepository.Save(ctx, *profile)
+		if err != nil {
+			p.logger.ErrorContext(ctx, "failed to save profile", slog.Any("error", err))
+			return err
+		}
+		action := AuditActionUpdate
+		if before == nil {
+			action = AuditActionCreate
+		}
+		if err := p.audit.record(ctx, action, AuditEntityProfile, userID, before, toProfileDto(saved)); err != nil {
+			p.logger.ErrorContext(ctx, "failed to record audit log", slog.Any("error", err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return ProfileDto{}, err
+	}
+	p.logger.InfoContext(ctx, "profile saved")
+	return toProfileDto(saved), nil
+}
+
+func toProfileDto(profile model.Profile) ProfileDto {
+	dto := ProfileDto{
+		DisplayName:        profile.DisplayName,
+		Initials:           profile.Initials,
+		Headline:           profile.Headline,
+		SelfPR:             profile.SelfPR,
+		NearestStation:     profile.NearestStation,
+		WorkStyle:          string(profile.WorkStyle),
+		PreferredLocations: profile.PreferredLocations,
+		Certifications:     make([]CertificationDto, len(profile.Certifications)),
+		Educations:         make([]EducationDto, len(profile.Educations)),
+		UpdatedAt:          profile.UpdatedAt,
+	}
+	if dto.PreferredLocations == nil {
+		dto.PreferredLocations = []string{}
+	}
+	for i, c := range profile.Certifications {
+		dto.Certifications[i] = CertificationDto{Name: c.Name, Issuer: c.Issuer}
+		if !c.AcquiredMonth.IsZero() {
+			acquiredMonth := c.AcquiredMonth
+			dto.Certifications[i].AcquiredMonth = &acquiredMonth
+		}
+	}
+	for i, e := range profile.Educations {
+		dto.Educations[i] = EducationDto{SchoolName: e.SchoolName, Faculty: e.Faculty, Degree: e.Degree}
+		if !e.Period.IsZero() {
+			start := e.Period.Start()
+			dto.Educations[i].StartMonth = &start
+			if end, ok := e.Period.End(); ok {
+				dto.Educations[i].EndMonth = &end
+			}
+		}
+	}
+	return dto
+}
+
+type ProfileUsecase interface {
+	Get(ctx context.Context) (ProfileDto, error)
+	Put(ctx context.Context, input ProfileInput) (ProfileDto, error)
+}
+
+func NewProfileUsecase(
+	profileRepository repository.ProfileRepository,
+	auditLogRepository repository.AuditLogRepository,
+	transactionManager repository.TransactionManager,
+	logger *slog.Logger,
+) ProfileUsecase {
+	return &profileUsecase{
+		profileRepository:  profileRepository,
+		transactionManager: transactionManager,
+		audit:              auditRecorder{auditLogRepository: auditLogRepository},
+		logger:             logger.With(slog.String("usecase", "profile")),
+	}
+}
@@ -0,0 +1,215 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"stackies/backend/domain/repository/mock"
+	"stackies/backend/usecase"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileUsecase_Get(t *testing.T) {
+	acquired := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		userID    string
+		setupMock func(*mock.MockProfileRepository)
+		want      usecase.ProfileDto
+		wantErr   error
+	}{
+		{
+			name:   "正常系: プロフィールを取得できる",
+			userID: "user-1",
+			setupMock: func(m *mock.MockProfileRepository) {
+				m.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(model.Profile{
+					UserID:             "user-1",
+					DisplayName:        "山田 太郎",
+					WorkStyle:          model.WorkStyleRemote,
+					PreferredLocations: []string{"東京都"},
+					Certifications:     []model.Certification{{Name: "AWS SAA", Issuer: "AWS", AcquiredMonth: acquired}},
+				}, nil)
+			},
+			want: usecase.ProfileDto{
+				DisplayName:        "山田 太郎",
+				WorkStyle:          "remote",
+				PreferredLocations: []string{"東京都"},
+				Certifications:     []usecase.CertificationDto{{Name: "AWS SAA", Issuer: "AWS", AcquiredMonth: &acquired}},
+				Educations:         []usecase.EducationDto{},
+			},
+		},
+		{
+			name:   "正常系: 未登録の場合は空のプロフィールを返す",
+			userID: "user-1",
+			setupMock: func(m *mock.MockProfileRepository) {
+				m.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(model.Profile{}, repository.ErrNotFound)
+			},
+			want: usecase.ProfileDto{
+				PreferredLocations: []string{},
+				Certifications:     []usecase.CertificationDto{},
+				Educations:         []usecase.EducationDto{},
+			},
+		},
+		{
+			name:      "異常系: ユーザーが設定されていない",
+			setupMock: func(m *mock.MockProfileRepository) {},
+			wantErr:   usecase.ErrUnauthenticated,
+		},
+		{
+			name:   "異常系: repository.FindByUserIDがエラーを返す",
+			userID: "user-1",
+			setupMock: func(m *mock.MockProfileRepository) {
+				m.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(model.Profile{}, errors.New("DB error"))
+			},
+			wantErr: errors.New("DB error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock.NewMockProfileRepository(ctrl)
+			mockAuditRepo := mock.NewMockAuditLogRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			ctx := context.Background()
+			if tt.userID != "" {
+				ctx = usecase.ContextWithUserID(ctx, tt.userID)
+			}
+
+			uc := usecase.NewProfileUsecase(mockRepo, mockAuditRepo, newTransactionManager(ctrl), discardLogger)
+			got, err := uc.Get(ctx)
+
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestProfileUsecase_Put(t *testing.T) {
+	start := time.Date(2015, time.April, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2019, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	validInput := usecase.ProfileInput{
+		DisplayName: "山田 太郎",
+		WorkStyle:   "hybrid",
+		Certifications: []usecase.CertificationDto{
+			{Name: " 基本情報技術者 ", Issuer: "IPA"},
+		},
+		Educations: []usecase.EducationDto{
+			{SchoolName: "東京大学", Faculty: "工学部", Degree: "学士", StartMonth: &start, EndMonth: &end},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		input     usecase.ProfileInput
+		setupMock func(*mock.MockProfileRepository, *mock.MockAuditLogRepository)
+		wantErr   error
+	}{
+		{
+			name:  "正常系: 新規登録の場合は作成として監査ログを記録する",
+			input: validInput,
+			setupMock: func(m *mock.MockProfileRepository, a *mock.MockAuditLogRepository) {
+				m.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(model.Profile{}, repository.ErrNotFound)
+				m.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, profile model.Profile) (model.Profile, error) {
+					assert.Equal(t, "user-1", profile.UserID)
+					assert.Equal(t, model.WorkStyleHybrid, profile.WorkStyle)
+					assert.Equal(t, "基本情報技術者", profile.Certifications[0].Name)
+					assert.Equal(t, start, profile.Educations[0].Period.Start())
+					return profile, nil
+				})
+				a.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, auditLog model.AuditLog) error {
+					assert.Equal(t, usecase.AuditActionCreate, auditLog.Action)
+					assert.Equal(t, usecase.AuditEntityProfile, auditLog.EntityType)
+					assert.Equal(t, "user-1", auditLog.EntityID)
+					assert.Nil(t, auditLog.Before)
+					assert.NotNil(t, auditLog.After)
+					return nil
+				})
+			},
+		},
+		{
+			name:  "正常系: 登録済みの場合は更新として監査ログを記録する",
+			input: validInput,
+			setupMock: func(m *mock.MockProfileRepository, a *mock.MockAuditLogRepository) {
+				m.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(model.Profile{UserID: "user-1", DisplayName: "旧名"}, nil)
+				m.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, profile model.Profile) (model.Profile, error) {
+					return profile, nil
+				})
+				a.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, auditLog model.AuditLog) error {
+					assert.Equal(t, usecase.AuditActionUpdate, auditLog.Action)
+					assert.Contains(t, string(auditLog.Before), "旧名")
+					return nil
+				})
+			},
+		},
+		{
+			name:      "異常系: 働き方が不正",
+			input:     usecase.ProfileInput{WorkStyle: "freelance"},
+			setupMock: func(m *mock.MockProfileRepository, a *mock.MockAuditLogRepository) {},
+			wantErr:   usecase.ErrInvalidInput,
+		},
+		{
+			name:      "異常系: 資格名が空",
+			input:     usecase.ProfileInput{Certifications: []usecase.CertificationDto{{Issuer: "IPA"}}},
+			setupMock: func(m *mock.MockProfileRepository, a *mock.MockAuditLogRepository) {},
+			wantErr:   usecase.ErrInvalidInput,
+		},
+		{
+			name:      "異常系: 学歴の終了月が開始月より前",
+			input:     usecase.ProfileInput{Educations: []usecase.EducationDto{{SchoolName: "東京大学", StartMonth: &end, EndMonth: &start}}},
+			setupMock: func(m *mock.MockProfileRepository, a *mock.MockAuditLogRepository) {},
+			wantErr:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "異常系: repository.Saveがエラーを返す",
+			input: validInput,
+			setupMock: func(m *mock.MockProfileRepository, a *mock.MockAuditLogRepository) {
+				m.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(model.Profile{}, repository.ErrNotFound)
+				m.EXPECT().Save(gomock.Any(), gomock.Any()).Return(model.Profile{}, errors.New("DB error"))
+			},
+			wantErr: errors.New("DB error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock.NewMockProfileRepository(ctrl)
+			mockAuditRepo := mock.NewMockAuditLogRepository(ctrl)
+			tt.setupMock(mockRepo, mockAuditRepo)
+
+			ctx := usecase.ContextWithUserID(context.Background(), "user-1")
+
+			uc := usecase.NewProfileUsecase(mockRepo, mockAuditRepo, newTransactionManager(ctrl), discardLogger)
+			got, err := uc.Put(ctx, tt.input)
+
+			if errors.Is(tt.wantErr, usecase.ErrInvalidInput) {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			} else if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "山田 太郎", got.DisplayName)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+//go:generate mockgen -source=resume_usecase.go -destination=mock/mock_$GOFILE -package=mock
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"time"
+)
+
+// ResumeDto は業務経歴書（スキルシート）の内容です
+type ResumeDto struct {
+	Profile ProfileDto
+	// Skills は言語・ツールごとの経験期間と習熟度で、経験月数の多い順です（業務経歴で使っていない評価だけのスキルは最後）
+	Skills []SkillSummaryDto
+	// Experiences は参画期間の開始月の古い順です（期間が未設定の業務経歴は最後）
+	Experiences []ExperienceDto
+	// GeneratedAt は作成日時です。経験月数は参画中の案件をこの月までとして数えます
+	GeneratedAt time.Time
+	// Anonymized は匿名化した業務経歴書であることを表します。チーム人数は丸めた値です
+	Anonymized bool
+}
+
+// ResumeOptions は業務経歴書の出力方法です
+type ResumeOptions struct {
+	// Anonymize は顧客名を公開用の名前に置き換え、チーム人数を丸め、氏名をイニシャルにすることを表します
+	// 自己PR・学校名・在学期間・資格の取得月も除きます
+	Anonymize bool
+}
+
+// SkillSummaryDto はスキルごとの経験期間です
+type SkillSummaryDto struct {
+	Category string
+	Name     string
+	Months   int
+	// LastUsedMonth は最後に使った月の月初です（nil は期間が未設定の案件でのみ使用）
+	LastUsedMonth   *time.Time
+	ExperienceCount int
+	// SelfLevel は自己評価の習熟度（1〜5）、PeerLevel は推薦の習熟度の平均です（いずれも 0 は評価なし）
+	SelfLevel        int
+	PeerLevel        float64
+	EndorsementCount int
+}
+
+type resumeUsecase struct {
+	profileRepository         repository.ProfileRepository
+	experienceRepository      repository.ExperienceRepository
+	skillAssessmentRepository repository.SkillAssessmentRepository
+	logger                    *slog.Logger
+}
+
+// Get implements ResumeUsecase.
+func (r *resumeUsecase) Get(ctx context.Context, options ResumeOptions) (_ ResumeDto, err error) {
+	ctx, span := tracer.Start(ctx, "ResumeUsecase.Get")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return ResumeDto{}, ErrUnauthenticated
+	}
+
+	profile, err := findProfile(ctx, r.profileRepository, userID)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to get profile", slog.Any("error", err))
+		return ResumeDto{}, err
+	}
+	experiences, err := r.experienceRepository.FindByUserID(ctx, userID)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to get experiences", slog.Any("error", err))
+		return ResumeDto{}, err
+	}
+	levels, err := findSkillLevels(ctx, r.skillAssessmentRepository, userID)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to get skill levels", slog.Any("error", err))
+		return ResumeDto{}, err
+	}
+	resume := newResumeDto(profile, experiences, time.Now())
+	resume.Skills = withSkillLevels(resume.Skills, levels)
+	if options.Anonymize {
+		resume = anonymizeResume(resume)
+	}
+	return resume, nil
+}
+
+func newResumeDto(profile model.Profile, experiences []model.Experience, now time.Time) ResumeDto {
+	sorted := make([]model.Experience, len(experiences))
+	copy(sorted, experiences)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		pi, pj := sorted[i].Period, sorted[j].Period
+		if pi.IsZero() || pj.IsZero() {
+			return !pi.IsZero() && pj.IsZero()
+		}
+		return pi.Start().Before(pj.Start())
+	})
+
+	resume := ResumeDto{
+		Profile:     toProfileDto(profile),
+		Experiences: make([]ExperienceDto, len(sorted)),
+		GeneratedAt: now,
+	}
+	for i, experience := range sorted {
+		resume.Experiences[i] = toExperienceDto(experience)
+	}
+	summaries := model.SummarizeSkills(sorted, now)
+	resume.Skills = make([]SkillSummaryDto, len(summaries))
+	for i, summary := range summaries {
+		resume.Skills[i] = SkillSummaryDto{
+			Category:        string(summary.Skill.Category),
+			Name:            summary.Skill.Name,
+			Months:          summary.Months,
+			ExperienceCount: summary.ExperienceCount,
+		}
+		if !summary.LastUsed.IsZero() {
+			lastUsed := summary.LastUsed
+			resume.Skills[i].LastUsedMonth = &lastUsed
+		}
+	}
+	return resume
+}
+
+// anonymizeResume は顧客に提出できるよう業務経歴書を匿名化します
+// 顧客名は公開用の名前に置き換え、チーム人数は丸め、氏名はイニシャルにして最寄り駅を除きます
+// 本人を特定できる自己PR（自由記述）と学校名・在学期間、資格の取得月も除き、資格名と学部・学位だけを残します
+func anonymizeResume(resume ResumeDto) ResumeDto {
+	resume = hideClientNames(resume)
+	profile := resume.Profile
+	if profile.Initials != "" {
+		profile.DisplayName = profile.Initials
+	} else {
+		profile.DisplayName = model.NameInitials(profile.DisplayName)
+	}
+	profile.NearestStation = ""
+	profile.SelfPR = ""
+	certifications := make([]CertificationDto, len(profile.Certifications))
+	for i, certification := range profile.Certifications {
+		certifications[i] = CertificationDto{Name: certification.Name, Issuer: certification.Issuer}
+	}
+	profile.Certifications = certifications
+	educations := make([]EducationDto, len(profile.Educations))
+	for i, education := range profile.Educations {
+		educations[i] = EducationDto{Faculty: education.Faculty, Degree: education.Degree}
+	}
+	profile.Educations = educations
+	resume.Profile = profile
+
+	for i, experience := range resume.Experiences {
+		// 保存済みの値のため、チーム人数は常に制約を満たす
+		teamSize, _ := model.NewTeamSize(experience.TeamSize)
+		resume.Experiences[i].TeamSize = teamSize.Approximate().Int()
+	}
+	resume.Anonymized = true
+	return resume
+}
+
+// hideClientNames は業務経歴の顧客名を除きます。公開用の名前はそのまま残します
+func hideClientNames(resume ResumeDto) ResumeDto {
+	experiences := make([]ExperienceDto, len(resume.Experiences))
+	for i, experience := range resume.Experiences {
+		experience.ClientName = ""
+		experiences[i] = experience
+	}
+	resume.Experiences = experiences
+	return resume
+}
+
+type ResumeUsecase interface {
+	// Get はログイン中のユーザーの業務経歴書の内容を返します
+	Get(ctx context.Context, options ResumeOptions) (ResumeDto, error)
+}
+
+func NewResumeUsecase(
+	profileRepository repository.ProfileRepository,
+	experienceRepository repository.ExperienceRepository,
+	skillAssessmentRepository repository.SkillAssessmentRepository,
+	logger *slog.Logger,
+) ResumeUsecase {
+	return &resumeUsecase{
+		profileRepository:         profileRepository,
+		experienceRepository:      experienceRepository,
+		skillAssessmentRepository: skillAssessmentRepository,
+		logger:                    logger.With(slog.String("usecase", "resume")),
+	}
+}
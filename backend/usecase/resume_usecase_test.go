@@ -0,0 +1,223 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"stackies/backend/domain/repository/mock"
+	"stackies/backend/usecase"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newExperienceInPeriod はテスト用の期間とスキルを持つ業務経歴エンティティを作成します
+func newExperienceInPeriod(t *testing.T, id int, title string, start time.Time, end time.Time, skills ...model.Skill) model.Experience {
+	t.Helper()
+	experience := newExperience(t, id, title, 1)
+	period, err := model.NewPeriod(start, &end)
+	require.NoError(t, err)
+	experience.Period = period
+	experience.Skills = skills
+	return experience
+}
+
+func TestResumeUsecase_Get(t *testing.T) {
+	goSkill := model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}
+	awsSkill := model.Skill{Category: model.SkillCategoryTool, Name: "AWS"}
+	month := func(year int, m time.Month) time.Time { return time.Date(year, m, 1, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name      string
+		userID    string
+		options   usecase.ResumeOptions
+		setupMock func(*mock.MockProfileRepository, *mock.MockExperienceRepository, *mock.MockSkillAssessmentRepository)
+		check     func(*testing.T, usecase.ResumeDto)
+		wantErr   error
+	}{
+		{
+			name:   "正常系: 業務経歴を参画期間の古い順に並べ、スキルの経験期間と習熟度を集計する",
+			userID: "user-1",
+			setupMock: func(p *mock.MockProfileRepository, e *mock.MockExperienceRepository, s *mock.MockSkillAssessmentRepository) {
+				p.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(model.Profile{UserID: "user-1", DisplayName: "山田 太郎"}, nil)
+				e.EXPECT().FindByUserID(gomock.Any(), "user-1").Return([]model.Experience{
+					newExperience(t, 3, "期間未設定", 1),
+					newExperienceInPeriod(t, 2, "新しい案件", month(2024, time.April), month(2024, time.September), goSkill),
+					newExperienceInPeriod(t, 1, "古い案件", month(2022, time.April), month(2023, time.March), goSkill),
+				}, nil)
+				s.EXPECT().FindByUserID(gomock.Any(), "user-1").Return([]model.SkillAssessment{
+					{UserID: "user-1", Skill: goSkill, Level: 4},
+					{UserID: "user-1", Skill: awsSkill, Level: 2},
+				}, nil)
+				s.EXPECT().FindEndorsementsByUserID(gomock.Any(), "user-1").Return([]model.SkillEndorsement{
+					{ID: 1, UserID: "user-1", EndorserID: "user-2", Skill: goSkill, Level: 3},
+				}, nil)
+			},
+			check: func(t *testing.T, resume usecase.ResumeDto) {
+				assert.Equal(t, "山田 太郎", resume.Profile.DisplayName)
+				titles := make([]string, len(resume.Experiences))
+				for i, experience := range resume.Experiences {
+					titles[i] = experience.Title
+				}
+				assert.Equal(t, []string{"古い案件", "新しい案件", "期間未設定"}, titles)
+				lastUsed := month(2024, time.September)
+				assert.Equal(t, []usecase.SkillSummaryDto{
+					{Category: "language", Name: "Go", Months: 18, LastUsedMonth: &lastUsed, ExperienceCount: 2, SelfLevel: 4, PeerLevel: 3, EndorsementCount: 1},
+					{Category: "tool", Name: "AWS", SelfLevel: 2},
+				}, resume.Skills)
+			},
+		},
+		{
+			name:    "正常系: 匿名化すると顧客名を除き、チーム人数を丸め、氏名をイニシャルにする",
+			userID:  "user-1",
+			options: usecase.ResumeOptions{Anonymize: true},
+			setupMock: func(p *mock.MockProfileRepository, e *mock.MockExperienceRepository, s *mock.MockSkillAssessmentRepository) {
+				p.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(model.Profile{UserID: "user-1", DisplayName: "Taro Yamada", NearestStation: "渋谷駅"}, nil)
+				experience := newExperienceInPeriod(t, 1, "勘定系システム刷新", month(2023, time.April), month(2024, time.March), goSkill)
+				client, err := model.NewClient("株式会社〇〇銀行", "大手金融機関向け")
+				require.NoError(t, err)
+				experience.Client = client
+				teamSize, err := model.NewTeamSize(23)
+				require.NoError(t, err)
+				experience.TeamSize = teamSize
+				e.EXPECT().FindByUserID(gomock.Any(), "user-1").Return([]model.Experience{experience}, nil)
+				s.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(nil, nil)
+				s.EXPECT().FindEndorsementsByUserID(gomock.Any(), "user-1").Return(nil, nil)
+			},
+			check: func(t *testing.T, resume usecase.ResumeDto) {
+				assert.True(t, resume.Anonymized)
+				assert.Equal(t, "T.Y.", resume.Profile.DisplayName)
+				assert.Empty(t, resume.Profile.NearestStation)
+				require.Len(t, resume.Experiences, 1)
+				assert.Empty(t, resume.Experiences[0].ClientName)
+				assert.Equal(t, "大手金融機関向け", resume.Experiences[0].ClientAlias)
+				assert.Equal(t, 20, resume.Experiences[0].TeamSize)
+			},
+		},
+		{
+			name:    "正常系: 匿名化するとプロフィールから本人を特定できる項目を除く",
+			userID:  "user-1",
+			options: usecase.ResumeOptions{Anonymize: true},
+			setupMock: func(p *mock.MockProfileRepository, e *mock.MockExperienceRepository, s *mock.MockSkillAssessmentRepository) {
+				certification, err := model.NewCertification("AWS Certified Solutions Architect - Associate", "Amazon Web Services", month(2022, time.June))
+				require.NoError(t, err)
+				graduated := month(2018, time.March)
+				studyPeriod, err := model.NewPeriod(month(2014, time.April), &graduated)
+				require.NoError(t, err)
+				education, err := model.NewEducation("〇〇大学", "工学部 情報工学科", "学士", studyPeriod)
+				require.NoError(t, err)
+				p.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(model.Profile{
+					UserID:             "user-1",
+					DisplayName:        "山田 太郎",
+					Initials:           "T.Y.",
+					Headline:           "バックエンドエンジニア / Go・AWS",
+					SelfPR:             "〇〇銀行の勘定系を5年担当した山田です",
+					NearestStation:     "渋谷駅",
+					WorkStyle:          model.WorkStyleRemote,
+					PreferredLocations: []string{"東京都"},
+					Certifications:     []model.Certification{certification},
+					Educations:         []model.Education{education},
+				}, nil)
+				e.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(nil, nil)
+				s.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(nil, nil)
+				s.EXPECT().FindEndorsementsByUserID(gomock.Any(), "user-1").Return(nil, nil)
+			},
+			check: func(t *testing.T, resume usecase.ResumeDto) {
+				assert.Equal(t, usecase.ProfileDto{
+					DisplayName:        "T.Y.",
+					Initials:           "T.Y.",
+					Headline:           "バックエンドエンジニア / Go・AWS",
+					WorkStyle:          string(model.WorkStyleRemote),
+					PreferredLocations: []string{"東京都"},
+					Certifications:     []usecase.CertificationDto{{Name: "AWS Certified Solutions Architect - Associate", Issuer: "Amazon Web Services"}},
+					Educations:         []usecase.EducationDto{{Faculty: "工学部 情報工学科", Degree: "学士"}},
+				}, resume.Profile)
+			},
+		},
+		{
+			name:    "正常系: 匿名化する場合は登録したイニシャルを優先する",
+			userID:  "user-1",
+			options: usecase.ResumeOptions{Anonymize: true},
+			setupMock: func(p *mock.MockProfileRepository, e *mock.MockExperienceRepository, s *mock.MockSkillAssessmentRepository) {
+				p.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(model.Profile{UserID: "user-1", DisplayName: "山田 太郎", Initials: "T.Y."}, nil)
+				e.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(nil, nil)
+				s.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(nil, nil)
+				s.EXPECT().FindEndorsementsByUserID(gomock.Any(), "user-1").Return(nil, nil)
+			},
+			check: func(t *testing.T, resume usecase.ResumeDto) {
+				assert.Equal(t, "T.Y.", resume.Profile.DisplayName)
+			},
+		},
+		{
+			name:   "正常系: プロフィールが未登録でも作成できる",
+			userID: "user-1",
+			setupMock: func(p *mock.MockProfileRepository, e *mock.MockExperienceRepository, s *mock.MockSkillAssessmentRepository) {
+				p.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(model.Profile{}, repository.ErrNotFound)
+				e.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(nil, nil)
+				s.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(nil, nil)
+				s.EXPECT().FindEndorsementsByUserID(gomock.Any(), "user-1").Return(nil, nil)
+			},
+			check: func(t *testing.T, resume usecase.ResumeDto) {
+				assert.Empty(t, resume.Profile.DisplayName)
+				assert.Empty(t, resume.Experiences)
+				assert.Empty(t, resume.Skills)
+			},
+		},
+		{
+			name:      "異常系: ユーザーが設定されていない",
+			setupMock: func(p *mock.MockProfileRepository, e *mock.MockExperienceRepository, s *mock.MockSkillAssessmentRepository) {},
+			wantErr:   usecase.ErrUnauthenticated,
+		},
+		{
+			name:   "異常系: 習熟度の取得に失敗",
+			userID: "user-1",
+			setupMock: func(p *mock.MockProfileRepository, e *mock.MockExperienceRepository, s *mock.MockSkillAssessmentRepository) {
+				p.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(model.Profile{UserID: "user-1"}, nil)
+				e.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(nil, nil)
+				s.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(nil, errors.New("DB error"))
+			},
+			wantErr: errors.New("DB error"),
+		},
+		{
+			name:   "異常系: 業務経歴の取得に失敗",
+			userID: "user-1",
+			setupMock: func(p *mock.MockProfileRepository, e *mock.MockExperienceRepository, s *mock.MockSkillAssessmentRepository) {
+				p.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(model.Profile{UserID: "user-1"}, nil)
+				e.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(nil, errors.New("DB error"))
+			},
+			wantErr: errors.New("DB error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockProfileRepo := mock.NewMockProfileRepository(ctrl)
+			mockExperienceRepo := mock.NewMockExperienceRepository(ctrl)
+			mockSkillAssessmentRepo := mock.NewMockSkillAssessmentRepository(ctrl)
+			tt.setupMock(mockProfileRepo, mockExperienceRepo, mockSkillAssessmentRepo)
+
+			ctx := context.Background()
+			if tt.userID != "" {
+				ctx = usecase.ContextWithUserID(ctx, tt.userID)
+			}
+
+			uc := usecase.NewResumeUsecase(mockProfileRepo, mockExperienceRepo, mockSkillAssessmentRepo, discardLogger)
+			got, err := uc.Get(ctx, tt.options)
+
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, got)
+		})
+	}
+}
@@ -0,0 +1,428 @@
+//go:generate mockgen -source=share_link_usecase.go -destination=mock/mock_$GOFILE -package=mock
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// defaultShareLinkDays は有効期間を指定しなかった場合の共有リンクの有効日数です
+	defaultShareLinkDays = 30
+	// shareTokenBytes は共有リンクのトークンの長さ（バイト）です
+	shareTokenBytes = 32
+	// 閲覧用パスワードの長さの上限・下限（bcrypt は 72 バイトまでしか扱えない）
+	sharePasswordMinLength = 8
+	sharePasswordMaxBytes  = 72
+)
+
+// ShareLinkDto は共有リンクです。トークンは作成時にのみ返します
+type ShareLinkDto struct {
+	ID                int       `json:"id"`
+	Label             string    `json:"label"`
+	Sections          []string  `json:"sections"`
+	HideClientNames   bool      `json:"hide_client_names"`
+	HidePeriod        bool      `json:"hide_period"`
+	Anonymize         bool      `json:"anonymize"`
+	PasswordProtected bool      `json:"password_protected"`
+	ExpiresAt         time.Time `json:"expires_at"`
+	// RevokedAt は無効にした日時です（nil は有効）
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	ViewCount int        `json:"view_count"`
+	// LastViewedAt は最後に閲覧された日時です（nil は未閲覧）
+	LastViewedAt *time.Time `json:"last_viewed_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	// Active は現在閲覧できるか（無効にされておらず期限切れでないか）です
+	Active bool `json:"active"`
+}
+
+// CreatedShareLinkDto は作成した共有リンクです
+type CreatedShareLinkDto struct {
+	ShareLinkDto
+	// Token は共有リンクのトークンです。保存していないため、再表示はできません
+	Token string
+}
+
+// ShareLinkInput は共有リンクの作成の入力値です
+type ShareLinkInput struct {
+	Label string
+	// Sections は公開する項目です（空は全ての項目）
+	Sections        []string
+	HideClientNames bool
+	HidePeriod      bool
+	Anonymize       bool
+	// ExpiresInDays は有効日数です（0 は 30 日）
+	ExpiresInDays int
+	// Password は閲覧用パスワードです（空はパスワードなし）
+	Password string
+}
+
+// SharedResumeDto は共有リンクで公開する業務経歴書です
+// 公開しない項目は空にしてあります
+type SharedResumeDto struct {
+	Resume    ResumeDto
+	Sections  []string
+	ExpiresAt time.Time
+}
+
+type shareLinkUsecase struct {
+	shareLinkRepository       repository.ShareLinkRepository
+	profileRepository         repository.ProfileRepository
+	experienceRepository      repository.ExperienceRepository
+	skillAssessmentRepository repository.SkillAssessmentRepository
+	transactionManager        repository.TransactionManager
+	audit                     auditRecorder
+	logger                    *slog.Logger
+}
+
+// Create implements ShareLinkUsecase.
+func (s *shareLinkUsecase) Create(ctx context.Context, input ShareLinkInput) (_ CreatedShareLinkDto, err error) {
+	ctx, span := tracer.Start(ctx, "ShareLinkUsecase.Create")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return CreatedShareLinkDto{}, ErrUnauthenticated
+	}
+	sections, err := model.NewShareSections(input.Sections)
+	if err != nil {
+		return CreatedShareLinkDto{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	days := input.ExpiresInDays
+	if days == 0 {
+		days = defaultShareLinkDays
+	}
+	if days < 0 {
+		return CreatedShareLinkDto{}, fmt.Errorf("%w: expires in days must be positive", ErrInvalidInput)
+	}
+	passwordHash, err := hashSharePassword(input.Password)
+	if err != nil {
+		return CreatedShareLinkDto{}, err
+	}
+	token, err := newShareToken()
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to generate share token", slog.Any("error", err))
+		return CreatedShareLinkDto{}, err
+	}
+
+	now := time.Now()
+	visibility := model.ShareVisibility{
+		Sections:        sections,
+		HideClientNames: input.HideClientNames,
+		HidePeriod:      input.HidePeriod,
+		Anonymize:       input.Anonymize,
+	}
+	link, err := model.NewShareLink(userID, input.Label, hashShareToken(token), passwordHash, visibility, now.AddDate(0, 0, days), now)
+	if err != nil {
+		return CreatedShareLinkDto{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	var created model.ShareLink
+	err = s.transactionManager.Do(ctx, func(ctx context.Context) error {
+		created, err = s.shareLinkRepository.Create(ctx, *link)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to create share link", slog.Any("error", err))
+			return err
+		}
+		if err := s.audit.record(ctx, AuditActionCreate, AuditEntityShareLink, created.ID, nil, toShareLinkDto(created, now)); err != nil {
+			s.logger.ErrorContext(ctx, "failed to record audit log", slog.Any("error", err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return CreatedShareLinkDto{}, err
+	}
+	s.logger.InfoContext(ctx, "share link created", slog.Int("share_link_id", created.ID))
+	return CreatedShareLinkDto{ShareLinkDto: toShareLinkDto(created, now), Token: token}, nil
+}
+
+// List implements ShareLinkUsecase.
+func (s *shareLinkUsecase) List(ctx context.Context) (_ []ShareLinkDto, err error) {
+	ctx, span := tracer.Start(ctx, "ShareLinkUsecase.List")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return nil, ErrUnauthenticated
+	}
+	links, err := s.shareLinkRepository.FindByUserID(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get share links", slog.Any("error", err))
+		return nil, err
+	}
+	now := time.Now()
+	dtos := make([]ShareLinkDto, len(links))
+	for i, link := range links {
+		dtos[i] = toShareLinkDto(link, now)
+	}
+	return dtos, nil
+}
+
+// Revoke implements ShareLinkUsecase.
+// 他のユーザーの共有リンクは存在しないものとして扱います。無効にした共有リンクを再度無効にしてもエラーにしません
+func (s *shareLinkUsecase) Revoke(ctx context.Context, id int) (err error) {
+	ctx, span := tracer.Start(ctx, "ShareLinkUsecase.Revoke")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return ErrUnauthenticated
+	}
+
+	now := time.Now()
+	err = s.transactionManager.Do(ctx, func(ctx context.Context) error {
+		current, err := s.shareLinkRepository.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if current.UserID != userID {
+			return ErrNotFound
+		}
+		if !current.RevokedAt.IsZero() {
+			return nil
+		}
+		if err := s.shareLinkRepository.Revoke(ctx, id, now); err != nil {
+			s.logger.ErrorContext(ctx, "failed to revoke share link", slog.Any("error", err))
+			return err
+		}
+		revoked := current
+		revoked.RevokedAt = now
+		if err := s.audit.record(ctx, AuditActionUpdate, AuditEntityShareLink, id, toShareLinkDto(current, now), toShareLinkDto(revoked, now)); err != nil {
+			s.logger.ErrorContext(ctx, "failed to record audit log", slog.Any("error", err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.logger.InfoContext(ctx, "share link revoked", slog.Int("share_link_id", id))
+	return nil
+}
+
+// View implements ShareLinkUsecase.
+// 存在しない・期限切れ・無効にした共有リンクはいずれも ErrNotFound を返し、区別できないようにします
+func (s *shareLinkUsecase) View(ctx context.Context, token, password string) (_ SharedResumeDto, err error) {
+	ctx, span := tracer.Start(ctx, "ShareLinkUsecase.View")
+	defer func() { endSpan(span, err) }()
+
+	if token == "" {
+		return SharedResumeDto{}, ErrNotFound
+	}
+	link, err := s.shareLinkRepository.FindByTokenHash(ctx, hashShareToken(token))
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			s.logger.ErrorContext(ctx, "failed to get share link", slog.Any("error", err))
+		}
+		return SharedResumeDto{}, err
+	}
+	now := time.Now()
+	if !link.IsActive(now) {
+		return SharedResumeDto{}, ErrNotFound
+	}
+	if link.HasPassword() {
+		if password == "" {
+			return SharedResumeDto{}, ErrSharePasswordRequired
+		}
+		if bcrypt.CompareHashAndPassword([]byte(link.PasswordHash), []byte(password)) != nil {
+			s.logger.WarnContext(ctx, "share link password mismatch", slog.Int("share_link_id", link.ID))
+			return SharedResumeDto{}, ErrSharePasswordRequired
+		}
+	}
+
+	profile, err := findProfile(ctx, s.profileRepository, link.UserID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get profile", slog.Any("error", err))
+		return SharedResumeDto{}, err
+	}
+	experiences, err := s.experienceRepository.FindByUserID(ctx, link.UserID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get experiences", slog.Any("error", err))
+		return SharedResumeDto{}, err
+	}
+	levels, err := findSkillLevels(ctx, s.skillAssessmentRepository, link.UserID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get skill levels", slog.Any("error", err))
+		return SharedResumeDto{}, err
+	}
+	if err := s.shareLinkRepository.IncrementViewCount(ctx, link.ID, now); err != nil {
+		s.logger.ErrorContext(ctx, "failed to count share link view", slog.Any("error", err))
+		return SharedResumeDto{}, err
+	}
+	s.logger.InfoContext(ctx, "share link viewed", slog.Int("share_link_id", link.ID))
+
+	resume := newResumeDto(profile, experiences, now)
+	resume.Skills = withSkillLevels(resume.Skills, levels)
+	return SharedResumeDto{
+		Resume:    filterSharedResume(link.ShareVisibility, resume),
+		Sections:  shareSectionStrings(link.Sections),
+		ExpiresAt: link.ExpiresAt,
+	}, nil
+}
+
+// filterSharedResume は共有リンクで公開しない項目を業務経歴書から取り除きます
+func filterSharedResume(visibility model.ShareVisibility, resume ResumeDto) ResumeDto {
+	switch {
+	case visibility.Anonymize:
+		resume = anonymizeResume(resume)
+	case visibility.HideClientNames:
+		resume = hideClientNames(resume)
+	}
+	profile := resume.Profile
+	shared := ResumeDto{
+		Profile:     ProfileDto{PreferredLocations: []string{}, Certifications: []CertificationDto{}, Educations: []EducationDto{}},
+		Skills:      []SkillSummaryDto{},
+		Experiences: []ExperienceDto{},
+		GeneratedAt: resume.GeneratedAt,
+		Anonymized:  resume.Anonymized,
+	}
+	if visibility.Shows(model.ShareSectionProfile) {
+		shared.Profile.DisplayName = profile.DisplayName
+		shared.Profile.Initials = profile.Initials
+		shared.Profile.Headline = profile.Headline
+		shared.Profile.NearestStation = profile.NearestStation
+		shared.Profile.WorkStyle = profile.WorkStyle
+		shared.Profile.PreferredLocations = profile.PreferredLocations
+	}
+	if visibility.Shows(model.ShareSectionSelfPR) {
+		shared.Profile.SelfPR = profile.SelfPR
+	}
+	if visibility.Shows(model.ShareSectionCertifications) {
+		shared.Profile.Certifications = profile.Certifications
+	}
+	if visibility.Shows(model.ShareSectionEducations) {
+		shared.Profile.Educations = profile.Educations
+	}
+	if visibility.Shows(model.ShareSectionSkills) {
+		for _, skill := range resume.Skills {
+			if visibility.HidePeriod {
+				skill.LastUsedMonth = nil
+			}
+			shared.Skills = append(shared.Skills, skill)
+		}
+	}
+	if visibility.Shows(model.ShareSectionExperiences) {
+		for _, experience := range resume.Experiences {
+			// 共有先に業務経歴の ID は不要
+			experience.ID = 0
+			if visibility.HidePeriod {
+				experience.StartMonth = nil
+				experience.EndMonth = nil
+			}
+			shared.Experiences = append(shared.Experiences, experience)
+		}
+	}
+	return shared
+}
+
+// newShareToken は推測できない共有リンクのトークン（URL に使える文字列）を生成します
+func newShareToken() (string, error) {
+	b := make([]byte, shareTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashShareToken はトークンを保存・照合するための SHA-256 のハッシュ値（16進数）を返します
+// トークンは十分に長い乱数のため、ソルトなしのハッシュで照合します
+func hashShareToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// hashSharePassword は閲覧用パスワードの bcrypt のハッシュ値を返します（空のパスワードは空文字）
+func hashSharePassword(password string) (string, error) {
+	if password == "" {
+		return "", nil
+	}
+	if utf8.RuneCountInString(password) < sharePasswordMinLength {
+		return "", fmt.Errorf("%w: password must be at least %d characters", ErrInvalidInput, sharePasswordMinLength)
+	}
+	if len(password) > sharePasswordMaxBytes {
+		return "", fmt.Errorf("%w: password must be at most %d bytes", ErrInvalidInput, sharePasswordMaxBytes)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+func toShareLinkDto(link model.ShareLink, now time.Time) ShareLinkDto {
+	dto := ShareLinkDto{
+		ID:                link.ID,
+		Label:             link.Label,
+		Sections:          shareSectionStrings(link.Sections),
+		HideClientNames:   link.HideClientNames,
+		HidePeriod:        link.HidePeriod,
+		Anonymize:         link.Anonymize,
+		PasswordProtected: link.HasPassword(),
+		ExpiresAt:         link.ExpiresAt,
+		ViewCount:         link.ViewCount,
+		CreatedAt:         link.CreatedAt,
+		Active:            link.IsActive(now),
+	}
+	if !link.RevokedAt.IsZero() {
+		revokedAt := link.RevokedAt
+		dto.RevokedAt = &revokedAt
+	}
+	if !link.LastViewedAt.IsZero() {
+		lastViewedAt := link.LastViewedAt
+		dto.LastViewedAt = &lastViewedAt
+	}
+	return dto
+}
+
+func shareSectionStrings(sections []model.ShareSection) []string {
+	values := make([]string, len(sections))
+	for i, s := range sections {
+		values[i] = string(s)
+	}
+	return values
+}
+
+type ShareLinkUsecase interface {
+	// Create はログイン中のユーザーの業務経歴書の共有リンクを作成します
+	Create(ctx context.Context, input ShareLinkInput) (CreatedShareLinkDto, error)
+	// List はログイン中のユーザーの共有リンクを作成日時の新しい順に返します
+	List(ctx context.Context) ([]ShareLinkDto, error)
+	// Revoke はログイン中のユーザーの共有リンクを無効にします
+	Revoke(ctx context.Context, id int) error
+	// View はトークンの共有リンクで公開している業務経歴書を返し、閲覧数を1つ増やします
+	// パスワードが必要な共有リンクでパスワードが一致しない場合は ErrSharePasswordRequired を返します
+	View(ctx context.Context, token, password string) (SharedResumeDto, error)
+}
+
+func NewShareLinkUsecase(
+	shareLinkRepository repository.ShareLinkRepository,
+	profileRepository repository.ProfileRepository,
+	experienceRepository repository.ExperienceRepository,
+	skillAssessmentRepository repository.SkillAssessmentRepository,
+	auditLogRepository repository.AuditLogRepository,
+	transactionManager repository.TransactionManager,
+	logger *slog.Logger,
+) ShareLinkUsecase {
+	return &shareLinkUsecase{
+		shareLinkRepository:       shareLinkRepository,
+		profileRepository:         profileRepository,
+		experienceRepository:      experienceRepository,
+		skillAssessmentRepository: skillAssessmentRepository,
+		transactionManager:        transactionManager,
+		audit:                     auditRecorder{auditLogRepository: auditLogRepository},
+		logger:                    logger.With(slog.String("usecase", "share_link")),
+	}
+}
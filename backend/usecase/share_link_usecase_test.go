@@ -0,0 +1,509 @@
+package usecase_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"stackies/backend/domain/repository/mock"
+	"stackies/backend/usecase"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type shareLinkMocks struct {
+	shareLink  *mock.MockShareLinkRepository
+	profile    *mock.MockProfileRepository
+	experience *mock.MockExperienceRepository
+	skill      *mock.MockSkillAssessmentRepository
+	audit      *mock.MockAuditLogRepository
+}
+
+func newShareLinkUsecase(ctrl *gomock.Controller) (usecase.ShareLinkUsecase, shareLinkMocks) {
+	m := shareLinkMocks{
+		shareLink:  mock.NewMockShareLinkRepository(ctrl),
+		profile:    mock.NewMockProfileRepository(ctrl),
+		experience: mock.NewMockExperienceRepository(ctrl),
+		skill:      mock.NewMockSkillAssessmentRepository(ctrl),
+		audit:      mock.NewMockAuditLogRepository(ctrl),
+	}
+	uc := usecase.NewShareLinkUsecase(m.shareLink, m.profile, m.experience, m.skill, m.audit, newTransactionManager(ctrl), discardLogger)
+	return uc, m
+}
+
+func TestShareLinkUsecase_Create(t *testing.T) {
+	tests := []struct {
+		name      string
+		userID    string
+		input     usecase.ShareLinkInput
+		setupMock func(shareLinkMocks)
+		check     func(*testing.T, usecase.CreatedShareLinkDto)
+		wantErr   error
+	}{
+		{
+			name:   "正常系: トークンのハッシュ値とパスワードのハッシュ値を保存する",
+			userID: "user-1",
+			input: usecase.ShareLinkInput{
+				Label:         "A社 面談用",
+				Sections:      []string{"experiences", "skills"},
+				HidePeriod:    true,
+				ExpiresInDays: 7,
+				Password:      "correct horse",
+			},
+			setupMock: func(m shareLinkMocks) {
+				m.shareLink.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, link model.ShareLink) (model.ShareLink, error) {
+					assert.Equal(t, "user-1", link.UserID)
+					assert.Equal(t, []model.ShareSection{model.ShareSectionSkills, model.ShareSectionExperiences}, link.Sections)
+					assert.Len(t, link.TokenHash, 64)
+					assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(link.PasswordHash), []byte("correct horse")))
+					assert.WithinDuration(t, time.Now().AddDate(0, 0, 7), link.ExpiresAt, time.Minute)
+					link.ID = 1
+					return link, nil
+				})
+				m.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, auditLog model.AuditLog) error {
+					assert.Equal(t, usecase.AuditEntityShareLink, auditLog.EntityType)
+					assert.NotContains(t, string(auditLog.After), "token")
+					return nil
+				})
+			},
+			check: func(t *testing.T, got usecase.CreatedShareLinkDto) {
+				assert.Equal(t, 1, got.ID)
+				assert.True(t, got.PasswordProtected)
+				assert.True(t, got.Active)
+				assert.Len(t, got.Token, 43)
+			},
+		},
+		{
+			name:   "正常系: 項目と有効日数が未指定の場合は全ての項目を30日間公開する",
+			userID: "user-1",
+			setupMock: func(m shareLinkMocks) {
+				m.shareLink.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, link model.ShareLink) (model.ShareLink, error) {
+					assert.Equal(t, model.AllShareSections(), link.Sections)
+					assert.Empty(t, link.PasswordHash)
+					assert.WithinDuration(t, time.Now().AddDate(0, 0, 30), link.ExpiresAt, time.Minute)
+					return link, nil
+				})
+				m.audit.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			check: func(t *testing.T, got usecase.CreatedShareLinkDto) {
+				assert.False(t, got.PasswordProtected)
+			},
+		},
+		{
+			name:      "異常系: 有効日数が上限を超える",
+			userID:    "user-1",
+			input:     usecase.ShareLinkInput{ExpiresInDays: 91},
+			setupMock: func(m shareLinkMocks) {},
+			wantErr:   usecase.ErrInvalidInput,
+		},
+		{
+			name:      "異常系: 不明な項目",
+			userID:    "user-1",
+			input:     usecase.ShareLinkInput{Sections: []string{"salary"}},
+			setupMock: func(m shareLinkMocks) {},
+			wantErr:   usecase.ErrInvalidInput,
+		},
+		{
+			name:      "異常系: パスワードが短い",
+			userID:    "user-1",
+			input:     usecase.ShareLinkInput{Password: "1234"},
+			setupMock: func(m shareLinkMocks) {},
+			wantErr:   usecase.ErrInvalidInput,
+		},
+		{
+			name:      "異常系: ユーザーが設定されていない",
+			setupMock: func(m shareLinkMocks) {},
+			wantErr:   usecase.ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newShareLinkUsecase(ctrl)
+			tt.setupMock(m)
+
+			ctx := context.Background()
+			if tt.userID != "" {
+				ctx = usecase.ContextWithUserID(ctx, tt.userID)
+			}
+			got, err := uc.Create(ctx, tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, got)
+		})
+	}
+}
+
+func TestShareLinkUsecase_Revoke(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		setupMock func(shareLinkMocks)
+		wantErr   error
+	}{
+		{
+			name: "正常系: 共有リンクを無効にして監査ログを記録する",
+			setupMock: func(m shareLinkMocks) {
+				m.shareLink.EXPECT().FindByID(gomock.Any(), 1).Return(model.ShareLink{ID: 1, UserID: "user-1", ExpiresAt: expiresAt}, nil)
+				m.shareLink.EXPECT().Revoke(gomock.Any(), 1, gomock.Any()).Return(nil)
+				m.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, auditLog model.AuditLog) error {
+					assert.Equal(t, usecase.AuditActionUpdate, auditLog.Action)
+					assert.Contains(t, string(auditLog.After), `"active":false`)
+					return nil
+				})
+			},
+		},
+		{
+			name: "正常系: 無効にした共有リンクは何もしない",
+			setupMock: func(m shareLinkMocks) {
+				m.shareLink.EXPECT().FindByID(gomock.Any(), 1).Return(model.ShareLink{ID: 1, UserID: "user-1", ExpiresAt: expiresAt, RevokedAt: time.Now()}, nil)
+			},
+		},
+		{
+			name: "異常系: 他のユーザーの共有リンク",
+			setupMock: func(m shareLinkMocks) {
+				m.shareLink.EXPECT().FindByID(gomock.Any(), 1).Return(model.ShareLink{ID: 1, UserID: "user-2", ExpiresAt: expiresAt}, nil)
+			},
+			wantErr: usecase.ErrNotFound,
+		},
+		{
+			name: "異常系: 存在しない共有リンク",
+			setupMock: func(m shareLinkMocks) {
+				m.shareLink.EXPECT().FindByID(gomock.Any(), 1).Return(model.ShareLink{}, repository.ErrNotFound)
+			},
+			wantErr: usecase.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newShareLinkUsecase(ctrl)
+			tt.setupMock(m)
+
+			err := uc.Revoke(usecase.ContextWithUserID(context.Background(), "user-1"), 1)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+// tenantIs はコンテキストのテナントが一致する gomock.Matcher です
+type tenantIs string
+
+func (id tenantIs) Matches(x interface{}) bool {
+	ctx, ok := x.(context.Context)
+	if !ok {
+		return false
+	}
+	tenant, ok := model.TenantFromContext(ctx)
+	return ok && tenant.ID == string(id)
+}
+
+func (id tenantIs) String() string {
+	return "is context of tenant " + string(id)
+}
+
+func TestShareLinkUsecase_ViewInAnotherTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc, m := newShareLinkUsecase(ctrl)
+	tenantRepository := mock.NewMockTenantRepository(ctrl)
+	tenants := usecase.NewTenantUsecase(tenantRepository, discardLogger)
+	tenantB := model.Tenant{ID: "tenant-b"}
+
+	// 既定でないテナント B で共有リンクを作成する
+	var saved model.ShareLink
+	m.shareLink.EXPECT().Create(tenantIs("tenant-b"), gomock.Any()).DoAndReturn(func(_ context.Context, link model.ShareLink) (model.ShareLink, error) {
+		link.ID = 1
+		saved = link
+		return link, nil
+	})
+	m.audit.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	ctx := model.ContextWithTenant(usecase.ContextWithUserID(context.Background(), "user-1"), tenantB)
+	created, err := uc.Create(ctx, usecase.ShareLinkInput{Label: "A社 面談用", Sections: []string{"profile"}, ExpiresInDays: 7})
+	require.NoError(t, err)
+
+	// サブドメインのない認証なしのリクエストでは、トークンからテナント B を決めて開けること
+	tenantRepository.EXPECT().FindByShareTokenHash(gomock.Any(), saved.TokenHash).Return(tenantB, nil)
+	viewCtx, err := tenants.ContextWithShareLinkTenant(context.Background(), created.Token)
+	require.NoError(t, err)
+
+	m.shareLink.EXPECT().FindByTokenHash(tenantIs("tenant-b"), saved.TokenHash).Return(saved, nil)
+	m.profile.EXPECT().FindByUserID(tenantIs("tenant-b"), "user-1").Return(model.Profile{UserID: "user-1", DisplayName: "山田 太郎"}, nil)
+	m.experience.EXPECT().FindByUserID(tenantIs("tenant-b"), "user-1").Return(nil, nil)
+	m.skill.EXPECT().FindByUserID(tenantIs("tenant-b"), "user-1").Return(nil, nil)
+	m.skill.EXPECT().FindEndorsementsByUserID(tenantIs("tenant-b"), "user-1").Return(nil, nil)
+	m.shareLink.EXPECT().IncrementViewCount(tenantIs("tenant-b"), 1, gomock.Any()).Return(nil)
+
+	got, err := uc.View(viewCtx, created.Token, "")
+	require.NoError(t, err)
+	assert.Equal(t, "山田 太郎", got.Resume.Profile.DisplayName)
+}
+
+func TestShareLinkUsecase_View(t *testing.T) {
+	const token = "share-token"
+	sum := sha256.Sum256([]byte(token))
+	tokenHash := hex.EncodeToString(sum[:])
+	errDB := errors.New("DB error")
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	month := func(year int, m time.Month) time.Time { return time.Date(year, m, 1, 0, 0, 0, 0, time.UTC) }
+	goSkill := model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}
+	newLink := func(visibility model.ShareVisibility) model.ShareLink {
+		return model.ShareLink{
+			ID:              1,
+			UserID:          "user-1",
+			TokenHash:       tokenHash,
+			ShareVisibility: visibility,
+			ExpiresAt:       time.Now().Add(time.Hour),
+		}
+	}
+	expectResume := func(m shareLinkMocks) {
+		m.profile.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(model.Profile{
+			UserID:         "user-1",
+			DisplayName:    "山田 太郎",
+			SelfPR:         "Go が得意です",
+			Certifications: []model.Certification{{Name: "基本情報技術者"}},
+		}, nil)
+		m.experience.EXPECT().FindByUserID(gomock.Any(), "user-1").Return([]model.Experience{
+			newExperienceInPeriod(t, 5, "ECサイト開発", month(2023, time.April), month(2024, time.March), goSkill),
+		}, nil)
+		m.skill.EXPECT().FindByUserID(gomock.Any(), "user-1").Return([]model.SkillAssessment{
+			{UserID: "user-1", Skill: goSkill, Level: 4},
+		}, nil)
+		m.skill.EXPECT().FindEndorsementsByUserID(gomock.Any(), "user-1").Return([]model.SkillEndorsement{
+			{ID: 1, UserID: "user-1", EndorserID: "user-2", Skill: goSkill, Level: 3},
+		}, nil)
+		m.shareLink.EXPECT().IncrementViewCount(gomock.Any(), 1, gomock.Any()).Return(nil)
+	}
+	clientExperience := func() model.Experience {
+		experience := newExperienceInPeriod(t, 5, "勘定系システム刷新", month(2023, time.April), month(2024, time.March), goSkill)
+		client, err := model.NewClient("株式会社〇〇銀行", "大手金融機関向け")
+		require.NoError(t, err)
+		experience.Client = client
+		teamSize, err := model.NewTeamSize(7)
+		require.NoError(t, err)
+		experience.TeamSize = teamSize
+		return experience
+	}
+
+	tests := []struct {
+		name      string
+		token     string
+		password  string
+		setupMock func(shareLinkMocks)
+		check     func(*testing.T, usecase.SharedResumeDto)
+		wantErr   error
+	}{
+		{
+			name:  "正常系: 公開する項目だけを返し、閲覧数を数える",
+			token: token,
+			setupMock: func(m shareLinkMocks) {
+				m.shareLink.EXPECT().FindByTokenHash(gomock.Any(), tokenHash).Return(
+					newLink(model.ShareVisibility{Sections: []model.ShareSection{model.ShareSectionProfile, model.ShareSectionExperiences}}), nil)
+				expectResume(m)
+			},
+			check: func(t *testing.T, got usecase.SharedResumeDto) {
+				assert.Equal(t, []string{"profile", "experiences"}, got.Sections)
+				assert.Equal(t, "山田 太郎", got.Resume.Profile.DisplayName)
+				assert.Empty(t, got.Resume.Profile.SelfPR)
+				assert.Empty(t, got.Resume.Profile.Certifications)
+				assert.Empty(t, got.Resume.Skills)
+				require.Len(t, got.Resume.Experiences, 1)
+				assert.Zero(t, got.Resume.Experiences[0].ID)
+				assert.NotNil(t, got.Resume.Experiences[0].StartMonth)
+			},
+		},
+		{
+			name:  "正常系: 期間を隠す場合は参画期間と最終使用月を返さない（習熟度は返す）",
+			token: token,
+			setupMock: func(m shareLinkMocks) {
+				m.shareLink.EXPECT().FindByTokenHash(gomock.Any(), tokenHash).Return(
+					newLink(model.ShareVisibility{Sections: []model.ShareSection{model.ShareSectionSkills, model.ShareSectionExperiences}, HidePeriod: true}), nil)
+				expectResume(m)
+			},
+			check: func(t *testing.T, got usecase.SharedResumeDto) {
+				require.Len(t, got.Resume.Experiences, 1)
+				assert.Nil(t, got.Resume.Experiences[0].StartMonth)
+				assert.Nil(t, got.Resume.Experiences[0].EndMonth)
+				require.Len(t, got.Resume.Skills, 1)
+				assert.Equal(t, 12, got.Resume.Skills[0].Months)
+				assert.Nil(t, got.Resume.Skills[0].LastUsedMonth)
+				assert.Equal(t, 4, got.Resume.Skills[0].SelfLevel)
+				assert.Equal(t, 3.0, got.Resume.Skills[0].PeerLevel)
+				assert.Equal(t, 1, got.Resume.Skills[0].EndorsementCount)
+			},
+		},
+		{
+			name:  "正常系: 顧客名を隠す場合は公開用の名前だけを返す",
+			token: token,
+			setupMock: func(m shareLinkMocks) {
+				m.shareLink.EXPECT().FindByTokenHash(gomock.Any(), tokenHash).Return(
+					newLink(model.ShareVisibility{Sections: model.AllShareSections(), HideClientNames: true}), nil)
+				m.profile.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(model.Profile{UserID: "user-1", DisplayName: "山田 太郎"}, nil)
+				m.experience.EXPECT().FindByUserID(gomock.Any(), "user-1").Return([]model.Experience{clientExperience()}, nil)
+				m.skill.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(nil, nil)
+				m.skill.EXPECT().FindEndorsementsByUserID(gomock.Any(), "user-1").Return(nil, nil)
+				m.shareLink.EXPECT().IncrementViewCount(gomock.Any(), 1, gomock.Any()).Return(nil)
+			},
+			check: func(t *testing.T, got usecase.SharedResumeDto) {
+				assert.False(t, got.Resume.Anonymized)
+				assert.Equal(t, "山田 太郎", got.Resume.Profile.DisplayName)
+				require.Len(t, got.Resume.Experiences, 1)
+				assert.Empty(t, got.Resume.Experiences[0].ClientName)
+				assert.Equal(t, "大手金融機関向け", got.Resume.Experiences[0].ClientAlias)
+				assert.Equal(t, 7, got.Resume.Experiences[0].TeamSize)
+			},
+		},
+		{
+			name:  "正常系: 匿名化する場合は氏名をイニシャルにしてチーム人数を丸める",
+			token: token,
+			setupMock: func(m shareLinkMocks) {
+				m.shareLink.EXPECT().FindByTokenHash(gomock.Any(), tokenHash).Return(
+					newLink(model.ShareVisibility{Sections: model.AllShareSections(), Anonymize: true}), nil)
+				m.profile.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(model.Profile{UserID: "user-1", DisplayName: "山田 太郎", Initials: "T.Y."}, nil)
+				m.experience.EXPECT().FindByUserID(gomock.Any(), "user-1").Return([]model.Experience{clientExperience()}, nil)
+				m.skill.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(nil, nil)
+				m.skill.EXPECT().FindEndorsementsByUserID(gomock.Any(), "user-1").Return(nil, nil)
+				m.shareLink.EXPECT().IncrementViewCount(gomock.Any(), 1, gomock.Any()).Return(nil)
+			},
+			check: func(t *testing.T, got usecase.SharedResumeDto) {
+				assert.True(t, got.Resume.Anonymized)
+				assert.Equal(t, "T.Y.", got.Resume.Profile.DisplayName)
+				require.Len(t, got.Resume.Experiences, 1)
+				assert.Empty(t, got.Resume.Experiences[0].ClientName)
+				assert.Equal(t, "大手金融機関向け", got.Resume.Experiences[0].ClientAlias)
+				assert.Equal(t, 10, got.Resume.Experiences[0].TeamSize)
+			},
+		},
+		{
+			name:     "正常系: パスワードが一致する",
+			token:    token,
+			password: "correct horse",
+			setupMock: func(m shareLinkMocks) {
+				link := newLink(model.ShareVisibility{Sections: model.AllShareSections()})
+				link.PasswordHash = string(passwordHash)
+				m.shareLink.EXPECT().FindByTokenHash(gomock.Any(), tokenHash).Return(link, nil)
+				expectResume(m)
+			},
+			check: func(t *testing.T, got usecase.SharedResumeDto) {
+				assert.Equal(t, "Go が得意です", got.Resume.Profile.SelfPR)
+			},
+		},
+		{
+			name:     "異常系: パスワードが一致しない",
+			token:    token,
+			password: "wrong password",
+			setupMock: func(m shareLinkMocks) {
+				link := newLink(model.ShareVisibility{Sections: model.AllShareSections()})
+				link.PasswordHash = string(passwordHash)
+				m.shareLink.EXPECT().FindByTokenHash(gomock.Any(), tokenHash).Return(link, nil)
+			},
+			wantErr: usecase.ErrSharePasswordRequired,
+		},
+		{
+			name:  "異常系: パスワードが未指定",
+			token: token,
+			setupMock: func(m shareLinkMocks) {
+				link := newLink(model.ShareVisibility{Sections: model.AllShareSections()})
+				link.PasswordHash = string(passwordHash)
+				m.shareLink.EXPECT().FindByTokenHash(gomock.Any(), tokenHash).Return(link, nil)
+			},
+			wantErr: usecase.ErrSharePasswordRequired,
+		},
+		{
+			name:  "異常系: 期限切れ",
+			token: token,
+			setupMock: func(m shareLinkMocks) {
+				link := newLink(model.ShareVisibility{Sections: model.AllShareSections()})
+				link.ExpiresAt = time.Now().Add(-time.Minute)
+				m.shareLink.EXPECT().FindByTokenHash(gomock.Any(), tokenHash).Return(link, nil)
+			},
+			wantErr: usecase.ErrNotFound,
+		},
+		{
+			name:  "異常系: 無効にされている",
+			token: token,
+			setupMock: func(m shareLinkMocks) {
+				link := newLink(model.ShareVisibility{Sections: model.AllShareSections()})
+				link.RevokedAt = time.Now().Add(-time.Minute)
+				m.shareLink.EXPECT().FindByTokenHash(gomock.Any(), tokenHash).Return(link, nil)
+			},
+			wantErr: usecase.ErrNotFound,
+		},
+		{
+			name:  "異常系: 存在しないトークン",
+			token: token,
+			setupMock: func(m shareLinkMocks) {
+				m.shareLink.EXPECT().FindByTokenHash(gomock.Any(), tokenHash).Return(model.ShareLink{}, repository.ErrNotFound)
+			},
+			wantErr: usecase.ErrNotFound,
+		},
+		{
+			name:  "異常系: 習熟度の取得に失敗する",
+			token: token,
+			setupMock: func(m shareLinkMocks) {
+				m.shareLink.EXPECT().FindByTokenHash(gomock.Any(), tokenHash).Return(newLink(model.ShareVisibility{Sections: model.AllShareSections()}), nil)
+				m.profile.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(model.Profile{}, repository.ErrNotFound)
+				m.experience.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(nil, nil)
+				m.skill.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(nil, errDB)
+			},
+			wantErr: errDB,
+		},
+		{
+			name:  "異常系: 閲覧数の更新に失敗する",
+			token: token,
+			setupMock: func(m shareLinkMocks) {
+				m.shareLink.EXPECT().FindByTokenHash(gomock.Any(), tokenHash).Return(newLink(model.ShareVisibility{Sections: model.AllShareSections()}), nil)
+				m.profile.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(model.Profile{}, repository.ErrNotFound)
+				m.experience.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(nil, nil)
+				m.skill.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(nil, nil)
+				m.skill.EXPECT().FindEndorsementsByUserID(gomock.Any(), "user-1").Return(nil, nil)
+				m.shareLink.EXPECT().IncrementViewCount(gomock.Any(), 1, gomock.Any()).Return(errDB)
+			},
+			wantErr: errDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newShareLinkUsecase(ctrl)
+			tt.setupMock(m)
+
+			got, err := uc.View(context.Background(), tt.token, tt.password)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, got)
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"fmt"
+	"sort"
+	"stackies/backend/domain/model"
+	"time"
+)
+
+// MemberDto は組織・チームに所属するユーザーです
+type MemberDto struct {
+	// UserID は JWT の sub です
+	UserID string
+	// DisplayName はプロフィールの表示名です（プロフィールが未登録の場合は空文字）
+	DisplayName string
+}
+
+// SkillMatrixDto はスキルとメンバーの経験期間の表です
+type SkillMatrixDto struct {
+	// Members は表の列です
+	Members []MemberDto
+	// Skills は表の行で、経験のあるメンバーの多い順です
+	Skills      []SkillMatrixRowDto
+	GeneratedAt time.Time
+}
+
+// SkillMatrixRowDto は1つのスキルのメンバーごとの経験期間です
+type SkillMatrixRowDto struct {
+	Category string
+	Name     string
+	// Members は経験のあるメンバーだけで、Members の並び順です
+	Members []MemberSkillDto
+	// TotalMonths はメンバーの経験月数の合計です
+	TotalMonths int
+}
+
+// MemberSkillDto はメンバーのスキルの経験期間です
+type MemberSkillDto struct {
+	UserID string
+	Months int
+	// LastUsedMonth は最後に使った月の月初です（nil は期間が未設定の案件でのみ使用）
+	LastUsedMonth   *time.Time
+	ExperienceCount int
+}
+
+// newSkillMatrixDto はメンバーの業務経歴からスキルの表を作成します
+// members に含まれないユーザーの業務経歴は使いません
+func newSkillMatrixDto(members []MemberDto, experiences []model.Experience, now time.Time) SkillMatrixDto {
+	byUser := make(map[string][]model.Experience)
+	for _, experience := range experiences {
+		byUser[experience.UserID] = append(byUser[experience.UserID], experience)
+	}
+
+	type rowKey struct{ category, name string }
+	rows := make(map[rowKey]*SkillMatrixRowDto)
+	var keys []rowKey
+	for _, member := range members {
+		for _, summary := range model.SummarizeSkills(byUser[member.UserID], now) {
+			key := rowKey{string(summary.Skill.Category), summary.Skill.Name}
+			row, ok := rows[key]
+			if !ok {
+				row = &SkillMatrixRowDto{Category: key.category, Name: key.name}
+				rows[key] = row
+				keys = append(keys, key)
+			}
+			skill := MemberSkillDto{UserID: member.UserID, Months: summary.Months, ExperienceCount: summary.ExperienceCount}
+			if !summary.LastUsed.IsZero() {
+				lastUsed := summary.LastUsed
+				skill.LastUsedMonth = &lastUsed
+			}
+			row.Members = append(row.Members, skill)
+			row.TotalMonths += summary.Months
+		}
+	}
+
+	matrix := SkillMatrixDto{Members: members, Skills: make([]SkillMatrixRowDto, len(keys)), GeneratedAt: now}
+	for i, key := range keys {
+		matrix.Skills[i] = *rows[key]
+	}
+	sort.SliceStable(matrix.Skills, func(i, j int) bool {
+		si, sj := matrix.Skills[i], matrix.Skills[j]
+		if len(si.Members) != len(sj.Members) {
+			return len(si.Members) > len(sj.Members)
+		}
+		return si.TotalMonths > sj.TotalMonths
+	})
+	return matrix
+}
+
+// SkillRequirementInput は必要なスキルです。MinPeople を省略（0）した場合は1人とします
+type SkillRequirementInput struct {
+	Category  string
+	Name      string
+	MinMonths int
+	MinPeople int
+}
+
+// toSkillRequirements は入力値を検証して必要なスキルに変換します
+func toSkillRequirements(inputs []SkillRequirementInput) ([]model.SkillRequirement, error) {
+	requirements := make([]model.SkillRequirement, len(inputs))
+	for i, in := range inputs {
+		var err error
+		if requirements[i], err = model.NewSkillRequirement(in.Category, in.Name, in.MinMonths, in.MinPeople); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+	}
+	return requirements, nil
+}
+
+// SkillGapAnalysisDto はスキルの表と、必要なスキルに対する不足の分析です
+type SkillGapAnalysisDto struct {
+	SkillMatrixDto
+	// Gaps は必要なスキルごとの分析で、指定した順です
+	Gaps []SkillGapDto
+	// Satisfied は全ての必要なスキルを満たしていることを表します
+	Satisfied bool
+}
+
+// SkillGapDto は1つの必要なスキルに対する不足です
+type SkillGapDto struct {
+	Category  string
+	Name      string
+	MinMonths int
+	MinPeople int
+	// Qualified は必要な経験月数を満たすメンバーで、経験月数の多い順です
+	Qualified []MemberSkillDto
+	// Candidates は経験はあるものの必要な経験月数に満たないメンバーで、経験月数の多い順です
+	Candidates []MemberSkillDto
+	// Shortage は不足している人数です（0 は満たしている）
+	Shortage int
+}
+
+// newSkillGapAnalysisDto はスキルの表から必要なスキルごとの不足を分析します
+func newSkillGapAnalysisDto(matrix SkillMatrixDto, requirements []model.SkillRequirement) SkillGapAnalysisDto {
+	analysis := SkillGapAnalysisDto{SkillMatrixDto: matrix, Gaps: make([]SkillGapDto, len(requirements)), Satisfied: true}
+	for i, requirement := range requirements {
+		gap := SkillGapDto{
+			Category:   string(requirement.Skill.Category),
+			Name:       requirement.Skill.Name,
+			MinMonths:  requirement.MinMonths,
+			MinPeople:  requirement.MinPeople,
+			Qualified:  []MemberSkillDto{},
+			Candidates: []MemberSkillDto{},
+		}
+		for _, row := range matrix.Skills {
+			skill := model.Skill{Category: model.SkillCategory(row.Category), Name: row.Name}
+			if !requirement.Matches(skill) {
+				continue
+			}
+			for _, member := range row.Members {
+				if requirement.SatisfiedBy(model.SkillSummary{Skill: skill, Months: member.Months}) {
+					gap.Qualified = append(gap.Qualified, member)
+				} else {
+					gap.Candidates = append(gap.Candidates, member)
+				}
+			}
+		}
+		sortMemberSkillsByMonths(gap.Qualified)
+		sortMemberSkillsByMonths(gap.Candidates)
+		if len(gap.Qualified) < requirement.MinPeople {
+			gap.Shortage = requirement.MinPeople - len(gap.Qualified)
+			analysis.Satisfied = false
+		}
+		analysis.Gaps[i] = gap
+	}
+	return analysis
+}
+
+func sortMemberSkillsByMonths(skills []MemberSkillDto) {
+	sort.SliceStable(skills, func(i, j int) bool { return skills[i].Months > skills[j].Months })
+}
+
+// newMemberDtos はユーザーの一覧にプロフィールの表示名を付けます。重複したユーザーは1つにまとめます
+func newMemberDtos(userIDs []string, profiles []model.Profile) []MemberDto {
+	names := displayNames(profiles)
+	seen := make(map[string]bool, len(userIDs))
+	var members []MemberDto
+	for _, userID := range userIDs {
+		if seen[userID] {
+			continue
+		}
+		seen[userID] = true
+		members = append(members, MemberDto{UserID: userID, DisplayName: names[userID]})
+	}
+	return members
+}
+
+// displayNames はユーザーIDからプロフィールの表示名への対応を返します
+func displayNames(profiles []model.Profile) map[string]string {
+	names := make(map[string]string, len(profiles))
+	for _, profile := range profiles {
+		names[profile.UserID] = profile.DisplayName
+	}
+	return names
+}
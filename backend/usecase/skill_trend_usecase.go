@@ -0,0 +1,206 @@
+//go:generate mockgen -source=skill_trend_usecase.go -destination=mock/mock_$GOFILE -package=mock
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"time"
+)
+
+// スキルの推移で集計する四半期の数と、増減を比較する期間の四半期の数
+const (
+	skillTrendDefaultQuarters = 8
+	skillTrendMaxQuarters     = 20
+	skillTrendWindow          = 2
+)
+
+// SkillTrendInput はスキルの推移の集計条件です
+type SkillTrendInput struct {
+	// Quarters は集計する四半期の数です（0 は 8、4〜20）。集計は前の四半期までで、現在の四半期は含めません
+	Quarters int
+}
+
+// SkillTrendReportDto は組織のスキルの推移です
+type SkillTrendReportDto struct {
+	// Quarters は四半期の古い順で、人月のない四半期も含めます
+	Quarters []SkillQuarterDto
+	// Growing・Declining は直近2四半期と、その前の2四半期の人月を比較して増えた・減ったスキルです
+	Growing   []SkillTrendDto
+	Declining []SkillTrendDto
+	// SoleHolders は組織で経験者が1人だけのスキルです（期間を問いません）
+	SoleHolders []SkillHolderDto
+}
+
+// SkillQuarterDto は1つの四半期のスキルごとの人月です
+type SkillQuarterDto struct {
+	// Quarter は "2025-Q3" の形式です
+	Quarter    string
+	StartMonth time.Time
+	Skills     []SkillUsageDto
+}
+
+type SkillUsageDto struct {
+	Category       string
+	Name           string
+	EngineerMonths int
+}
+
+type SkillTrendDto struct {
+	Category       string
+	Name           string
+	RecentMonths   int
+	PreviousMonths int
+}
+
+type SkillHolderDto struct {
+	Category string
+	Name     string
+	Holder   MemberDto
+}
+
+type skillTrendUsecase struct {
+	skillTrendRepository   repository.SkillTrendRepository
+	organizationRepository repository.OrganizationRepository
+	profileRepository      repository.ProfileRepository
+	logger                 *slog.Logger
+}
+
+// GetOrganizationTrends implements SkillTrendUsecase.
+// 組織のいずれかのチームのマネージャーだけが参照できます
+func (s *skillTrendUsecase) GetOrganizationTrends(ctx context.Context, organizationID int, input SkillTrendInput) (_ SkillTrendReportDto, err error) {
+	ctx, span := tracer.Start(ctx, "SkillTrendUsecase.GetOrganizationTrends")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return SkillTrendReportDto{}, ErrUnauthenticated
+	}
+	quarters := input.Quarters
+	if quarters == 0 {
+		quarters = skillTrendDefaultQuarters
+	}
+	if quarters < 2*skillTrendWindow || quarters > skillTrendMaxQuarters {
+		return SkillTrendReportDto{}, fmt.Errorf("%w: quarters must be between %d and %d", ErrInvalidInput, 2*skillTrendWindow, skillTrendMaxQuarters)
+	}
+
+	if _, err := s.organizationRepository.FindByID(ctx, organizationID); err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			s.logger.ErrorContext(ctx, "failed to get organization", slog.Any("error", err))
+		}
+		return SkillTrendReportDto{}, err
+	}
+	members, err := s.organizationRepository.FindMembersByOrganizationID(ctx, organizationID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get organization members", slog.Any("error", err))
+		return SkillTrendReportDto{}, err
+	}
+	isManager := false
+	for _, member := range members {
+		if member.UserID == userID && member.IsManager() {
+			isManager = true
+		}
+	}
+	if !isManager {
+		return SkillTrendReportDto{}, ErrForbidden
+	}
+
+	latest := model.QuarterOf(time.Now()).Add(-1)
+	from := latest.Add(-(quarters - 1))
+	usages, err := s.skillTrendRepository.FindQuarterlyUsage(ctx, organizationID, from, latest)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get quarterly skill usage", slog.Any("error", err))
+		return SkillTrendReportDto{}, err
+	}
+	holders, err := s.skillTrendRepository.FindSoleHolders(ctx, organizationID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get sole skill holders", slog.Any("error", err))
+		return SkillTrendReportDto{}, err
+	}
+	holderIDs := make([]string, len(holders))
+	for i, holder := range holders {
+		holderIDs[i] = holder.UserID
+	}
+	var profiles []model.Profile
+	if len(holderIDs) > 0 {
+		if profiles, err = s.profileRepository.FindByUserIDs(ctx, holderIDs); err != nil {
+			s.logger.ErrorContext(ctx, "failed to get profiles", slog.Any("error", err))
+			return SkillTrendReportDto{}, err
+		}
+	}
+
+	report := SkillTrendReportDto{
+		Quarters:    newSkillQuarterDtos(usages, from, latest),
+		Growing:     []SkillTrendDto{},
+		Declining:   []SkillTrendDto{},
+		SoleHolders: make([]SkillHolderDto, len(holders)),
+	}
+	for _, trend := range model.AnalyzeSkillTrends(usages, latest, skillTrendWindow) {
+		dto := SkillTrendDto{
+			Category:       string(trend.Skill.Category),
+			Name:           trend.Skill.Name,
+			RecentMonths:   trend.Recent,
+			PreviousMonths: trend.Previous,
+		}
+		switch trend.Direction {
+		case model.SkillTrendGrowing:
+			report.Growing = append(report.Growing, dto)
+		case model.SkillTrendDeclining:
+			// 減った人月の多い順にする
+			report.Declining = append([]SkillTrendDto{dto}, report.Declining...)
+		}
+	}
+	names := displayNames(profiles)
+	for i, holder := range holders {
+		report.SoleHolders[i] = SkillHolderDto{
+			Category: string(holder.Skill.Category),
+			Name:     holder.Skill.Name,
+			Holder:   MemberDto{UserID: holder.UserID, DisplayName: names[holder.UserID]},
+		}
+	}
+	return report, nil
+}
+
+// newSkillQuarterDtos は from から to までの四半期ごとに人月をまとめます
+func newSkillQuarterDtos(usages []model.SkillQuarterUsage, from, to model.Quarter) []SkillQuarterDto {
+	var dtos []SkillQuarterDto
+	index := make(map[model.Quarter]int)
+	for q := from; !to.Before(q); q = q.Add(1) {
+		index[q] = len(dtos)
+		dtos = append(dtos, SkillQuarterDto{Quarter: q.String(), StartMonth: q.Start(), Skills: []SkillUsageDto{}})
+	}
+	for _, usage := range usages {
+		i, ok := index[usage.Quarter]
+		if !ok {
+			continue
+		}
+		dtos[i].Skills = append(dtos[i].Skills, SkillUsageDto{
+			Category:       string(usage.Skill.Category),
+			Name:           usage.Skill.Name,
+			EngineerMonths: usage.EngineerMonths,
+		})
+	}
+	return dtos
+}
+
+type SkillTrendUsecase interface {
+	// GetOrganizationTrends は組織のメンバーの、四半期ごとのスキルの人月と増減、経験者が1人だけのスキルを返します
+	GetOrganizationTrends(ctx context.Context, organizationID int, input SkillTrendInput) (SkillTrendReportDto, error)
+}
+
+func NewSkillTrendUsecase(
+	skillTrendRepository repository.SkillTrendRepository,
+	organizationRepository repository.OrganizationRepository,
+	profileRepository repository.ProfileRepository,
+	logger *slog.Logger,
+) SkillTrendUsecase {
+	return &skillTrendUsecase{
+		skillTrendRepository:   skillTrendRepository,
+		organizationRepository: organizationRepository,
+		profileRepository:      profileRepository,
+		logger:                 logger.With(slog.String("usecase", "skill_trend")),
+	}
+}
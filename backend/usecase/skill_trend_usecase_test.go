@@ -0,0 +1,166 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"stackies/backend/domain/repository/mock"
+	"stackies/backend/usecase"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type skillTrendMocks struct {
+	skillTrend   *mock.MockSkillTrendRepository
+	organization *mock.MockOrganizationRepository
+	profile      *mock.MockProfileRepository
+}
+
+func newSkillTrendUsecase(ctrl *gomock.Controller) (usecase.SkillTrendUsecase, skillTrendMocks) {
+	m := skillTrendMocks{
+		skillTrend:   mock.NewMockSkillTrendRepository(ctrl),
+		organization: mock.NewMockOrganizationRepository(ctrl),
+		profile:      mock.NewMockProfileRepository(ctrl),
+	}
+	return usecase.NewSkillTrendUsecase(m.skillTrend, m.organization, m.profile, discardLogger), m
+}
+
+func TestSkillTrendUsecase_GetOrganizationTrends(t *testing.T) {
+	latest := model.QuarterOf(time.Now()).Add(-1)
+	goSkill := model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}
+	php := model.Skill{Category: model.SkillCategoryLanguage, Name: "PHP"}
+	aws := model.Skill{Category: model.SkillCategoryTool, Name: "AWS"}
+	expectManager := func(m skillTrendMocks) {
+		m.organization.EXPECT().FindByID(gomock.Any(), 1).Return(model.Organization{ID: 1}, nil)
+		m.organization.EXPECT().FindMembersByOrganizationID(gomock.Any(), 1).Return([]model.TeamMember{
+			{TeamID: 1, UserID: "manager-1", Role: model.TeamRoleManager},
+			{TeamID: 1, UserID: "user-1", Role: model.TeamRoleMember},
+		}, nil)
+	}
+
+	tests := []struct {
+		name      string
+		userID    string
+		input     usecase.SkillTrendInput
+		setupMock func(skillTrendMocks)
+		check     func(*testing.T, usecase.SkillTrendReportDto)
+		wantErr   error
+	}{
+		{
+			name:   "正常系: 前の四半期までの人月と増減、経験者が1人だけのスキルを返す",
+			userID: "manager-1",
+			setupMock: func(m skillTrendMocks) {
+				expectManager(m)
+				m.skillTrend.EXPECT().FindQuarterlyUsage(gomock.Any(), 1, latest.Add(-7), latest).Return([]model.SkillQuarterUsage{
+					{Skill: goSkill, Quarter: latest.Add(-3), EngineerMonths: 3},
+					{Skill: php, Quarter: latest.Add(-3), EngineerMonths: 12},
+					{Skill: php, Quarter: latest.Add(-2), EngineerMonths: 12},
+					{Skill: goSkill, Quarter: latest.Add(-1), EngineerMonths: 6},
+					{Skill: aws, Quarter: latest.Add(-1), EngineerMonths: 6},
+					{Skill: goSkill, Quarter: latest, EngineerMonths: 9},
+					{Skill: php, Quarter: latest, EngineerMonths: 3},
+					{Skill: aws, Quarter: latest, EngineerMonths: 6},
+				}, nil)
+				m.skillTrend.EXPECT().FindSoleHolders(gomock.Any(), 1).Return([]model.SkillHolder{
+					{Skill: model.Skill{Category: model.SkillCategoryLanguage, Name: "COBOL"}, UserID: "user-1"},
+				}, nil)
+				m.profile.EXPECT().FindByUserIDs(gomock.Any(), []string{"user-1"}).Return([]model.Profile{
+					{UserID: "user-1", DisplayName: "山田 太郎"},
+				}, nil)
+			},
+			check: func(t *testing.T, report usecase.SkillTrendReportDto) {
+				require.Len(t, report.Quarters, 8)
+				assert.Equal(t, latest.Add(-7).String(), report.Quarters[0].Quarter)
+				assert.Empty(t, report.Quarters[0].Skills)
+				assert.Equal(t, latest.Start(), report.Quarters[7].StartMonth)
+				assert.Equal(t, []usecase.SkillUsageDto{
+					{Category: "language", Name: "Go", EngineerMonths: 9},
+					{Category: "language", Name: "PHP", EngineerMonths: 3},
+					{Category: "tool", Name: "AWS", EngineerMonths: 6},
+				}, report.Quarters[7].Skills)
+
+				assert.Equal(t, []usecase.SkillTrendDto{
+					{Category: "language", Name: "Go", RecentMonths: 15, PreviousMonths: 3},
+					{Category: "tool", Name: "AWS", RecentMonths: 12, PreviousMonths: 0},
+				}, report.Growing)
+				assert.Equal(t, []usecase.SkillTrendDto{
+					{Category: "language", Name: "PHP", RecentMonths: 3, PreviousMonths: 24},
+				}, report.Declining)
+				assert.Equal(t, []usecase.SkillHolderDto{
+					{Category: "language", Name: "COBOL", Holder: usecase.MemberDto{UserID: "user-1", DisplayName: "山田 太郎"}},
+				}, report.SoleHolders)
+			},
+		},
+		{
+			name:   "正常系: 集計する四半期の数を指定し、人月がない場合は空の一覧を返す",
+			userID: "manager-1",
+			input:  usecase.SkillTrendInput{Quarters: 4},
+			setupMock: func(m skillTrendMocks) {
+				expectManager(m)
+				m.skillTrend.EXPECT().FindQuarterlyUsage(gomock.Any(), 1, latest.Add(-3), latest).Return(nil, nil)
+				m.skillTrend.EXPECT().FindSoleHolders(gomock.Any(), 1).Return(nil, nil)
+			},
+			check: func(t *testing.T, report usecase.SkillTrendReportDto) {
+				assert.Len(t, report.Quarters, 4)
+				assert.Empty(t, report.Growing)
+				assert.Empty(t, report.Declining)
+				assert.Empty(t, report.SoleHolders)
+			},
+		},
+		{
+			name:      "異常系: 集計する四半期の数が範囲外",
+			userID:    "manager-1",
+			input:     usecase.SkillTrendInput{Quarters: 3},
+			setupMock: func(m skillTrendMocks) {},
+			wantErr:   usecase.ErrInvalidInput,
+		},
+		{
+			name:   "異常系: 組織のマネージャーではない",
+			userID: "user-1",
+			setupMock: func(m skillTrendMocks) {
+				expectManager(m)
+			},
+			wantErr: usecase.ErrForbidden,
+		},
+		{
+			name:   "異常系: 組織が存在しない",
+			userID: "manager-1",
+			setupMock: func(m skillTrendMocks) {
+				m.organization.EXPECT().FindByID(gomock.Any(), 1).Return(model.Organization{}, repository.ErrNotFound)
+			},
+			wantErr: repository.ErrNotFound,
+		},
+		{
+			name:      "異常系: ユーザーが設定されていない",
+			setupMock: func(m skillTrendMocks) {},
+			wantErr:   usecase.ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newSkillTrendUsecase(ctrl)
+			tt.setupMock(m)
+
+			ctx := context.Background()
+			if tt.userID != "" {
+				ctx = usecase.ContextWithUserID(ctx, tt.userID)
+			}
+			got, err := uc.GetOrganizationTrends(ctx, 1, tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, got)
+		})
+	}
+}
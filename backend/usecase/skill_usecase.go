@@ -0,0 +1,386 @@
+//go:generate mockgen -source=skill_usecase.go -destination=mock/mock_$GOFILE -package=mock
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"time"
+)
+
+// SkillAssessmentDto はスキルの習熟度の自己評価です
+type SkillAssessmentDto struct {
+	Category  string
+	Name      string
+	Level     int
+	Comment   string
+	UpdatedAt time.Time
+}
+
+// SkillEndorsementDto はスキルの推薦です
+type SkillEndorsementDto struct {
+	ID       int
+	UserID   string
+	Endorser MemberDto
+	Category string
+	Name     string
+	Level    int
+	Comment  string
+	// CreatedAt は最初に推薦した日時、UpdatedAt は最後に推薦し直した日時です
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// SkillLevelInput はスキルの習熟度の自己評価の入力値です
+type SkillLevelInput struct {
+	Category string
+	Name     string
+	Level    int
+	Comment  string
+}
+
+// SkillEndorsementInput はスキルの推薦の入力値です
+type SkillEndorsementInput struct {
+	Category string
+	Name     string
+	Level    int
+	Comment  string
+}
+
+type skillUsecase struct {
+	skillAssessmentRepository repository.SkillAssessmentRepository
+	experienceRepository      repository.ExperienceRepository
+	organizationRepository    repository.OrganizationRepository
+	profileRepository         repository.ProfileRepository
+	transactionManager        repository.TransactionManager
+	audit                     auditRecorder
+	logger                    *slog.Logger
+}
+
+// ListMySkills implements SkillUsecase.
+func (s *skillUsecase) ListMySkills(ctx context.Context) (_ []SkillSummaryDto, err error) {
+	ctx, span := tracer.Start(ctx, "SkillUsecase.ListMySkills")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return nil, ErrUnauthenticated
+	}
+	experiences, err := s.experienceRepository.FindByUserID(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get experiences", slog.Any("error", err))
+		return nil, err
+	}
+	levels, err := findSkillLevels(ctx, s.skillAssessmentRepository, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get skill levels", slog.Any("error", err))
+		return nil, err
+	}
+	return withSkillLevels(newResumeDto(model.Profile{}, experiences, time.Now()).Skills, levels), nil
+}
+
+// SetLevel implements SkillUsecase.
+func (s *skillUsecase) SetLevel(ctx context.Context, input SkillLevelInput) (_ SkillAssessmentDto, err error) {
+	ctx, span := tracer.Start(ctx, "SkillUsecase.SetLevel")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return SkillAssessmentDto{}, ErrUnauthenticated
+	}
+	skill, err := model.NewSkill(input.Category, input.Name)
+	if err != nil {
+		return SkillAssessmentDto{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	assessment, err := model.NewSkillAssessment(userID, skill, input.Level, input.Comment)
+	if err != nil {
+		return SkillAssessmentDto{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	var saved model.SkillAssessment
+	err = s.transactionManager.Do(ctx, func(ctx context.Context) error {
+		// 監査ログの before には変更前の自己評価を記録する（未評価の場合は nil）
+		assessments, err := s.skillAssessmentRepository.FindByUserID(ctx, userID)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to get skill assessments", slog.Any("error", err))
+			return err
+		}
+		var before interface{}
+		for _, a := range assessments {
+			if a.Skill.SameAs(skill) {
+				before = toSkillAssessmentDto(a)
+			}
+		}
+
+		saved, err = s.skillAssessmentRepository.Save(ctx, *assessment)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to save skill assessment", slog.Any("error", err))
+			return err
+		}
+		action := AuditActionUpdate
+		if before == nil {
+			action = AuditActionCreate
+		}
+		if err := s.audit.record(ctx, action, AuditEntitySkillAssessment, skillAuditID(userID, saved.Skill), before, toSkillAssessmentDto(saved)); err != nil {
+			s.logger.ErrorContext(ctx, "failed to record audit log", slog.Any("error", err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return SkillAssessmentDto{}, err
+	}
+	s.logger.InfoContext(ctx, "skill level set", slog.String("category", string(saved.Skill.Category)), slog.String("name", saved.Skill.Name))
+	return toSkillAssessmentDto(saved), nil
+}
+
+// Endorse implements SkillUsecase.
+// 同じ組織のいずれかのチームに所属するユーザーだけを推薦できます
+func (s *skillUsecase) Endorse(ctx context.Context, userID string, input SkillEndorsementInput) (_ SkillEndorsementDto, err error) {
+	ctx, span := tracer.Start(ctx, "SkillUsecase.Endorse")
+	defer func() { endSpan(span, err) }()
+
+	endorserID := ActorFromContext(ctx).UserID
+	if endorserID == "" {
+		return SkillEndorsementDto{}, ErrUnauthenticated
+	}
+	skill, err := model.NewSkill(input.Category, input.Name)
+	if err != nil {
+		return SkillEndorsementDto{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	endorsement, err := model.NewSkillEndorsement(userID, endorserID, skill, input.Level, input.Comment)
+	if err != nil {
+		return SkillEndorsementDto{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	if err := s.authorizeColleague(ctx, endorserID, userID); err != nil {
+		return SkillEndorsementDto{}, err
+	}
+
+	var saved model.SkillEndorsement
+	err = s.transactionManager.Do(ctx, func(ctx context.Context) error {
+		// 監査ログの before には推薦し直す前の推薦を記録する（初めての推薦の場合は nil）
+		endorsements, err := s.skillAssessmentRepository.FindEndorsementsByUserID(ctx, userID)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to get skill endorsements", slog.Any("error", err))
+			return err
+		}
+		var before interface{}
+		for _, e := range endorsements {
+			if e.EndorserID == endorserID && e.Skill.SameAs(skill) {
+				before = toSkillEndorsementDto(e, "")
+			}
+		}
+
+		saved, err = s.skillAssessmentRepository.SaveEndorsement(ctx, *endorsement)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to save skill endorsement", slog.Any("error", err))
+			return err
+		}
+		action := AuditActionUpdate
+		if before == nil {
+			action = AuditActionCreate
+		}
+		if err := s.audit.record(ctx, action, AuditEntitySkillEndorsement, saved.ID, before, toSkillEndorsementDto(saved, "")); err != nil {
+			s.logger.ErrorContext(ctx, "failed to record audit log", slog.Any("error", err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return SkillEndorsementDto{}, err
+	}
+	s.logger.InfoContext(ctx, "skill endorsed", slog.Int("endorsement_id", saved.ID), slog.String("user_id", userID))
+	return toSkillEndorsementDto(saved, ""), nil
+}
+
+// ListEndorsements implements SkillUsecase.
+// 本人と、同じ組織のいずれかのチームに所属するユーザーだけが参照できます
+func (s *skillUsecase) ListEndorsements(ctx context.Context, userID string) (_ []SkillEndorsementDto, err error) {
+	ctx, span := tracer.Start(ctx, "SkillUsecase.ListEndorsements")
+	defer func() { endSpan(span, err) }()
+
+	actorID := ActorFromContext(ctx).UserID
+	if actorID == "" {
+		return nil, ErrUnauthenticated
+	}
+	if actorID != userID {
+		if err := s.authorizeColleague(ctx, actorID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	endorsements, err := s.skillAssessmentRepository.FindEndorsementsByUserID(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get skill endorsements", slog.Any("error", err))
+		return nil, err
+	}
+	endorserIDs := make([]string, len(endorsements))
+	for i, e := range endorsements {
+		endorserIDs[i] = e.EndorserID
+	}
+	profiles, err := s.profileRepository.FindByUserIDs(ctx, endorserIDs)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get profiles", slog.Any("error", err))
+		return nil, err
+	}
+	names := displayNames(profiles)
+	dtos := make([]SkillEndorsementDto, len(endorsements))
+	for i, e := range endorsements {
+		dtos[i] = toSkillEndorsementDto(e, names[e.EndorserID])
+	}
+	return dtos, nil
+}
+
+// authorizeColleague は2人のユーザーが同じ組織のいずれかのチームに所属しているかを検証します
+// 所属していない場合は ErrForbidden を返します
+func (s *skillUsecase) authorizeColleague(ctx context.Context, actorID, userID string) error {
+	actorOrganizations, err := s.organizationIDs(ctx, actorID)
+	if err != nil {
+		return err
+	}
+	userOrganizations, err := s.organizationIDs(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for id := range userOrganizations {
+		if actorOrganizations[id] {
+			return nil
+		}
+	}
+	return ErrForbidden
+}
+
+// organizationIDs はユーザーが所属するチームの組織を返します
+func (s *skillUsecase) organizationIDs(ctx context.Context, userID string) (map[int]bool, error) {
+	memberships, err := s.organizationRepository.FindMembershipsByUserID(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get memberships", slog.Any("error", err))
+		return nil, err
+	}
+	ids := make(map[int]bool)
+	for _, membership := range memberships {
+		team, err := s.organizationRepository.FindTeamByID(ctx, membership.TeamID)
+		if err != nil {
+			if !errors.Is(err, repository.ErrNotFound) {
+				s.logger.ErrorContext(ctx, "failed to get team", slog.Any("error", err))
+			}
+			return nil, err
+		}
+		ids[team.OrganizationID] = true
+	}
+	return ids, nil
+}
+
+// findSkillLevels はユーザーの自己評価と受けた推薦をスキルごとにまとめて返します
+func findSkillLevels(ctx context.Context, skillAssessmentRepository repository.SkillAssessmentRepository, userID string) (model.SkillLevels, error) {
+	assessments, err := skillAssessmentRepository.FindByUserID(ctx, userID)
+	if err != nil {
+		return model.SkillLevels{}, err
+	}
+	endorsements, err := skillAssessmentRepository.FindEndorsementsByUserID(ctx, userID)
+	if err != nil {
+		return model.SkillLevels{}, err
+	}
+	return model.SummarizeSkillLevels(assessments, endorsements), nil
+}
+
+// withSkillLevels は経験期間に自己評価と他者評価を加えます
+// 業務経歴で使っていないが評価のあるスキルは、経験月数 0 として最後に加えます
+func withSkillLevels(skills []SkillSummaryDto, levels model.SkillLevels) []SkillSummaryDto {
+	result := make([]SkillSummaryDto, 0, len(skills))
+	var used []model.Skill
+	for _, summary := range skills {
+		skill := model.Skill{Category: model.SkillCategory(summary.Category), Name: summary.Name}
+		used = append(used, skill)
+		result = append(result, applySkillLevel(summary, levels.Of(skill)))
+	}
+	for _, skill := range levels.Skills() {
+		if containsSkill(used, skill) {
+			continue
+		}
+		summary := SkillSummaryDto{Category: string(skill.Category), Name: skill.Name}
+		result = append(result, applySkillLevel(summary, levels.Of(skill)))
+	}
+	return result
+}
+
+func applySkillLevel(summary SkillSummaryDto, level model.SkillLevel) SkillSummaryDto {
+	summary.SelfLevel = int(level.Self)
+	summary.PeerLevel = level.Peer
+	summary.EndorsementCount = level.EndorsementCount
+	return summary
+}
+
+func containsSkill(skills []model.Skill, skill model.Skill) bool {
+	for _, s := range skills {
+		if s.SameAs(skill) {
+			return true
+		}
+	}
+	return false
+}
+
+// skillAuditID は監査ログに記録する自己評価の ID です（ユーザーとスキルの組）
+func skillAuditID(userID string, skill model.Skill) string {
+	return fmt.Sprintf("%s/%s/%s", userID, skill.Category, skill.Name)
+}
+
+func toSkillAssessmentDto(assessment model.SkillAssessment) SkillAssessmentDto {
+	return SkillAssessmentDto{
+		Category:  string(assessment.Skill.Category),
+		Name:      assessment.Skill.Name,
+		Level:     int(assessment.Level),
+		Comment:   assessment.Comment,
+		UpdatedAt: assessment.UpdatedAt,
+	}
+}
+
+func toSkillEndorsementDto(endorsement model.SkillEndorsement, endorserName string) SkillEndorsementDto {
+	return SkillEndorsementDto{
+		ID:        endorsement.ID,
+		UserID:    endorsement.UserID,
+		Endorser:  MemberDto{UserID: endorsement.EndorserID, DisplayName: endorserName},
+		Category:  string(endorsement.Skill.Category),
+		Name:      endorsement.Skill.Name,
+		Level:     int(endorsement.Level),
+		Comment:   endorsement.Comment,
+		CreatedAt: endorsement.CreatedAt,
+		UpdatedAt: endorsement.UpdatedAt,
+	}
+}
+
+type SkillUsecase interface {
+	// ListMySkills はログイン中のユーザーのスキルごとの経験期間と、自己評価・他者評価の習熟度を返します
+	ListMySkills(ctx context.Context) ([]SkillSummaryDto, error)
+	// SetLevel はログイン中のユーザーのスキルの習熟度を自己評価します。評価済みの場合は上書きします
+	SetLevel(ctx context.Context, input SkillLevelInput) (SkillAssessmentDto, error)
+	// Endorse はユーザーのスキルを推薦します。推薦済みの場合は上書きします
+	// 同じ組織のユーザー以外は ErrForbidden、自分自身は ErrInvalidInput を返します
+	Endorse(ctx context.Context, userID string, input SkillEndorsementInput) (SkillEndorsementDto, error)
+	// ListEndorsements はユーザーが受けた推薦を新しい順に返します
+	// 本人と同じ組織のユーザー以外は ErrForbidden を返します
+	ListEndorsements(ctx context.Context, userID string) ([]SkillEndorsementDto, error)
+}
+
+func NewSkillUsecase(
+	skillAssessmentRepository repository.SkillAssessmentRepository,
+	experienceRepository repository.ExperienceRepository,
+	organizationRepository repository.OrganizationRepository,
+	profileRepository repository.ProfileRepository,
+	auditLogRepository repository.AuditLogRepository,
+	transactionManager repository.TransactionManager,
+	logger *slog.Logger,
+) SkillUsecase {
+	return &skillUsecase{
+		skillAssessmentRepository: skillAssessmentRepository,
+		experienceRepository:      experienceRepository,
+		organizationRepository:    organizationRepository,
+		profileRepository:         profileRepository,
+		transactionManager:        transactionManager,
+		audit:                     auditRecorder{auditLogRepository: auditLogRepository},
+		logger:                    logger.With(slog.String("usecase", "skill")),
+	}
+}
@@ -0,0 +1,373 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"stackies/backend/domain/model"
+	"stackies/backend/domain/repository/mock"
+	"stackies/backend/usecase"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type skillMocks struct {
+	skill        *mock.MockSkillAssessmentRepository
+	experience   *mock.MockExperienceRepository
+	organization *mock.MockOrganizationRepository
+	profile      *mock.MockProfileRepository
+	audit        *mock.MockAuditLogRepository
+}
+
+func newSkillUsecase(ctrl *gomock.Controller) (usecase.SkillUsecase, skillMocks) {
+	m := skillMocks{
+		skill:        mock.NewMockSkillAssessmentRepository(ctrl),
+		experience:   mock.NewMockExperienceRepository(ctrl),
+		organization: mock.NewMockOrganizationRepository(ctrl),
+		profile:      mock.NewMockProfileRepository(ctrl),
+		audit:        mock.NewMockAuditLogRepository(ctrl),
+	}
+	uc := usecase.NewSkillUsecase(m.skill, m.experience, m.organization, m.profile, m.audit, newTransactionManager(ctrl), discardLogger)
+	return uc, m
+}
+
+// expectOrganizations はユーザーがそれぞれの組織のチームに所属していることを設定します
+// チームの ID は組織の ID と同じにします
+func expectOrganizations(m skillMocks, organizationIDs map[string][]int) {
+	for userID, ids := range organizationIDs {
+		var memberships []model.TeamMember
+		for _, id := range ids {
+			memberships = append(memberships, model.TeamMember{TeamID: id, UserID: userID, Role: model.TeamRoleMember})
+			m.organization.EXPECT().FindTeamByID(gomock.Any(), id).Return(model.Team{ID: id, OrganizationID: id}, nil).AnyTimes()
+		}
+		m.organization.EXPECT().FindMembershipsByUserID(gomock.Any(), userID).Return(memberships, nil)
+	}
+}
+
+func TestSkillUsecase_ListMySkills(t *testing.T) {
+	month := func(year int, m time.Month) time.Time { return time.Date(year, m, 1, 0, 0, 0, 0, time.UTC) }
+	goSkill := model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}
+	rustSkill := model.Skill{Category: model.SkillCategoryLanguage, Name: "Rust"}
+	lastUsed := month(2025, time.March)
+
+	tests := []struct {
+		name      string
+		userID    string
+		setupMock func(skillMocks)
+		want      []usecase.SkillSummaryDto
+		wantErr   error
+	}{
+		{
+			name:   "正常系: 経験期間に自己評価と他者評価を加え、業務で使っていないスキルは最後に加える",
+			userID: "user-1",
+			setupMock: func(m skillMocks) {
+				m.experience.EXPECT().FindByUserID(gomock.Any(), "user-1").Return([]model.Experience{
+					newUserExperience(t, 1, "user-1", month(2024, time.April), month(2025, time.March), goSkill),
+				}, nil)
+				m.skill.EXPECT().FindByUserID(gomock.Any(), "user-1").Return([]model.SkillAssessment{
+					{UserID: "user-1", Skill: rustSkill, Level: 2},
+					{UserID: "user-1", Skill: model.Skill{Category: model.SkillCategoryLanguage, Name: "go"}, Level: 4},
+				}, nil)
+				m.skill.EXPECT().FindEndorsementsByUserID(gomock.Any(), "user-1").Return([]model.SkillEndorsement{
+					{ID: 1, UserID: "user-1", EndorserID: "user-2", Skill: goSkill, Level: 5},
+					{ID: 2, UserID: "user-1", EndorserID: "user-3", Skill: goSkill, Level: 4},
+				}, nil)
+			},
+			want: []usecase.SkillSummaryDto{
+				{Category: "language", Name: "Go", Months: 12, LastUsedMonth: &lastUsed, ExperienceCount: 1, SelfLevel: 4, PeerLevel: 4.5, EndorsementCount: 2},
+				{Category: "language", Name: "Rust", SelfLevel: 2},
+			},
+		},
+		{
+			name:      "異常系: ユーザーが設定されていない",
+			setupMock: func(m skillMocks) {},
+			wantErr:   usecase.ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newSkillUsecase(ctrl)
+			tt.setupMock(m)
+
+			ctx := context.Background()
+			if tt.userID != "" {
+				ctx = usecase.ContextWithUserID(ctx, tt.userID)
+			}
+			got, err := uc.ListMySkills(ctx)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSkillUsecase_SetLevel(t *testing.T) {
+	goSkill := model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}
+
+	tests := []struct {
+		name      string
+		userID    string
+		input     usecase.SkillLevelInput
+		setupMock func(skillMocks)
+		wantLevel int
+		wantErr   error
+	}{
+		{
+			name:   "正常系: 未評価のスキルの習熟度を登録する",
+			userID: "user-1",
+			input:  usecase.SkillLevelInput{Category: "language", Name: " Go ", Level: 3, Comment: "API サーバーを一人で実装できる"},
+			setupMock: func(m skillMocks) {
+				m.skill.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(nil, nil)
+				m.skill.EXPECT().Save(gomock.Any(), model.SkillAssessment{UserID: "user-1", Skill: goSkill, Level: 3, Comment: "API サーバーを一人で実装できる"}).
+					Return(model.SkillAssessment{UserID: "user-1", Skill: goSkill, Level: 3, Comment: "API サーバーを一人で実装できる"}, nil)
+				m.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log model.AuditLog) error {
+					assert.Equal(t, usecase.AuditActionCreate, log.Action)
+					assert.Equal(t, usecase.AuditEntitySkillAssessment, log.EntityType)
+					assert.Equal(t, "user-1/language/Go", log.EntityID)
+					return nil
+				})
+			},
+			wantLevel: 3,
+		},
+		{
+			name:   "正常系: 評価済みのスキルは大文字・小文字を区別せずに上書きする",
+			userID: "user-1",
+			input:  usecase.SkillLevelInput{Category: "language", Name: "go", Level: 4},
+			setupMock: func(m skillMocks) {
+				m.skill.EXPECT().FindByUserID(gomock.Any(), "user-1").Return([]model.SkillAssessment{{UserID: "user-1", Skill: goSkill, Level: 3}}, nil)
+				m.skill.EXPECT().Save(gomock.Any(), gomock.Any()).Return(model.SkillAssessment{UserID: "user-1", Skill: goSkill, Level: 4}, nil)
+				m.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log model.AuditLog) error {
+					assert.Equal(t, usecase.AuditActionUpdate, log.Action)
+					assert.Contains(t, string(log.Before), `"Level":3`)
+					return nil
+				})
+			},
+			wantLevel: 4,
+		},
+		{
+			name:      "異常系: 習熟度が範囲外",
+			userID:    "user-1",
+			input:     usecase.SkillLevelInput{Category: "language", Name: "Go", Level: 6},
+			setupMock: func(m skillMocks) {},
+			wantErr:   usecase.ErrInvalidInput,
+		},
+		{
+			name:      "異常系: スキルの区分が不正",
+			userID:    "user-1",
+			input:     usecase.SkillLevelInput{Category: "framework", Name: "Echo", Level: 3},
+			setupMock: func(m skillMocks) {},
+			wantErr:   usecase.ErrInvalidInput,
+		},
+		{
+			name:      "異常系: ユーザーが設定されていない",
+			input:     usecase.SkillLevelInput{Category: "language", Name: "Go", Level: 3},
+			setupMock: func(m skillMocks) {},
+			wantErr:   usecase.ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newSkillUsecase(ctrl)
+			tt.setupMock(m)
+
+			ctx := context.Background()
+			if tt.userID != "" {
+				ctx = usecase.ContextWithUserID(ctx, tt.userID)
+			}
+			got, err := uc.SetLevel(ctx, tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantLevel, got.Level)
+		})
+	}
+}
+
+func TestSkillUsecase_Endorse(t *testing.T) {
+	goSkill := model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}
+	input := usecase.SkillEndorsementInput{Category: "language", Name: "Go", Level: 4, Comment: "設計のレビューが的確"}
+
+	tests := []struct {
+		name      string
+		userID    string
+		targetID  string
+		input     usecase.SkillEndorsementInput
+		setupMock func(skillMocks)
+		wantErr   error
+	}{
+		{
+			name:     "正常系: 同じ組織のユーザーを推薦できる",
+			userID:   "user-2",
+			targetID: "user-1",
+			input:    input,
+			setupMock: func(m skillMocks) {
+				// 組織は別のチームでもよい
+				expectOrganizations(m, map[string][]int{"user-2": {1, 2}, "user-1": {2}})
+				m.skill.EXPECT().FindEndorsementsByUserID(gomock.Any(), "user-1").Return(nil, nil)
+				m.skill.EXPECT().SaveEndorsement(gomock.Any(), model.SkillEndorsement{UserID: "user-1", EndorserID: "user-2", Skill: goSkill, Level: 4, Comment: "設計のレビューが的確"}).
+					Return(model.SkillEndorsement{ID: 10, UserID: "user-1", EndorserID: "user-2", Skill: goSkill, Level: 4, Comment: "設計のレビューが的確"}, nil)
+				m.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log model.AuditLog) error {
+					assert.Equal(t, usecase.AuditActionCreate, log.Action)
+					assert.Equal(t, usecase.AuditEntitySkillEndorsement, log.EntityType)
+					assert.Equal(t, "10", log.EntityID)
+					return nil
+				})
+			},
+		},
+		{
+			name:     "異常系: 別の組織のユーザーは推薦できない",
+			userID:   "user-2",
+			targetID: "user-9",
+			input:    input,
+			setupMock: func(m skillMocks) {
+				expectOrganizations(m, map[string][]int{"user-2": {1}, "user-9": {3}})
+			},
+			wantErr: usecase.ErrForbidden,
+		},
+		{
+			name:     "異常系: どのチームにも所属していない場合は推薦できない",
+			userID:   "user-2",
+			targetID: "user-1",
+			input:    input,
+			setupMock: func(m skillMocks) {
+				expectOrganizations(m, map[string][]int{"user-2": nil, "user-1": {1}})
+			},
+			wantErr: usecase.ErrForbidden,
+		},
+		{
+			name:      "異常系: 自分自身は推薦できない",
+			userID:    "user-1",
+			targetID:  "user-1",
+			input:     input,
+			setupMock: func(m skillMocks) {},
+			wantErr:   usecase.ErrInvalidInput,
+		},
+		{
+			name:      "異常系: ユーザーが設定されていない",
+			targetID:  "user-1",
+			input:     input,
+			setupMock: func(m skillMocks) {},
+			wantErr:   usecase.ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newSkillUsecase(ctrl)
+			tt.setupMock(m)
+
+			ctx := context.Background()
+			if tt.userID != "" {
+				ctx = usecase.ContextWithUserID(ctx, tt.userID)
+			}
+			got, err := uc.Endorse(ctx, tt.targetID, tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, 10, got.ID)
+			assert.Equal(t, "user-2", got.Endorser.UserID)
+		})
+	}
+}
+
+func TestSkillUsecase_ListEndorsements(t *testing.T) {
+	goSkill := model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}
+	endorsements := []model.SkillEndorsement{
+		{ID: 2, UserID: "user-1", EndorserID: "user-3", Skill: goSkill, Level: 3},
+		{ID: 1, UserID: "user-1", EndorserID: "user-2", Skill: goSkill, Level: 4},
+	}
+
+	tests := []struct {
+		name      string
+		userID    string
+		targetID  string
+		setupMock func(skillMocks)
+		want      []string
+		wantErr   error
+	}{
+		{
+			name:     "正常系: 本人は自分が受けた推薦を参照できる",
+			userID:   "user-1",
+			targetID: "user-1",
+			setupMock: func(m skillMocks) {
+				m.skill.EXPECT().FindEndorsementsByUserID(gomock.Any(), "user-1").Return(endorsements, nil)
+				m.profile.EXPECT().FindByUserIDs(gomock.Any(), []string{"user-3", "user-2"}).
+					Return([]model.Profile{{UserID: "user-2", DisplayName: "佐藤 花子"}}, nil)
+			},
+			want: []string{"", "佐藤 花子"},
+		},
+		{
+			name:     "正常系: 同じ組織のユーザーは参照できる",
+			userID:   "user-2",
+			targetID: "user-1",
+			setupMock: func(m skillMocks) {
+				expectOrganizations(m, map[string][]int{"user-2": {1}, "user-1": {1}})
+				m.skill.EXPECT().FindEndorsementsByUserID(gomock.Any(), "user-1").Return(endorsements, nil)
+				m.profile.EXPECT().FindByUserIDs(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			want: []string{"", ""},
+		},
+		{
+			name:     "異常系: 別の組織のユーザーは参照できない",
+			userID:   "user-9",
+			targetID: "user-1",
+			setupMock: func(m skillMocks) {
+				expectOrganizations(m, map[string][]int{"user-9": {3}, "user-1": {1}})
+			},
+			wantErr: usecase.ErrForbidden,
+		},
+		{
+			name:      "異常系: ユーザーが設定されていない",
+			targetID:  "user-1",
+			setupMock: func(m skillMocks) {},
+			wantErr:   usecase.ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newSkillUsecase(ctrl)
+			tt.setupMock(m)
+
+			ctx := context.Background()
+			if tt.userID != "" {
+				ctx = usecase.ContextWithUserID(ctx, tt.userID)
+			}
+			got, err := uc.ListEndorsements(ctx, tt.targetID)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			names := make([]string, len(got))
+			for i, e := range got {
+				names[i] = e.Endorser.DisplayName
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
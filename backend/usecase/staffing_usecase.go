@@ -0,0 +1,198 @@
+//go:generate mockgen -source=staffing_usecase.go -destination=mock/mock_$GOFILE -package=mock
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"time"
+)
+
+// 案件の候補者の検索で返す人数
+const (
+	staffingSearchDefaultLimit = 20
+	staffingSearchMaxLimit     = 100
+)
+
+// StaffingSearchInput は案件の募集要件です
+type StaffingSearchInput struct {
+	Required  []SkillRequirementInput
+	Preferred []SkillRequirementInput
+	Industry  string
+	// Phases は経験のある工程です（"basic_design" など）
+	Phases []string
+	// AvailableBy は参画開始月です（nil は稼働可能時期を問わない）
+	AvailableBy *time.Time
+	// Limit は返す人数です（0 は 20 人、最大 100 人）
+	Limit int
+}
+
+// StaffingCandidateDto は募集要件に合うエンジニアです
+type StaffingCandidateDto struct {
+	Member MemberDto
+	Score  int
+	// Breakdown は要件ごとの照合の内訳です
+	Breakdown []StaffingScoreItemDto
+	// AvailableFrom は稼働可能になる月の月初です（nil は参画中で終了月が未定）
+	AvailableFrom *time.Time
+}
+
+// StaffingScoreItemDto は1つの要件の照合の結果です
+type StaffingScoreItemDto struct {
+	// Kind は required_skill / preferred_skill / industry / phase / availability です
+	Kind      string
+	Name      string
+	Points    int
+	Months    int
+	MinMonths int
+	Matched   bool
+}
+
+type staffingUsecase struct {
+	experienceRepository repository.ExperienceRepository
+	profileRepository    repository.ProfileRepository
+	logger               *slog.Logger
+}
+
+// Search implements StaffingUsecase.
+func (s *staffingUsecase) Search(ctx context.Context, input StaffingSearchInput) (_ []StaffingCandidateDto, err error) {
+	ctx, span := tracer.Start(ctx, "StaffingUsecase.Search")
+	defer func() { endSpan(span, err) }()
+
+	if ActorFromContext(ctx).UserID == "" {
+		return nil, ErrUnauthenticated
+	}
+	requirement, err := input.toStaffingRequirement()
+	if err != nil {
+		return nil, err
+	}
+	limit := input.Limit
+	if limit < 0 || limit > staffingSearchMaxLimit {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidInput, staffingSearchMaxLimit)
+	}
+	if limit == 0 {
+		limit = staffingSearchDefaultLimit
+	}
+
+	// テナントの全ての業務経歴を読み込まないよう、候補になりうるエンジニアの業務経歴だけを読み込む
+	experiences, err := s.experienceRepository.FindByUserSkills(ctx, requirement.CandidateSkills())
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get experiences", slog.Any("error", err))
+		return nil, err
+	}
+	byUser := make(map[string][]model.Experience)
+	var userIDs []string
+	for _, experience := range experiences {
+		// 登録者を記録する前の業務経歴はエンジニアが分からないため候補にしない
+		if experience.UserID == "" {
+			continue
+		}
+		if _, ok := byUser[experience.UserID]; !ok {
+			userIDs = append(userIDs, experience.UserID)
+		}
+		byUser[experience.UserID] = append(byUser[experience.UserID], experience)
+	}
+
+	now := time.Now()
+	var candidates []StaffingCandidateDto
+	for _, userID := range userIDs {
+		match, ok := requirement.Evaluate(byUser[userID], now)
+		if !ok {
+			continue
+		}
+		candidates = append(candidates, toStaffingCandidateDto(userID, match))
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].Member.UserID < candidates[j].Member.UserID
+	})
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	if len(candidates) == 0 {
+		return []StaffingCandidateDto{}, nil
+	}
+
+	candidateIDs := make([]string, len(candidates))
+	for i, candidate := range candidates {
+		candidateIDs[i] = candidate.Member.UserID
+	}
+	profiles, err := s.profileRepository.FindByUserIDs(ctx, candidateIDs)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get profiles", slog.Any("error", err))
+		return nil, err
+	}
+	names := displayNames(profiles)
+	for i := range candidates {
+		candidates[i].Member.DisplayName = names[candidates[i].Member.UserID]
+	}
+	return candidates, nil
+}
+
+// toStaffingRequirement は入力値を検証して募集要件に変換します
+func (in StaffingSearchInput) toStaffingRequirement() (model.StaffingRequirement, error) {
+	required, err := toSkillRequirements(in.Required)
+	if err != nil {
+		return model.StaffingRequirement{}, err
+	}
+	preferred, err := toSkillRequirements(in.Preferred)
+	if err != nil {
+		return model.StaffingRequirement{}, err
+	}
+	phases, err := model.NewPhases(in.Phases)
+	if err != nil {
+		return model.StaffingRequirement{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	requirement, err := model.NewStaffingRequirement(required, preferred, in.Industry, phases, in.AvailableBy)
+	if err != nil {
+		return model.StaffingRequirement{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	return requirement, nil
+}
+
+func toStaffingCandidateDto(userID string, match model.StaffingMatch) StaffingCandidateDto {
+	dto := StaffingCandidateDto{
+		Member:    MemberDto{UserID: userID},
+		Score:     match.Score,
+		Breakdown: make([]StaffingScoreItemDto, len(match.Breakdown)),
+	}
+	for i, item := range match.Breakdown {
+		dto.Breakdown[i] = StaffingScoreItemDto{
+			Kind:      string(item.Kind),
+			Name:      item.Name,
+			Points:    item.Points,
+			Months:    item.Months,
+			MinMonths: item.MinMonths,
+			Matched:   item.Matched,
+		}
+	}
+	if !match.AvailableFrom.IsZero() {
+		availableFrom := match.AvailableFrom
+		dto.AvailableFrom = &availableFrom
+	}
+	return dto
+}
+
+type StaffingUsecase interface {
+	// Search は募集要件に合うエンジニアを点数の高い順に返します
+	// 必須スキルを満たさないエンジニアと、参画開始月までに稼働できないエンジニアは含めません
+	// 必須スキルがない場合は、いずれかの歓迎スキルの経験があるエンジニアから探します
+	Search(ctx context.Context, input StaffingSearchInput) ([]StaffingCandidateDto, error)
+}
+
+func NewStaffingUsecase(
+	experienceRepository repository.ExperienceRepository,
+	profileRepository repository.ProfileRepository,
+	logger *slog.Logger,
+) StaffingUsecase {
+	return &staffingUsecase{
+		experienceRepository: experienceRepository,
+		profileRepository:    profileRepository,
+		logger:               logger.With(slog.String("usecase", "staffing")),
+	}
+}
@@ -0,0 +1,144 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"stackies/backend/domain/model"
+	"stackies/backend/domain/repository/mock"
+	"stackies/backend/usecase"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaffingUsecase_Search(t *testing.T) {
+	month := func(year int, m time.Month) time.Time { return time.Date(year, m, 1, 0, 0, 0, 0, time.UTC) }
+	goSkill := model.Skill{Category: model.SkillCategoryLanguage, Name: "Go"}
+	awsSkill := model.Skill{Category: model.SkillCategoryTool, Name: "AWS"}
+	// user-1 は Go と AWS を 36 か月、user-2 は Go を 24 か月、user-3 は AWS だけ
+	experiences := []model.Experience{
+		newUserExperience(t, 1, "user-2", month(2022, time.April), month(2024, time.March), goSkill),
+		newUserExperience(t, 2, "user-1", month(2021, time.April), month(2024, time.March), goSkill, awsSkill),
+		newUserExperience(t, 3, "user-3", month(2021, time.April), month(2024, time.March), awsSkill),
+		newUserExperience(t, 4, "", month(2021, time.April), month(2024, time.March), goSkill),
+	}
+
+	tests := []struct {
+		name      string
+		input     usecase.StaffingSearchInput
+		setupMock func(experience *mock.MockExperienceRepository, profile *mock.MockProfileRepository)
+		check     func(*testing.T, []usecase.StaffingCandidateDto)
+		wantErr   error
+	}{
+		{
+			name: "正常系: 必須スキルを満たすエンジニアを点数の高い順に返す",
+			input: usecase.StaffingSearchInput{
+				Required:  []usecase.SkillRequirementInput{{Category: "language", Name: "Go", MinMonths: 24}},
+				Preferred: []usecase.SkillRequirementInput{{Category: "tool", Name: "AWS", MinMonths: 12}},
+			},
+			setupMock: func(experience *mock.MockExperienceRepository, profile *mock.MockProfileRepository) {
+				experience.EXPECT().FindByUserSkills(gomock.Any(), []model.Skill{{Category: model.SkillCategoryLanguage, Name: "Go"}}).
+					Return(experiences[:2], nil)
+				profile.EXPECT().FindByUserIDs(gomock.Any(), []string{"user-1", "user-2"}).
+					Return([]model.Profile{{UserID: "user-1", DisplayName: "山田 太郎"}}, nil)
+			},
+			check: func(t *testing.T, candidates []usecase.StaffingCandidateDto) {
+				require.Len(t, candidates, 2)
+				assert.Equal(t, usecase.MemberDto{UserID: "user-1", DisplayName: "山田 太郎"}, candidates[0].Member)
+				assert.Equal(t, 32, candidates[0].Score)
+				assert.Equal(t, []usecase.StaffingScoreItemDto{
+					{Kind: "required_skill", Name: "Go", Points: 22, Months: 36, MinMonths: 24, Matched: true},
+					{Kind: "preferred_skill", Name: "AWS", Points: 10, Months: 36, MinMonths: 12, Matched: true},
+				}, candidates[0].Breakdown)
+				assert.NotNil(t, candidates[0].AvailableFrom)
+				assert.Equal(t, "user-2", candidates[1].Member.UserID)
+				assert.Equal(t, 20, candidates[1].Score)
+			},
+		},
+		{
+			name:  "正常系: 該当するエンジニアがいない",
+			input: usecase.StaffingSearchInput{Required: []usecase.SkillRequirementInput{{Category: "language", Name: "Rust"}}},
+			setupMock: func(experience *mock.MockExperienceRepository, profile *mock.MockProfileRepository) {
+				experience.EXPECT().FindByUserSkills(gomock.Any(), []model.Skill{{Category: model.SkillCategoryLanguage, Name: "Rust"}}).
+					Return(nil, nil)
+			},
+			check: func(t *testing.T, candidates []usecase.StaffingCandidateDto) {
+				assert.Empty(t, candidates)
+			},
+		},
+		{
+			name:  "正常系: 必須スキルがない場合は歓迎スキルの経験があるエンジニアから探す",
+			input: usecase.StaffingSearchInput{Preferred: []usecase.SkillRequirementInput{{Category: "tool", Name: "AWS"}}},
+			setupMock: func(experience *mock.MockExperienceRepository, profile *mock.MockProfileRepository) {
+				experience.EXPECT().FindByUserSkills(gomock.Any(), []model.Skill{{Category: model.SkillCategoryTool, Name: "AWS"}}).
+					Return(experiences[1:3], nil)
+				profile.EXPECT().FindByUserIDs(gomock.Any(), []string{"user-1", "user-3"}).Return(nil, nil)
+			},
+			check: func(t *testing.T, candidates []usecase.StaffingCandidateDto) {
+				require.Len(t, candidates, 2)
+				assert.Equal(t, "user-1", candidates[0].Member.UserID)
+				assert.Equal(t, "user-3", candidates[1].Member.UserID)
+			},
+		},
+		{
+			name:      "異常系: 未知の工程",
+			input:     usecase.StaffingSearchInput{Phases: []string{"planning"}},
+			setupMock: func(experience *mock.MockExperienceRepository, profile *mock.MockProfileRepository) {},
+			wantErr:   usecase.ErrInvalidInput,
+		},
+		{
+			name:      "異常系: 要件を指定していない",
+			input:     usecase.StaffingSearchInput{},
+			setupMock: func(experience *mock.MockExperienceRepository, profile *mock.MockProfileRepository) {},
+			wantErr:   usecase.ErrInvalidInput,
+		},
+		{
+			name:      "異常系: スキルを指定していない",
+			input:     usecase.StaffingSearchInput{Industry: "金融"},
+			setupMock: func(experience *mock.MockExperienceRepository, profile *mock.MockProfileRepository) {},
+			wantErr:   usecase.ErrInvalidInput,
+		},
+		{
+			name:      "異常系: 人数が上限を超える",
+			input:     usecase.StaffingSearchInput{Required: []usecase.SkillRequirementInput{{Category: "language", Name: "Go"}}, Limit: 101},
+			setupMock: func(experience *mock.MockExperienceRepository, profile *mock.MockProfileRepository) {},
+			wantErr:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "異常系: 業務経歴の取得に失敗",
+			input: usecase.StaffingSearchInput{Required: []usecase.SkillRequirementInput{{Category: "language", Name: "Go"}}},
+			setupMock: func(experience *mock.MockExperienceRepository, profile *mock.MockProfileRepository) {
+				experience.EXPECT().FindByUserSkills(gomock.Any(), gomock.Any()).Return(nil, errors.New("DB error"))
+			},
+			wantErr: errors.New("DB error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			experienceRepository := mock.NewMockExperienceRepository(ctrl)
+			profileRepository := mock.NewMockProfileRepository(ctrl)
+			tt.setupMock(experienceRepository, profileRepository)
+			uc := usecase.NewStaffingUsecase(experienceRepository, profileRepository, discardLogger)
+
+			got, err := uc.Search(usecase.ContextWithUserID(context.Background(), "sales-1"), tt.input)
+			if tt.wantErr != nil {
+				if errors.Is(tt.wantErr, usecase.ErrInvalidInput) {
+					assert.ErrorIs(t, err, tt.wantErr)
+				} else {
+					assert.EqualError(t, err, tt.wantErr.Error())
+				}
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, got)
+		})
+	}
+}
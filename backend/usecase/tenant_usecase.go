@@ -0,0 +1,70 @@
+//go:generate mockgen -source=tenant_usecase.go -destination=mock/mock_$GOFILE -package=mock
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+)
+
+type tenantUsecase struct {
+	tenantRepository repository.TenantRepository
+	logger           *slog.Logger
+}
+
+// ContextWithTenant implements TenantUsecase.
+func (t *tenantUsecase) ContextWithTenant(ctx context.Context, tenantID string) (_ context.Context, err error) {
+	ctx, span := tracer.Start(ctx, "TenantUsecase.ContextWithTenant")
+	defer func() { endSpan(span, err) }()
+
+	// 形式が不正な ID はテナントの有無を問い合わせずに存在しないものとして扱う
+	if err := model.ValidateTenantID(tenantID); err != nil {
+		return nil, ErrNotFound
+	}
+	tenant, err := t.tenantRepository.FindByID(ctx, tenantID)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			t.logger.ErrorContext(ctx, "failed to find tenant", slog.Any("error", err))
+		}
+		return nil, err
+	}
+	return model.ContextWithTenant(ctx, tenant), nil
+}
+
+// ContextWithShareLinkTenant implements TenantUsecase.
+func (t *tenantUsecase) ContextWithShareLinkTenant(ctx context.Context, token string) (_ context.Context, err error) {
+	ctx, span := tracer.Start(ctx, "TenantUsecase.ContextWithShareLinkTenant")
+	defer func() { endSpan(span, err) }()
+
+	if token == "" {
+		return nil, ErrNotFound
+	}
+	tenant, err := t.tenantRepository.FindByShareTokenHash(ctx, hashShareToken(token))
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			t.logger.ErrorContext(ctx, "failed to find share link tenant", slog.Any("error", err))
+		}
+		return nil, err
+	}
+	return model.ContextWithTenant(ctx, tenant), nil
+}
+
+type TenantUsecase interface {
+	// ContextWithTenant はテナントを読み込み、リクエストのテナントとしてコンテキストに設定します
+	// 以降のリポジトリの読み書きはこのテナントのデータに限定されます
+	// ID の形式が不正なテナント・存在しないテナントは ErrNotFound を返します
+	ContextWithTenant(ctx context.Context, tenantID string) (context.Context, error)
+	// ContextWithShareLinkTenant は共有リンクを作成したテナントを読み込み、リクエストのテナントとしてコンテキストに設定します
+	// 認証なしで開く共有リンクは、サブドメインがなくても作成したテナントのデータを読み取れるようにします
+	// トークンに一致する共有リンクがない場合は ErrNotFound を返します
+	ContextWithShareLinkTenant(ctx context.Context, token string) (context.Context, error)
+}
+
+func NewTenantUsecase(tenantRepository repository.TenantRepository, logger *slog.Logger) TenantUsecase {
+	return &tenantUsecase{
+		tenantRepository: tenantRepository,
+		logger:           logger.With(slog.String("usecase", "tenant")),
+	}
+}
@@ -0,0 +1,142 @@
+package usecase_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"stackies/backend/domain/repository/mock"
+	"stackies/backend/usecase"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantUsecase_ContextWithTenant(t *testing.T) {
+	tests := []struct {
+		name      string
+		tenantID  string
+		setupMock func(*mock.MockTenantRepository)
+		want      model.Tenant
+		wantErr   error
+	}{
+		{
+			name:     "正常系: テナントをコンテキストに設定する",
+			tenantID: "acme",
+			setupMock: func(m *mock.MockTenantRepository) {
+				m.EXPECT().FindByID(gomock.Any(), "acme").Return(model.Tenant{ID: "acme", Name: "ACME", InheritGlobalCatalog: true}, nil)
+			},
+			want: model.Tenant{ID: "acme", Name: "ACME", InheritGlobalCatalog: true},
+		},
+		{
+			name:     "異常系: テナントが存在しない",
+			tenantID: "unknown",
+			setupMock: func(m *mock.MockTenantRepository) {
+				m.EXPECT().FindByID(gomock.Any(), "unknown").Return(model.Tenant{}, repository.ErrNotFound)
+			},
+			wantErr: usecase.ErrNotFound,
+		},
+		{
+			name:      "異常系: 形式が不正な ID は問い合わせない",
+			tenantID:  "ACME.example",
+			setupMock: func(m *mock.MockTenantRepository) {},
+			wantErr:   usecase.ErrNotFound,
+		},
+		{
+			name:     "異常系: テナントの取得に失敗",
+			tenantID: "acme",
+			setupMock: func(m *mock.MockTenantRepository) {
+				m.EXPECT().FindByID(gomock.Any(), "acme").Return(model.Tenant{}, errors.New("DB error"))
+			},
+			wantErr: errors.New("DB error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tenantRepository := mock.NewMockTenantRepository(ctrl)
+			tt.setupMock(tenantRepository)
+			uc := usecase.NewTenantUsecase(tenantRepository, discardLogger)
+
+			ctx, err := uc.ContextWithTenant(context.Background(), tt.tenantID)
+			if tt.wantErr != nil {
+				if errors.Is(tt.wantErr, usecase.ErrNotFound) {
+					assert.ErrorIs(t, err, tt.wantErr)
+				} else {
+					assert.EqualError(t, err, tt.wantErr.Error())
+				}
+				return
+			}
+			require.NoError(t, err)
+			got, ok := model.TenantFromContext(ctx)
+			assert.True(t, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTenantUsecase_ContextWithShareLinkTenant(t *testing.T) {
+	const token = "share-token"
+	sum := sha256.Sum256([]byte(token))
+	tokenHash := hex.EncodeToString(sum[:])
+
+	tests := []struct {
+		name      string
+		token     string
+		setupMock func(*mock.MockTenantRepository)
+		want      model.Tenant
+		wantErr   error
+	}{
+		{
+			name:  "正常系: 共有リンクを作成したテナントをコンテキストに設定する",
+			token: token,
+			setupMock: func(m *mock.MockTenantRepository) {
+				m.EXPECT().FindByShareTokenHash(gomock.Any(), tokenHash).Return(model.Tenant{ID: "tenant-b", Name: "B社"}, nil)
+			},
+			want: model.Tenant{ID: "tenant-b", Name: "B社"},
+		},
+		{
+			name:  "異常系: トークンに一致する共有リンクがない",
+			token: token,
+			setupMock: func(m *mock.MockTenantRepository) {
+				m.EXPECT().FindByShareTokenHash(gomock.Any(), tokenHash).Return(model.Tenant{}, repository.ErrNotFound)
+			},
+			wantErr: usecase.ErrNotFound,
+		},
+		{
+			name:      "異常系: 空のトークンは問い合わせない",
+			token:     "",
+			setupMock: func(m *mock.MockTenantRepository) {},
+			wantErr:   usecase.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tenantRepository := mock.NewMockTenantRepository(ctrl)
+			tt.setupMock(tenantRepository)
+			uc := usecase.NewTenantUsecase(tenantRepository, discardLogger)
+
+			ctx, err := uc.ContextWithShareLinkTenant(context.Background(), tt.token)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			got, ok := model.TenantFromContext(ctx)
+			assert.True(t, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,283 @@
+//go:generate mockgen -source=timeline_usecase.go -destination=mock/mock_$GOFILE -package=mock
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"time"
+)
+
+// TimelineDto はキャリアの年表です
+type TimelineDto struct {
+	// Years は年の古い順で、各年の項目は開始月の古い順です。複数の年にまたがる項目は開始月の年にだけ含めます
+	Years []TimelineYearDto
+	// Overlaps は2つの業務経歴が並行していた期間です
+	Overlaps []TimelineOverlapDto
+	// Undated は期間が未設定の業務経歴と、取得月が未設定の資格です
+	Undated []TimelineEntryDto
+	// GeneratedAt は作成日時です。参画中・所属中の項目の月数はこの月までとして数えます
+	GeneratedAt time.Time
+}
+
+type TimelineYearDto struct {
+	Year    int
+	Entries []TimelineEntryDto
+}
+
+// TimelineEntryDto は年表の1つの項目です
+type TimelineEntryDto struct {
+	ID string
+	// Kind は experience / certification / membership / role_change / gap のいずれかです
+	Kind   string
+	Title  string
+	Detail string
+	// StartMonth・EndMonth は月初です（EndMonth が nil は参画中・所属中、いずれも nil は期間が未設定）
+	StartMonth *time.Time
+	EndMonth   *time.Time
+	Months     int
+	// Milestone は期間ではなく、ある月の出来事（資格の取得、役割の変更）であることを表します
+	Milestone bool
+	// Overlaps は期間が重なる業務経歴の ID です
+	Overlaps []string
+}
+
+type TimelineOverlapDto struct {
+	StartMonth time.Time
+	EndMonth   time.Time
+	Months     int
+	EntryIDs   []string
+}
+
+type timelineUsecase struct {
+	experienceRepository   repository.ExperienceRepository
+	profileRepository      repository.ProfileRepository
+	organizationRepository repository.OrganizationRepository
+	logger                 *slog.Logger
+}
+
+// GetMyTimeline implements TimelineUsecase.
+func (t *timelineUsecase) GetMyTimeline(ctx context.Context) (_ TimelineDto, err error) {
+	ctx, span := tracer.Start(ctx, "TimelineUsecase.GetMyTimeline")
+	defer func() { endSpan(span, err) }()
+
+	userID := ActorFromContext(ctx).UserID
+	if userID == "" {
+		return TimelineDto{}, ErrUnauthenticated
+	}
+
+	experiences, err := t.experienceRepository.FindByUserID(ctx, userID)
+	if err != nil {
+		t.logger.ErrorContext(ctx, "failed to get experiences", slog.Any("error", err))
+		return TimelineDto{}, err
+	}
+	profile, err := findProfile(ctx, t.profileRepository, userID)
+	if err != nil {
+		t.logger.ErrorContext(ctx, "failed to get profile", slog.Any("error", err))
+		return TimelineDto{}, err
+	}
+	periods, err := t.organizationRepository.FindMembershipPeriodsByUserID(ctx, userID)
+	if err != nil {
+		t.logger.ErrorContext(ctx, "failed to get membership periods", slog.Any("error", err))
+		return TimelineDto{}, err
+	}
+	teamNames, err := t.teamNames(ctx, periods)
+	if err != nil {
+		return TimelineDto{}, err
+	}
+
+	var entries []model.TimelineEntry
+	for _, experience := range experiences {
+		entries = append(entries, model.TimelineEntry{
+			ID:     fmt.Sprintf("experience:%d", experience.ID),
+			Kind:   model.TimelineKindExperience,
+			Title:  experience.Title.String(),
+			Detail: experience.Client.Name(),
+			Period: experience.Period,
+		})
+	}
+	for i, certification := range profile.Certifications {
+		entry := model.TimelineEntry{
+			ID:     fmt.Sprintf("certification:%d", i+1),
+			Kind:   model.TimelineKindCertification,
+			Title:  certification.Name,
+			Detail: certification.Issuer,
+		}
+		if !certification.AcquiredMonth.IsZero() {
+			acquired := certification.AcquiredMonth
+			// 取得月から作成するため、常に作成できる
+			entry.Period, _ = model.NewPeriod(acquired, &acquired)
+		}
+		entries = append(entries, entry)
+	}
+	entries = append(entries, membershipTimelineEntries(periods, teamNames)...)
+
+	now := time.Now()
+	return toTimelineDto(model.BuildTimeline(entries, now), now), nil
+}
+
+// teamNames は所属していたチームの「組織名 / チーム名」を返します。削除されたチームは含めません
+func (t *timelineUsecase) teamNames(ctx context.Context, periods []model.TeamMembershipPeriod) (map[int]string, error) {
+	names := make(map[int]string)
+	organizationNames := make(map[int]string)
+	for _, period := range periods {
+		if _, ok := names[period.TeamID]; ok {
+			continue
+		}
+		team, err := t.organizationRepository.FindTeamByID(ctx, period.TeamID)
+		if errors.Is(err, repository.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			t.logger.ErrorContext(ctx, "failed to get team", slog.Any("error", err))
+			return nil, err
+		}
+		organizationName, ok := organizationNames[team.OrganizationID]
+		if !ok {
+			organization, err := t.organizationRepository.FindByID(ctx, team.OrganizationID)
+			if err != nil {
+				t.logger.ErrorContext(ctx, "failed to get organization", slog.Any("error", err))
+				return nil, err
+			}
+			organizationName = organization.Name
+			organizationNames[team.OrganizationID] = organizationName
+		}
+		names[period.TeamID] = organizationName + " / " + team.Name
+	}
+	return names, nil
+}
+
+// membershipTimelineEntries はチームへの所属期間を、所属と役割の変更の項目にします
+// 役割を変更しただけの連続した期間は1つの所属にまとめ、変更した月を役割の変更とします
+func membershipTimelineEntries(periods []model.TeamMembershipPeriod, teamNames map[int]string) []model.TimelineEntry {
+	byTeam := make(map[int][]model.TeamMembershipPeriod)
+	var teamIDs []int
+	for _, period := range periods {
+		if _, ok := teamNames[period.TeamID]; !ok {
+			continue
+		}
+		if _, ok := byTeam[period.TeamID]; !ok {
+			teamIDs = append(teamIDs, period.TeamID)
+		}
+		byTeam[period.TeamID] = append(byTeam[period.TeamID], period)
+	}
+	sort.Ints(teamIDs)
+
+	var entries []model.TimelineEntry
+	for _, teamID := range teamIDs {
+		teamPeriods := byTeam[teamID]
+		sort.SliceStable(teamPeriods, func(i, j int) bool { return teamPeriods[i].StartedAt.Before(teamPeriods[j].StartedAt) })
+
+		var memberships, roleChanges int
+		start := teamPeriods[0]
+		for i := 1; i < len(teamPeriods); i++ {
+			previous, period := teamPeriods[i-1], teamPeriods[i]
+			if previous.EndedAt == nil || period.StartedAt.After(*previous.EndedAt) {
+				// チームから外れた後に再び所属した
+				memberships++
+				entries = append(entries, newMembershipEntry(teamID, memberships, teamNames[teamID], start, previous))
+				start = period
+				continue
+			}
+			roleChanges++
+			changedAt := period.StartedAt
+			// 所属期間の開始日時から作成するため、常に作成できる
+			changed, _ := model.NewPeriod(changedAt, &changedAt)
+			entries = append(entries, model.TimelineEntry{
+				ID:     fmt.Sprintf("role_change:%d:%d", teamID, roleChanges),
+				Kind:   model.TimelineKindRoleChange,
+				Title:  teamNames[teamID],
+				Detail: fmt.Sprintf("%s → %s", previous.Role, period.Role),
+				Period: changed,
+			})
+		}
+		memberships++
+		entries = append(entries, newMembershipEntry(teamID, memberships, teamNames[teamID], start, teamPeriods[len(teamPeriods)-1]))
+	}
+	return entries
+}
+
+// newMembershipEntry は first の開始から last の終了までを1つの所属の項目にします。役割は最後の役割です
+func newMembershipEntry(teamID, n int, teamName string, first, last model.TeamMembershipPeriod) model.TimelineEntry {
+	// 終了日時は開始日時より後のため、常に作成できる
+	period, _ := model.NewPeriod(first.StartedAt, last.EndedAt)
+	return model.TimelineEntry{
+		ID:     fmt.Sprintf("membership:%d:%d", teamID, n),
+		Kind:   model.TimelineKindMembership,
+		Title:  teamName,
+		Detail: string(last.Role),
+		Period: period,
+	}
+}
+
+func toTimelineDto(timeline model.Timeline, now time.Time) TimelineDto {
+	dto := TimelineDto{
+		Years:       make([]TimelineYearDto, len(timeline.Years)),
+		Overlaps:    make([]TimelineOverlapDto, len(timeline.Overlaps)),
+		Undated:     make([]TimelineEntryDto, len(timeline.Undated)),
+		GeneratedAt: now,
+	}
+	for i, year := range timeline.Years {
+		dto.Years[i] = TimelineYearDto{Year: year.Year, Entries: make([]TimelineEntryDto, len(year.Entries))}
+		for j, entry := range year.Entries {
+			dto.Years[i].Entries[j] = toTimelineEntryDto(entry, now)
+		}
+	}
+	for i, overlap := range timeline.Overlaps {
+		// 重複期間は常に終了月がある
+		end, _ := overlap.Period.End()
+		dto.Overlaps[i] = TimelineOverlapDto{
+			StartMonth: overlap.Period.Start(),
+			EndMonth:   end,
+			Months:     overlap.Period.Months(now),
+			EntryIDs:   overlap.EntryIDs,
+		}
+	}
+	for i, entry := range timeline.Undated {
+		dto.Undated[i] = toTimelineEntryDto(entry, now)
+	}
+	return dto
+}
+
+func toTimelineEntryDto(entry model.TimelineEntry, now time.Time) TimelineEntryDto {
+	dto := TimelineEntryDto{
+		ID:        entry.ID,
+		Kind:      string(entry.Kind),
+		Title:     entry.Title,
+		Detail:    entry.Detail,
+		Months:    entry.Period.Months(now),
+		Milestone: entry.Kind.IsMilestone(),
+		Overlaps:  entry.Overlaps,
+	}
+	if !entry.Period.IsZero() {
+		start := entry.Period.Start()
+		dto.StartMonth = &start
+		if end, ok := entry.Period.End(); ok {
+			dto.EndMonth = &end
+		}
+	}
+	return dto
+}
+
+type TimelineUsecase interface {
+	// GetMyTimeline はログイン中のユーザーの業務経歴・資格・チームへの所属と役割の変更を、空白期間と重複期間を含む年表にして返します
+	GetMyTimeline(ctx context.Context) (TimelineDto, error)
+}
+
+func NewTimelineUsecase(
+	experienceRepository repository.ExperienceRepository,
+	profileRepository repository.ProfileRepository,
+	organizationRepository repository.OrganizationRepository,
+	logger *slog.Logger,
+) TimelineUsecase {
+	return &timelineUsecase{
+		experienceRepository:   experienceRepository,
+		profileRepository:      profileRepository,
+		organizationRepository: organizationRepository,
+		logger:                 logger.With(slog.String("usecase", "timeline")),
+	}
+}
@@ -0,0 +1,184 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"stackies/backend/domain/model"
+	"stackies/backend/domain/repository"
+	"stackies/backend/domain/repository/mock"
+	"stackies/backend/usecase"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type timelineMocks struct {
+	experience   *mock.MockExperienceRepository
+	profile      *mock.MockProfileRepository
+	organization *mock.MockOrganizationRepository
+}
+
+func newTimelineUsecase(ctrl *gomock.Controller) (usecase.TimelineUsecase, timelineMocks) {
+	m := timelineMocks{
+		experience:   mock.NewMockExperienceRepository(ctrl),
+		profile:      mock.NewMockProfileRepository(ctrl),
+		organization: mock.NewMockOrganizationRepository(ctrl),
+	}
+	return usecase.NewTimelineUsecase(m.experience, m.profile, m.organization, discardLogger), m
+}
+
+// timelineEntries は年表の全ての年の項目を ID と種類の組にします
+func timelineEntries(timeline usecase.TimelineDto) [][2]string {
+	var entries [][2]string
+	for _, year := range timeline.Years {
+		for _, entry := range year.Entries {
+			entries = append(entries, [2]string{entry.ID, entry.Kind})
+		}
+	}
+	return entries
+}
+
+func TestTimelineUsecase_GetMyTimeline(t *testing.T) {
+	month := func(year int, m time.Month) time.Time { return time.Date(year, m, 1, 0, 0, 0, 0, time.UTC) }
+	at := func(year int, m time.Month, day int) time.Time { return time.Date(year, m, day, 9, 0, 0, 0, time.UTC) }
+	ptr := func(v time.Time) *time.Time { return &v }
+	team := model.Team{ID: 1, OrganizationID: 1, Name: "決済チーム"}
+	organization := model.Organization{ID: 1, Name: "株式会社スタッキーズ"}
+
+	tests := []struct {
+		name      string
+		userID    string
+		setupMock func(timelineMocks)
+		check     func(*testing.T, usecase.TimelineDto)
+		wantErr   error
+	}{
+		{
+			name:   "正常系: 業務経歴・資格・所属を年ごとに並べ、空白期間と重複期間を含める",
+			userID: "user-1",
+			setupMock: func(m timelineMocks) {
+				m.experience.EXPECT().FindByUserID(gomock.Any(), "user-1").Return([]model.Experience{
+					newUserExperience(t, 1, "user-1", month(2023, time.April), month(2024, time.March)),
+					newUserExperience(t, 2, "user-1", month(2024, time.July), month(2024, time.December)),
+					newUserExperience(t, 3, "user-1", month(2024, time.October), month(2025, time.March)),
+				}, nil)
+				m.profile.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(model.Profile{
+					UserID: "user-1",
+					Certifications: []model.Certification{
+						{Name: "応用情報技術者", Issuer: "IPA", AcquiredMonth: month(2023, time.June)},
+						{Name: "AWS SAA"},
+					},
+				}, nil)
+				m.organization.EXPECT().FindMembershipPeriodsByUserID(gomock.Any(), "user-1").Return(nil, nil)
+			},
+			check: func(t *testing.T, timeline usecase.TimelineDto) {
+				assert.Equal(t, [][2]string{
+					{"experience:1", "experience"},
+					{"certification:1", "certification"},
+					{"gap:1", "gap"},
+					{"experience:2", "experience"},
+					{"experience:3", "experience"},
+				}, timelineEntries(timeline))
+
+				gap := timeline.Years[1].Entries[0]
+				assert.Equal(t, month(2024, time.April), *gap.StartMonth)
+				assert.Equal(t, month(2024, time.June), *gap.EndMonth)
+				assert.Equal(t, 3, gap.Months)
+
+				certification := timeline.Years[0].Entries[1]
+				assert.True(t, certification.Milestone)
+				assert.Equal(t, "IPA", certification.Detail)
+
+				require.Len(t, timeline.Overlaps, 1)
+				assert.Equal(t, []string{"experience:2", "experience:3"}, timeline.Overlaps[0].EntryIDs)
+				assert.Equal(t, month(2024, time.October), timeline.Overlaps[0].StartMonth)
+				assert.Equal(t, 3, timeline.Overlaps[0].Months)
+				assert.Equal(t, []string{"experience:3"}, timeline.Years[1].Entries[1].Overlaps)
+
+				require.Len(t, timeline.Undated, 1)
+				assert.Equal(t, "certification:2", timeline.Undated[0].ID)
+				assert.Nil(t, timeline.Undated[0].StartMonth)
+			},
+		},
+		{
+			name:   "正常系: 役割の変更は所属を区切らずに出来事として含め、外れた後の再所属は別の所属にする",
+			userID: "user-1",
+			setupMock: func(m timelineMocks) {
+				m.experience.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(nil, nil)
+				m.profile.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(model.Profile{}, repository.ErrNotFound)
+				m.organization.EXPECT().FindMembershipPeriodsByUserID(gomock.Any(), "user-1").Return([]model.TeamMembershipPeriod{
+					{TeamID: 1, UserID: "user-1", Role: model.TeamRoleMember, StartedAt: at(2023, time.April, 1), EndedAt: ptr(at(2024, time.April, 1))},
+					{TeamID: 1, UserID: "user-1", Role: model.TeamRoleManager, StartedAt: at(2024, time.April, 1), EndedAt: ptr(at(2024, time.September, 30))},
+					{TeamID: 1, UserID: "user-1", Role: model.TeamRoleMember, StartedAt: at(2025, time.January, 6)},
+				}, nil)
+				m.organization.EXPECT().FindTeamByID(gomock.Any(), 1).Return(team, nil)
+				m.organization.EXPECT().FindByID(gomock.Any(), 1).Return(organization, nil)
+			},
+			check: func(t *testing.T, timeline usecase.TimelineDto) {
+				assert.Equal(t, [][2]string{
+					{"membership:1:1", "membership"},
+					{"role_change:1:1", "role_change"},
+					{"membership:1:2", "membership"},
+				}, timelineEntries(timeline))
+
+				first := timeline.Years[0].Entries[0]
+				assert.Equal(t, "株式会社スタッキーズ / 決済チーム", first.Title)
+				assert.Equal(t, "manager", first.Detail)
+				assert.Equal(t, month(2023, time.April), *first.StartMonth)
+				assert.Equal(t, month(2024, time.September), *first.EndMonth)
+
+				roleChange := timeline.Years[1].Entries[0]
+				assert.Equal(t, "member → manager", roleChange.Detail)
+				assert.True(t, roleChange.Milestone)
+
+				current := timeline.Years[2].Entries[0]
+				assert.Nil(t, current.EndMonth)
+				assert.Empty(t, timeline.Overlaps)
+			},
+		},
+		{
+			name:   "正常系: 削除されたチームの所属は含めない",
+			userID: "user-1",
+			setupMock: func(m timelineMocks) {
+				m.experience.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(nil, nil)
+				m.profile.EXPECT().FindByUserID(gomock.Any(), "user-1").Return(model.Profile{UserID: "user-1"}, nil)
+				m.organization.EXPECT().FindMembershipPeriodsByUserID(gomock.Any(), "user-1").Return([]model.TeamMembershipPeriod{
+					{TeamID: 9, UserID: "user-1", Role: model.TeamRoleMember, StartedAt: at(2023, time.April, 1)},
+				}, nil)
+				m.organization.EXPECT().FindTeamByID(gomock.Any(), 9).Return(model.Team{}, repository.ErrNotFound)
+			},
+			check: func(t *testing.T, timeline usecase.TimelineDto) {
+				assert.Empty(t, timeline.Years)
+			},
+		},
+		{
+			name:      "異常系: ユーザーが設定されていない",
+			setupMock: func(m timelineMocks) {},
+			wantErr:   usecase.ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newTimelineUsecase(ctrl)
+			tt.setupMock(m)
+
+			ctx := context.Background()
+			if tt.userID != "" {
+				ctx = usecase.ContextWithUserID(ctx, tt.userID)
+			}
+			got, err := uc.GetMyTimeline(ctx)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, got)
+		})
+	}
+}
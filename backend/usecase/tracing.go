@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer はユースケースの各メソッドでスパンを開始するためのトレーサーです
+var tracer = otel.Tracer("stackies/backend/usecase")
+
+// endSpan はエラーをスパンに記録してから終了します
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
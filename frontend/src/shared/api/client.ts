@@ -0,0 +1,43 @@
+import { createTraceparent } from './traceparent'
+
+const baseUrl = import.meta.env.VITE_API_BASE_URL ?? 'http://localhost:8080'
+
+export type ApiErrorBody = {
+  message: string
+  trace_id?: string
+  // 412（他の更新と競合）の場合に返る現在の内容
+  current?: unknown
+}
+
+export class ApiError extends Error {
+  constructor(
+    public readonly status: number,
+    public readonly traceId: string | undefined,
+    message: string,
+    public readonly body?: ApiErrorBody,
+  ) {
+    super(message)
+  }
+}
+
+// APIを呼び出す。リクエストごとに traceparent を付与し、エラー時はトレースIDを ApiError に含める
+export const apiFetch = async <T>(path: string, init: RequestInit = {}): Promise<T> => {
+  const traceparent = createTraceparent()
+  const headers = new Headers(init.headers)
+  headers.set('traceparent', traceparent.header)
+  if (init.body && !headers.has('Content-Type')) {
+    headers.set('Content-Type', 'application/json')
+  }
+
+  const res = await fetch(`${baseUrl}${path}`, { ...init, headers })
+  const traceId = res.headers.get('X-Trace-Id') ?? traceparent.traceId
+
+  if (!res.ok) {
+    const body = (await res.json().catch(() => undefined)) as ApiErrorBody | undefined
+    throw new ApiError(res.status, body?.trace_id ?? traceId, body?.message ?? res.statusText, body)
+  }
+  if (res.status === 204) {
+    return undefined as T
+  }
+  return (await res.json()) as T
+}
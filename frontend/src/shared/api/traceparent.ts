@@ -0,0 +1,19 @@
+// W3C Trace Context (https://www.w3.org/TR/trace-context/) の traceparent ヘッダーを生成する
+// バックエンドはこのヘッダーを親としてスパンを開始するため、画面操作とサーバー側のトレースを紐付けられる
+
+const toHex = (bytes: Uint8Array) => Array.from(bytes, (b) => b.toString(16).padStart(2, '0')).join('')
+
+const randomHex = (byteLength: number) => {
+  const bytes = new Uint8Array(byteLength)
+  // 全て0のIDは無効なため、0以外になるまで採番し直す
+  do {
+    crypto.getRandomValues(bytes)
+  } while (bytes.every((b) => b === 0))
+  return toHex(bytes)
+}
+
+export const createTraceparent = () => {
+  const traceId = randomHex(16)
+  const spanId = randomHex(8)
+  return { traceId, header: `00-${traceId}-${spanId}-01` }
+}